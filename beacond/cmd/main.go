@@ -90,6 +90,10 @@ func run() error {
 		),
 		// Set the NodeBuilderFunc to the NodeBuilder Build.
 		clibuilder.WithNodeBuilderFunc[node, *executionPayload](nb.Build),
+		// Set the ReplayerCreator to the NodeBuilder BuildReplayer.
+		clibuilder.WithReplayerCreator[node, *executionPayload](
+			nb.BuildReplayer,
+		),
 	)
 
 	cmd, err := cb.Build()
@@ -22,29 +22,50 @@ package broker
 
 import (
 	"context"
+	"strconv"
+	"sync"
 	"time"
 )
 
-// Broker broadcasts msgs to registered clients.
+// Broker broadcasts msgs to registered clients. Every client has its own
+// buffered queue and a policy deciding what happens when the queue is full,
+// so that a slow client does not hold up the others unless it asks to.
 type Broker[T any] struct {
+	options
 	// name of the message broker.
 	name string
-	// clients is a map of registered clients.
-	clients map[chan T]struct{}
+	// mu guards clients, closed and count.
+	mu sync.RWMutex
+	// clients is a map of registered clients to their subscriptions.
+	clients map[chan T]*subscription[T]
+	// closed is set once the broker loop has stopped.
+	closed bool
+	// count is the number of subscriptions ever made, used to name them.
+	count uint64
 	// msgs is the channel for publishing new messages.
-	msgs chan T
-	// timeout is the timeout for sending a msg to a client.
-	timeout time.Duration
+	msgs chan envelope[T]
+	// metrics reports drops, queue depth and delivery latency.
+	metrics *metrics
+}
+
+// envelope wraps a published msg with the time it was published.
+type envelope[T any] struct {
+	msg         T
+	publishedAt time.Time
 }
 
 // New creates a new b.
-func New[T any](name string) *Broker[T] {
-	return &Broker[T]{
-		clients: make(map[chan T]struct{}),
-		msgs:    make(chan T, defaultBufferSize),
-		timeout: defaultTimeout,
+func New[T any](name string, opts ...Option) *Broker[T] {
+	b := &Broker[T]{
+		clients: make(map[chan T]*subscription[T]),
+		msgs:    make(chan envelope[T], defaultBufferSize),
 		name:    name,
 	}
+	for _, opt := range opts {
+		opt(&b.options)
+	}
+	b.metrics = newMetrics(b.sink, name)
+	return b
 }
 
 // Name returns the name of the msg broker.
@@ -63,48 +84,102 @@ func (b *Broker[T]) start(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			// close all leftover clients and break the broker loop
-			for client := range b.clients {
-				b.Unsubscribe(client)
-			}
+			b.close()
 			return
-		case msg := <-b.msgs:
-			// broadcast published msg to all clients
-			for client := range b.clients {
-				// send msg to client (or discard msg after timeout)
-				select {
-				case client <- msg:
-				case <-time.After(b.timeout):
-				}
-			}
+		case env := <-b.msgs:
+			b.broadcast(ctx, env)
+		}
+	}
+}
+
+// broadcast enqueues a published msg to every client. The lock is only held
+// to take a snapshot of the clients, so a client blocking the broadcast can
+// still unsubscribe.
+func (b *Broker[T]) broadcast(ctx context.Context, env envelope[T]) {
+	b.mu.RLock()
+	subs := make([]*subscription[T], 0, len(b.clients))
+	for _, sub := range b.clients {
+		subs = append(subs, sub)
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		dropped, ok := sub.send(ctx, env.msg)
+		if dropped > 0 {
+			b.metrics.markDropped(sub.name, sub.policy, dropped)
+		}
+		if ok {
+			b.metrics.markDelivered(sub.name, len(sub.ch), env.publishedAt)
 		}
 	}
 }
 
+// close closes all leftover clients and rejects new subscriptions.
+func (b *Broker[T]) close() {
+	b.mu.Lock()
+	b.closed = true
+	clients := b.clients
+	b.clients = make(map[chan T]*subscription[T])
+	b.mu.Unlock()
+
+	for _, sub := range clients {
+		sub.close()
+	}
+}
+
 // Publish publishes a msg to the b.
-// Returns ErrTimeout on timeout.
 func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
 	select {
-	case b.msgs <- msg:
+	case b.msgs <- envelope[T]{msg: msg, publishedAt: time.Now()}:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
 	}
 }
 
-// Subscribe registers a new client to the broker and returns it to the caller.
-// Returns ErrTimeout on timeout.
+// Subscribe registers a new client to the broker with the default options
+// and returns it to the caller.
 func (b *Broker[T]) Subscribe() (chan T, error) {
-	client := make(chan T)
-	b.clients[client] = struct{}{}
-	return client, nil
+	return b.SubscribeWithOptions()
 }
 
-// Unsubscribe removes a client from the b.
-// Returns ErrTimeout on timeout.
+// SubscribeWithOptions registers a new client to the broker and returns it
+// to the caller. By default the client has a queue of defaultQueueSize msgs
+// and blocks the broker when it is full.
+func (b *Broker[T]) SubscribeWithOptions(
+	opts ...SubscriptionOption,
+) (chan T, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+
+	b.count++
+	o := subscriptionOptions{
+		name:      "subscriber-" + strconv.FormatUint(b.count, 10),
+		policy:    PolicyBlock,
+		queueSize: defaultQueueSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.queueSize <= 0 {
+		return nil, ErrInvalidQueueSize
+	}
+
+	sub := newSubscription[T](o)
+	b.clients[sub.ch] = sub
+	return sub.ch, nil
+}
+
+// Unsubscribe removes a client from the b and closes it.
 func (b *Broker[T]) Unsubscribe(client chan T) {
-	// Remove the client from the broker
+	b.mu.Lock()
+	sub, ok := b.clients[client]
 	delete(b.clients, client)
-	// close the client channel
-	close(client)
+	b.mu.Unlock()
+	if ok {
+		sub.close()
+	}
 }
@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/stretchr/testify/require"
+)
+
+// sink is a telemetry sink that counts the metrics reported to it.
+type sink struct {
+	dropped   atomic.Int64
+	depth     atomic.Int64
+	delivered atomic.Int64
+}
+
+func (s *sink) IncrementCounter(string, ...string) {
+	s.dropped.Add(1)
+}
+
+func (s *sink) SetGauge(_ string, value int64, _ ...string) {
+	s.depth.Store(value)
+}
+
+func (s *sink) MeasureSince(string, time.Time, ...string) {
+	s.delivered.Add(1)
+}
+
+// drain returns the msgs left in the client queue.
+func drain(client chan int) []int {
+	var msgs []int
+	for {
+		select {
+		case msg := <-client:
+			msgs = append(msgs, msg)
+		default:
+			return msgs
+		}
+	}
+}
+
+func TestBroker_Policies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   broker.Policy
+		expected []int
+	}{
+		{
+			name:     "DropOldest",
+			policy:   broker.PolicyDropOldest,
+			expected: []int{3, 4},
+		},
+		{
+			name:     "DropNewest",
+			policy:   broker.PolicyDropNewest,
+			expected: []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			s := &sink{}
+			b := broker.New[int]("test", broker.WithTelemetrySink(s))
+			require.NoError(t, b.Start(ctx))
+
+			client, err := b.SubscribeWithOptions(
+				broker.WithPolicy(tt.policy), broker.WithQueueSize(2),
+			)
+			require.NoError(t, err)
+
+			for i := 1; i <= 4; i++ {
+				require.NoError(t, b.Publish(ctx, i))
+			}
+			require.Eventually(t, func() bool {
+				return s.dropped.Load() == 2
+			}, time.Second, time.Millisecond)
+			require.Equal(t, int64(2), s.depth.Load())
+			require.Equal(t, tt.expected, drain(client))
+		})
+	}
+}
+
+func TestBroker_Block(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := broker.New[int]("test")
+	require.NoError(t, b.Start(ctx))
+
+	client, err := b.SubscribeWithOptions(broker.WithQueueSize(1))
+	require.NoError(t, err)
+
+	for i := range 5 {
+		require.NoError(t, b.Publish(ctx, i))
+	}
+	for i := range 5 {
+		select {
+		case msg := <-client:
+			require.Equal(t, i, msg)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for msg %d", i)
+		}
+	}
+}
+
+func TestBroker_SlowSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := broker.New[int]("test")
+	require.NoError(t, b.Start(ctx))
+
+	// the slow client never reads from its queue.
+	_, err := b.SubscribeWithOptions(
+		broker.WithPolicy(broker.PolicyDropNewest), broker.WithQueueSize(1),
+	)
+	require.NoError(t, err)
+	fast, err := b.Subscribe()
+	require.NoError(t, err)
+
+	for i := range 100 {
+		require.NoError(t, b.Publish(ctx, i))
+		select {
+		case msg := <-fast:
+			require.Equal(t, i, msg)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for msg %d", i)
+		}
+	}
+}
+
+func TestBroker_UnsubscribeBlocked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := broker.New[int]("test")
+	require.NoError(t, b.Start(ctx))
+
+	client, err := b.SubscribeWithOptions(broker.WithQueueSize(1))
+	require.NoError(t, err)
+	for i := range 3 {
+		require.NoError(t, b.Publish(ctx, i))
+	}
+
+	// the broker is blocked on the full queue, unsubscribing must not
+	// deadlock.
+	done := make(chan struct{})
+	go func() {
+		b.Unsubscribe(client)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("unsubscribe deadlocked")
+	}
+
+	for range client {
+		// drain the queue until the client is closed.
+	}
+}
+
+func TestBroker_Close(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := broker.New[int]("test")
+	require.NoError(t, b.Start(ctx))
+
+	client, err := b.Subscribe()
+	require.NoError(t, err)
+	cancel()
+
+	require.Eventually(t, func() bool {
+		_, ok := <-client
+		return !ok
+	}, time.Second, time.Millisecond)
+
+	_, err = b.Subscribe()
+	require.ErrorIs(t, err, broker.ErrClosed)
+}
+
+func TestBroker_InvalidQueueSize(t *testing.T) {
+	b := broker.New[int]("test")
+	_, err := b.SubscribeWithOptions(broker.WithQueueSize(0))
+	require.ErrorIs(t, err, broker.ErrInvalidQueueSize)
+}
+
+func TestBroker_Concurrent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := broker.New[int]("test", broker.WithTelemetrySink(&sink{}))
+	require.NoError(t, b.Start(ctx))
+
+	const (
+		publishers  = 4
+		subscribers = 8
+		msgs        = 100
+	)
+
+	var wg sync.WaitGroup
+	for range publishers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range msgs {
+				require.NoError(t, b.Publish(ctx, i))
+			}
+		}()
+	}
+
+	policies := []broker.Policy{
+		broker.PolicyBlock,
+		broker.PolicyDropOldest,
+		broker.PolicyDropNewest,
+	}
+	for i := range subscribers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, err := b.SubscribeWithOptions(
+				broker.WithPolicy(policies[i%len(policies)]),
+				broker.WithQueueSize(1+i%3),
+			)
+			require.NoError(t, err)
+			for range msgs / 10 {
+				select {
+				case <-client:
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+			b.Unsubscribe(client)
+		}()
+	}
+	wg.Wait()
+}
@@ -24,5 +24,11 @@ import (
 	"errors"
 )
 
-// ErrTimeout is the error returned when a broker operation timed out.
-var ErrTimeout = errors.New("timeout")
+var (
+	// ErrClosed is returned when subscribing to a broker that has been
+	// stopped.
+	ErrClosed = errors.New("broker closed")
+	// ErrInvalidQueueSize is returned when subscribing with a queue size
+	// that is not positive.
+	ErrInvalidQueueSize = errors.New("queue size must be positive")
+)
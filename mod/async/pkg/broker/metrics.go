@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import "time"
+
+// metrics is a struct that contains metrics for the broker.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+	// name is the name of the broker, used to label metrics.
+	name string
+}
+
+// newMetrics creates a new instance of the metrics struct.
+func newMetrics(sink TelemetrySink, name string) *metrics {
+	return &metrics{
+		sink: sink,
+		name: name,
+	}
+}
+
+// markDelivered reports the depth of a subscriber's queue after a message
+// published at publishedAt has been enqueued to it.
+func (m *metrics) markDelivered(
+	subscriber string, depth int, publishedAt time.Time,
+) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.MeasureSince(
+		"beacon_kit.async.broker.delivery_latency", publishedAt,
+		"broker", m.name, "subscriber", subscriber,
+	)
+	m.sink.SetGauge(
+		"beacon_kit.async.broker.queue_depth", int64(depth),
+		"broker", m.name, "subscriber", subscriber,
+	)
+}
+
+// markDropped reports the messages dropped for a subscriber.
+func (m *metrics) markDropped(subscriber string, policy Policy, count int) {
+	if m.sink == nil {
+		return
+	}
+	for range count {
+		m.sink.IncrementCounter(
+			"beacon_kit.async.broker.dropped_msgs",
+			"broker", m.name,
+			"subscriber", subscriber,
+			"policy", policy.String(),
+		)
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+// Option is a functional option for the broker.
+type Option func(*options)
+
+// options holds the optional configuration of a broker.
+type options struct {
+	sink TelemetrySink
+}
+
+// WithTelemetrySink sets the telemetry sink used to report broker metrics.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.sink = sink
+	}
+}
+
+// SubscriptionOption is a functional option for a subscription.
+type SubscriptionOption func(*subscriptionOptions)
+
+// subscriptionOptions holds the optional configuration of a subscription.
+type subscriptionOptions struct {
+	name      string
+	policy    Policy
+	queueSize int
+}
+
+// WithPolicy sets the policy applied when the subscriber's queue is full.
+func WithPolicy(policy Policy) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.policy = policy
+	}
+}
+
+// WithQueueSize sets the number of messages buffered for the subscriber.
+func WithQueueSize(size int) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.queueSize = size
+	}
+}
+
+// WithSubscriberName sets the name used to label the subscriber's metrics.
+func WithSubscriberName(name string) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.name = name
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// subscription is a subscriber's queue along with the policy applied when
+// it is full.
+type subscription[T any] struct {
+	subscriptionOptions
+	// ch is the queue of the subscriber, returned to it on subscribe.
+	ch chan T
+	// done is closed when the subscriber unsubscribes, releasing a blocked
+	// delivery.
+	done chan struct{}
+	once sync.Once
+	// mu guards sends on ch against it being closed.
+	mu     sync.Mutex
+	closed bool
+}
+
+// newSubscription creates a new subscription with the given options.
+func newSubscription[T any](opts subscriptionOptions) *subscription[T] {
+	return &subscription[T]{
+		subscriptionOptions: opts,
+		ch:                  make(chan T, opts.queueSize),
+		done:                make(chan struct{}),
+	}
+}
+
+// send enqueues msg for the subscriber unless it has been closed. It
+// returns the number of messages dropped and whether msg was enqueued.
+func (s *subscription[T]) send(ctx context.Context, msg T) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, false
+	}
+	return s.deliver(ctx, msg)
+}
+
+// deliver enqueues msg for the subscriber according to its policy. It
+// returns the number of messages dropped and whether msg was enqueued.
+// Only the broker loop sends on the queue, so evicting a message always
+// makes room for the new one.
+func (s *subscription[T]) deliver(ctx context.Context, msg T) (int, bool) {
+	switch s.policy {
+	case PolicyDropNewest:
+		select {
+		case s.ch <- msg:
+			return 0, true
+		default:
+			return 1, false
+		}
+	case PolicyDropOldest:
+		var dropped int
+		for {
+			select {
+			case s.ch <- msg:
+				return dropped, true
+			default:
+			}
+			select {
+			case <-s.ch:
+				dropped++
+			default:
+			}
+		}
+	default:
+		select {
+		case s.ch <- msg:
+			return 0, true
+		case <-s.done:
+			return 1, false
+		case <-ctx.Done():
+			return 1, false
+		}
+	}
+}
+
+// close releases any delivery blocked on the subscription and closes the
+// subscriber's queue.
+func (s *subscription[T]) close() {
+	s.once.Do(func() { close(s.done) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+}
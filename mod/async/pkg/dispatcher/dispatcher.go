@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// Dispatcher sends typed requests to a single handler and routes every
+// response back to the request it answers, so concurrent requests can never
+// consume each other's responses.
+type Dispatcher[ReqT, RespT any] struct {
+	options
+	// name of the dispatcher.
+	name string
+	// requests is the channel the handler receives requests on.
+	requests chan *Request[ReqT, RespT]
+	// lastID is the correlation ID of the last request.
+	lastID atomic.Uint64
+	// served is set once a handler has been registered.
+	served atomic.Bool
+	// mu protects observers.
+	mu sync.RWMutex
+	// observers are notified of every request and response.
+	observers []Observer[ReqT, RespT]
+}
+
+// New creates a new dispatcher.
+func New[ReqT, RespT any](
+	name string, opts ...Option,
+) *Dispatcher[ReqT, RespT] {
+	d := &Dispatcher[ReqT, RespT]{
+		options: options{
+			bufferSize: defaultBufferSize,
+		},
+		name: name,
+	}
+	for _, opt := range opts {
+		opt(&d.options)
+	}
+	d.requests = make(chan *Request[ReqT, RespT], d.bufferSize)
+	return d
+}
+
+// Name returns the name of the dispatcher.
+func (d *Dispatcher[_, _]) Name() string {
+	return d.name
+}
+
+// Serve registers the caller as the handler of the dispatcher and returns
+// the channel requests are delivered on. Only one handler can be registered.
+func (d *Dispatcher[ReqT, RespT]) Serve() (
+	<-chan *Request[ReqT, RespT], error,
+) {
+	if !d.served.CompareAndSwap(false, true) {
+		return nil, ErrHandlerRegistered
+	}
+	return d.requests, nil
+}
+
+// Observe registers an observer that is notified of every request sent
+// through the dispatcher and of the response it resolved to.
+func (d *Dispatcher[ReqT, RespT]) Observe(observer Observer[ReqT, RespT]) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.observers = append(d.observers, observer)
+}
+
+// Request sends a request of the given type to the handler and waits for its
+// response. The request is cancelled if ctx is done or the timeout of the
+// dispatcher expires first.
+func (d *Dispatcher[ReqT, RespT]) Request(
+	ctx context.Context,
+	eventType asynctypes.EventID,
+	data ReqT,
+) (RespT, error) {
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+
+	req := newRequest[ReqT, RespT](
+		ctx, RequestID(d.lastID.Add(1)), eventType, data,
+	)
+	observers := d.getObservers()
+	for _, observer := range observers {
+		observer.OnRequest(req.ID(), eventType, data)
+	}
+
+	resp := d.await(ctx, req)
+	for _, observer := range observers {
+		observer.OnResponse(req.ID(), eventType, resp.data, resp.err)
+	}
+	return resp.data, resp.err
+}
+
+// await sends the request to the handler and waits for its response.
+func (d *Dispatcher[ReqT, RespT]) await(
+	ctx context.Context, req *Request[ReqT, RespT],
+) response[RespT] {
+	select {
+	case d.requests <- req:
+	case <-ctx.Done():
+		return response[RespT]{err: d.wrapErr(req, ctx.Err())}
+	}
+
+	select {
+	case resp := <-req.resp:
+		return resp
+	case <-ctx.Done():
+		return response[RespT]{err: d.wrapErr(req, ctx.Err())}
+	}
+}
+
+// getObservers returns a snapshot of the registered observers.
+func (d *Dispatcher[ReqT, RespT]) getObservers() []Observer[ReqT, RespT] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.observers
+}
+
+// wrapErr wraps the error of a cancelled request with its correlation ID.
+func (d *Dispatcher[ReqT, RespT]) wrapErr(
+	req *Request[ReqT, RespT], err error,
+) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = errors.Join(ErrTimeout, err)
+	}
+	return fmt.Errorf(
+		"%s request %d (%s): %w", d.name, req.ID(), req.Type(), err,
+	)
+}
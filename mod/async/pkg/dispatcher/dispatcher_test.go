@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dispatcher_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	double = "double"
+	fail   = "fail"
+)
+
+var errFail = errors.New("fail")
+
+// serve answers requests to d until ctx is done, responding to each of them
+// from its own goroutine after a delay so that responses are sent out of
+// order.
+func serve(
+	ctx context.Context, t *testing.T, d *dispatcher.Dispatcher[int, int],
+) {
+	t.Helper()
+	reqs, err := d.Serve()
+	require.NoError(t, err)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-reqs:
+				go func() {
+					time.Sleep(time.Duration(req.Data()%5) * time.Millisecond)
+					switch req.Type() {
+					case double:
+						_ = req.Respond(2*req.Data(), nil)
+					default:
+						_ = req.Respond(0, errFail)
+					}
+				}()
+			}
+		}
+	}()
+}
+
+func TestDispatcher_Request(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := dispatcher.New[int, int]("test")
+	serve(ctx, t, d)
+
+	resp, err := d.Request(ctx, double, 21)
+	require.NoError(t, err)
+	require.Equal(t, 42, resp)
+
+	_, err = d.Request(ctx, fail, 1)
+	require.ErrorIs(t, err, errFail)
+}
+
+func TestDispatcher_Correlation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := dispatcher.New[int, int]("test")
+	serve(ctx, t, d)
+
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := d.Request(ctx, double, i)
+			require.NoError(t, err)
+			require.Equal(t, 2*i, resp)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDispatcher_Timeout(t *testing.T) {
+	d := dispatcher.New[int, int](
+		"test", dispatcher.WithTimeout(10*time.Millisecond),
+	)
+	reqs, err := d.Serve()
+	require.NoError(t, err)
+
+	_, err = d.Request(context.Background(), double, 1)
+	require.ErrorIs(t, err, dispatcher.ErrTimeout)
+
+	// the handler sees the request cancelled, and a late response to it is
+	// not delivered to the next request.
+	req := <-reqs
+	require.ErrorIs(t, req.Context().Err(), context.DeadlineExceeded)
+	require.NoError(t, req.Respond(2, nil))
+
+	go func() {
+		next := <-reqs
+		_ = next.Respond(4, nil)
+	}()
+	resp, err := d.Request(context.Background(), double, 2)
+	require.NoError(t, err)
+	require.Equal(t, 4, resp)
+}
+
+func TestDispatcher_Cancel(t *testing.T) {
+	d := dispatcher.New[int, int]("test", dispatcher.WithBufferSize(0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := d.Request(ctx, double, 1)
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, dispatcher.ErrTimeout)
+}
+
+func TestDispatcher_RespondOnce(t *testing.T) {
+	d := dispatcher.New[int, int]("test")
+	reqs, err := d.Serve()
+	require.NoError(t, err)
+
+	served := make(chan *dispatcher.Request[int, int], 1)
+	go func() {
+		req := <-reqs
+		_ = req.Respond(1, nil)
+		served <- req
+	}()
+	resp, err := d.Request(context.Background(), double, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, resp)
+	require.ErrorIs(
+		t, (<-served).Respond(2, nil), dispatcher.ErrAlreadyResponded,
+	)
+}
+
+func TestDispatcher_SingleHandler(t *testing.T) {
+	d := dispatcher.New[int, int]("test")
+	_, err := d.Serve()
+	require.NoError(t, err)
+	_, err = d.Serve()
+	require.ErrorIs(t, err, dispatcher.ErrHandlerRegistered)
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package dispatcher
+
+import (
+	"context"
+	"sync/atomic"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// RequestID correlates a request with its response.
+type RequestID uint64
+
+// Request is a typed request sent to the handler of a dispatcher. The handler
+// must respond to it exactly once.
+type Request[ReqT, RespT any] struct {
+	// ctx is the context of the request, cancelled when the requester stops
+	// waiting for the response.
+	ctx context.Context
+	// id is the correlation ID of the request.
+	id RequestID
+	// eventType is the type of the request.
+	eventType asynctypes.EventID
+	// data is the payload of the request.
+	data ReqT
+	// resp receives the single response to the request.
+	resp chan response[RespT]
+	// responded is set once the request has been responded to.
+	responded atomic.Bool
+}
+
+// response is the response to a request.
+type response[RespT any] struct {
+	data RespT
+	err  error
+}
+
+// newRequest creates a new request.
+func newRequest[ReqT, RespT any](
+	ctx context.Context,
+	id RequestID,
+	eventType asynctypes.EventID,
+	data ReqT,
+) *Request[ReqT, RespT] {
+	return &Request[ReqT, RespT]{
+		ctx:       ctx,
+		id:        id,
+		eventType: eventType,
+		data:      data,
+		resp:      make(chan response[RespT], 1),
+	}
+}
+
+// ID returns the correlation ID of the request.
+func (r *Request[_, _]) ID() RequestID {
+	return r.id
+}
+
+// Context returns the context of the request.
+func (r *Request[_, _]) Context() context.Context {
+	return r.ctx
+}
+
+// Type returns the type of the request.
+func (r *Request[_, _]) Type() asynctypes.EventID {
+	return r.eventType
+}
+
+// Is returns true if the request has the given type.
+func (r *Request[_, _]) Is(eventType asynctypes.EventID) bool {
+	return r.eventType == eventType
+}
+
+// Data returns the payload of the request.
+func (r *Request[ReqT, _]) Data() ReqT {
+	return r.data
+}
+
+// Respond sends the response to the request. It never blocks, even if the
+// requester has stopped waiting, and returns ErrAlreadyResponded if the
+// request has already been responded to.
+func (r *Request[_, RespT]) Respond(data RespT, err error) error {
+	if !r.responded.CompareAndSwap(false, true) {
+		return ErrAlreadyResponded
+	}
+	r.resp <- response[RespT]{data: data, err: err}
+	return nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+const (
+	// DefaultMaxFileSize is the default size in bytes at which a journal
+	// file is rotated.
+	DefaultMaxFileSize = 64 << 20
+	// DefaultMaxFiles is the default number of journal files kept on disk.
+	DefaultMaxFiles = 8
+)
+
+// Config is the configuration for the event journal.
+type Config struct {
+	// Enabled determines if events are recorded to the journal.
+	Enabled bool `mapstructure:"enabled"`
+	// Dir is the directory the journal files are written to. If empty, the
+	// journal is written to data/journal in the node's home directory.
+	Dir string `mapstructure:"dir"`
+	// MaxFileSize is the size in bytes at which a journal file is rotated.
+	MaxFileSize uint64 `mapstructure:"max-file-size"`
+	// MaxFiles is the number of journal files kept on disk, the oldest
+	// being removed on rotation. If zero, no file is ever removed.
+	MaxFiles uint64 `mapstructure:"max-files"`
+}
+
+// DefaultConfig returns the default configuration for the event journal.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		MaxFileSize: DefaultMaxFileSize,
+		MaxFiles:    DefaultMaxFiles,
+	}
+}
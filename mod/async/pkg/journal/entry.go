@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// Kind is the kind of a journal entry.
+type Kind string
+
+const (
+	// KindEvent is an event published on a broker.
+	KindEvent Kind = "event"
+	// KindRequest is a request sent through a dispatcher.
+	KindRequest Kind = "request"
+	// KindResponse is the response a dispatcher request resolved to.
+	KindResponse Kind = "response"
+)
+
+// Entry is a single record of the journal.
+type Entry struct {
+	// Seq is the position of the entry in the journal.
+	Seq uint64 `json:"seq"`
+	// Time is the time the entry was recorded at.
+	Time time.Time `json:"time"`
+	// Source is the name of the broker or dispatcher the entry passed
+	// through.
+	Source string `json:"source"`
+	// Kind is the kind of the entry.
+	Kind Kind `json:"kind"`
+	// Type is the event type of the entry.
+	Type asynctypes.EventID `json:"type"`
+	// ID correlates a request with its response. It is zero for events.
+	ID uint64 `json:"id,omitempty"`
+	// Version is the fork version the payload was encoded with.
+	Version uint32 `json:"version,omitempty"`
+	// Payload is the SSZ encoded data of the entry. Responses are recorded
+	// without a payload.
+	Payload []byte `json:"payload,omitempty"`
+	// Error is the error carried by the entry, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// errString returns the message of err, or an empty string if it is nil.
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// name is the name of the journal service.
+	name = "journal"
+	// filePrefix is the prefix of the name of every journal file.
+	filePrefix = "journal-"
+	// fileExt is the extension of every journal file.
+	fileExt = ".jsonl"
+	// filePerm is the permission journal files are created with.
+	filePerm = 0o600
+	// dirPerm is the permission the journal directory is created with.
+	dirPerm = 0o700
+)
+
+// Journal records the events and requests passing through the brokers and
+// dispatchers of the node to a set of rotating files, one JSON entry per
+// line.
+type Journal struct {
+	cfg    Config
+	logger Logger
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// file is the journal file currently written to, opened on the first
+	// entry.
+	file *os.File
+	// index is the index of the current journal file.
+	index uint64
+	// size is the size of the current journal file.
+	size uint64
+	// seq is the sequence number of the last entry.
+	seq uint64
+	// closed is set once the journal has been closed.
+	closed bool
+}
+
+// New creates a new journal.
+func New(cfg Config, logger Logger) *Journal {
+	return &Journal{
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Name returns the name of the journal service.
+func (j *Journal) Name() string {
+	return name
+}
+
+// Enabled returns true if the journal records entries.
+func (j *Journal) Enabled() bool {
+	return j.cfg.Enabled
+}
+
+// Start is a no-op, the journal file is opened on the first entry.
+func (*Journal) Start(context.Context) error {
+	return nil
+}
+
+// Stop closes the journal.
+func (j *Journal) Stop() error {
+	return j.Close()
+}
+
+// Record appends an entry to the journal, assigning it the next sequence
+// number and, if unset, the current time.
+func (j *Journal) Record(entry Entry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.closed {
+		return ErrClosed
+	}
+
+	j.seq++
+	entry.Seq = j.seq
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	if err = j.rotate(uint64(len(bz))); err != nil {
+		return err
+	}
+	n, err := j.file.Write(bz)
+	j.size += uint64(n)
+	return err
+}
+
+// Close closes the journal. Entries recorded afterwards are rejected.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.closed {
+		return nil
+	}
+	j.closed = true
+	if j.file == nil {
+		return nil
+	}
+	return j.file.Close()
+}
+
+// rotate makes sure there is a journal file with room for n more bytes,
+// opening the first one or moving on to a new one as needed.
+func (j *Journal) rotate(n uint64) error {
+	if j.file == nil {
+		if err := os.MkdirAll(j.cfg.Dir, dirPerm); err != nil {
+			return err
+		}
+		files, err := listFiles(j.cfg.Dir)
+		if err != nil {
+			return err
+		}
+		// continue after the files left by previous runs.
+		if len(files) > 0 {
+			j.index = files[len(files)-1].index + 1
+		}
+		return j.open()
+	}
+
+	if j.cfg.MaxFileSize == 0 || j.size == 0 ||
+		j.size+n <= j.cfg.MaxFileSize {
+		return nil
+	}
+	if err := j.file.Close(); err != nil {
+		return err
+	}
+	j.index++
+	if err := j.open(); err != nil {
+		return err
+	}
+	return j.removeOldest()
+}
+
+// open creates the journal file for the current index.
+func (j *Journal) open() error {
+	file, err := os.OpenFile(
+		filepath.Join(j.cfg.Dir, fileName(j.index)),
+		os.O_CREATE|os.O_EXCL|os.O_WRONLY,
+		filePerm,
+	)
+	if err != nil {
+		return err
+	}
+	j.file = file
+	j.size = 0
+	return nil
+}
+
+// removeOldest removes the journal files beyond the configured maximum.
+func (j *Journal) removeOldest() error {
+	if j.cfg.MaxFiles == 0 {
+		return nil
+	}
+	files, err := listFiles(j.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > int(j.cfg.MaxFiles) {
+		if err = os.Remove(files[0].path); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// fileName returns the name of the journal file with the given index.
+func fileName(index uint64) string {
+	return fmt.Sprintf("%s%06d%s", filePrefix, index, fileExt)
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	"github.com/stretchr/testify/require"
+)
+
+const double = "double"
+
+var errOdd = errors.New("odd")
+
+// uint64Codec encodes uint64s as little-endian bytes.
+type uint64Codec struct{}
+
+func (uint64Codec) Encode(data uint64) ([]byte, uint32, error) {
+	return binary.LittleEndian.AppendUint64(nil, data), 0, nil
+}
+
+func (uint64Codec) Decode(payload []byte, _ uint32) (uint64, error) {
+	if len(payload) != 8 {
+		return 0, errors.New("invalid payload")
+	}
+	return binary.LittleEndian.Uint64(payload), nil
+}
+
+// nopLogger discards every message.
+type nopLogger struct{}
+
+func (nopLogger) Error(string, ...any) {}
+
+// serve doubles every request to d, failing odd ones when failOdd is set.
+func serve(
+	ctx context.Context,
+	t *testing.T,
+	d *dispatcher.Dispatcher[uint64, uint64],
+	failOdd bool,
+) {
+	t.Helper()
+	reqs, err := d.Serve()
+	require.NoError(t, err)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-reqs:
+				if failOdd && req.Data()%2 == 1 {
+					_ = req.Respond(0, errOdd)
+					continue
+				}
+				_ = req.Respond(2*req.Data(), nil)
+			}
+		}
+	}()
+}
+
+func TestJournal_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	j := journal.New(journal.Config{
+		Enabled:     true,
+		Dir:         dir,
+		MaxFileSize: 256,
+		MaxFiles:    2,
+	}, nopLogger{})
+
+	for i := range 20 {
+		require.NoError(t, j.Record(journal.Entry{
+			Source:  "test",
+			Kind:    journal.KindEvent,
+			Type:    double,
+			Payload: []byte{byte(i)},
+		}))
+	}
+	require.NoError(t, j.Close())
+	require.ErrorIs(t, j.Record(journal.Entry{}), journal.ErrClosed)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// only the most recent entries are kept, in order.
+	entries, err := journal.Read(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	require.Less(t, len(entries), 20)
+	for i, entry := range entries {
+		require.Equal(t, uint64(20-len(entries)+i+1), entry.Seq)
+	}
+	require.Equal(t, []byte{19}, entries[len(entries)-1].Payload)
+}
+
+func TestJournal_ReadTruncated(t *testing.T) {
+	dir := t.TempDir()
+	j := journal.New(journal.Config{Enabled: true, Dir: dir}, nopLogger{})
+	for range 3 {
+		require.NoError(t, j.Record(journal.Entry{Source: "test"}))
+	}
+	require.NoError(t, j.Close())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	path := filepath.Join(dir, files[0].Name())
+
+	// a crash may leave the last entry half written.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"seq":4,"source":"te`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	entries, err := journal.Read(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	// a new run continues in a new file.
+	j = journal.New(journal.Config{Enabled: true, Dir: dir}, nopLogger{})
+	require.NoError(t, j.Record(journal.Entry{Source: "test"}))
+	require.NoError(t, j.Close())
+	entries, err = journal.Read(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+}
+
+func TestJournal_Replay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// record a run where every request succeeds.
+	dir := t.TempDir()
+	j := journal.New(journal.Config{Enabled: true, Dir: dir}, nopLogger{})
+	recorded := dispatcher.New[uint64, uint64]("doubler")
+	journal.ObserveDispatcher(j, recorded, uint64Codec{}, uint64Codec{})
+	serve(ctx, t, recorded, false)
+	for i := range uint64(4) {
+		resp, err := recorded.Request(ctx, double, i)
+		require.NoError(t, err)
+		require.Equal(t, 2*i, resp)
+	}
+	require.NoError(t, j.Close())
+
+	entries, err := journal.Read(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 8)
+
+	// replaying through an identical handler does not diverge.
+	same := dispatcher.New[uint64, uint64]("doubler")
+	serve(ctx, t, same, false)
+	replayer := journal.NewReplayer()
+	replayer.Register(
+		"doubler", journal.DispatcherHandler(same, uint64Codec{}, uint64Codec{}),
+	)
+	report, err := replayer.Replay(ctx, entries)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), report.Replayed)
+	require.Empty(t, report.Divergences)
+
+	// replaying through a handler failing odd requests diverges on them.
+	faulty := dispatcher.New[uint64, uint64]("doubler")
+	serve(ctx, t, faulty, true)
+	replayer.Register(
+		"doubler",
+		journal.DispatcherHandler(faulty, uint64Codec{}, uint64Codec{}),
+	)
+	report, err = replayer.Replay(ctx, entries)
+	require.NoError(t, err)
+	require.Len(t, report.Divergences, 2)
+	for _, d := range report.Divergences {
+		require.Empty(t, d.Recorded)
+		require.Equal(t, errOdd.Error(), d.Replayed)
+	}
+
+	// entries of unknown sources are skipped.
+	report, err = journal.NewReplayer().Replay(ctx, entries)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), report.Skipped)
+	require.Zero(t, report.Replayed)
+}
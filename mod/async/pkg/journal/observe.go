@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// ObserveBroker records every event published on b to the journal. The
+// journal subscribes with the blocking policy so that no event is missed.
+func ObserveBroker[DataT any](
+	j *Journal,
+	b *broker.Broker[*asynctypes.Event[DataT]],
+	codec Codec[DataT],
+) error {
+	ch, err := b.SubscribeWithOptions(
+		broker.WithPolicy(broker.PolicyBlock),
+		broker.WithSubscriberName(name),
+	)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for event := range ch {
+			entry := Entry{
+				Source: b.Name(),
+				Kind:   KindEvent,
+				Type:   event.Type(),
+				Error:  errString(event.Error()),
+			}
+			record(j, entry, codec, event.Data())
+		}
+	}()
+	return nil
+}
+
+// ObserveDispatcher records every request sent through d, and the response
+// it resolved to, to the journal. If respCodec is nil, responses are
+// recorded without a payload.
+func ObserveDispatcher[ReqT, RespT any](
+	j *Journal,
+	d *dispatcher.Dispatcher[ReqT, RespT],
+	reqCodec Codec[ReqT],
+	respCodec Codec[RespT],
+) {
+	d.Observe(&dispatcherObserver[ReqT, RespT]{
+		journal:   j,
+		source:    d.Name(),
+		reqCodec:  reqCodec,
+		respCodec: respCodec,
+	})
+}
+
+// dispatcherObserver records the requests of a dispatcher to the journal.
+type dispatcherObserver[ReqT, RespT any] struct {
+	journal   *Journal
+	source    string
+	reqCodec  Codec[ReqT]
+	respCodec Codec[RespT]
+}
+
+// OnRequest records a request.
+func (o *dispatcherObserver[ReqT, _]) OnRequest(
+	id dispatcher.RequestID, eventType asynctypes.EventID, data ReqT,
+) {
+	record(o.journal, Entry{
+		Source: o.source,
+		Kind:   KindRequest,
+		Type:   eventType,
+		ID:     uint64(id),
+	}, o.reqCodec, data)
+}
+
+// OnResponse records the response a request resolved to.
+func (o *dispatcherObserver[_, RespT]) OnResponse(
+	id dispatcher.RequestID,
+	eventType asynctypes.EventID,
+	data RespT,
+	err error,
+) {
+	entry := Entry{
+		Source: o.source,
+		Kind:   KindResponse,
+		Type:   eventType,
+		ID:     uint64(id),
+		Error:  errString(err),
+	}
+	// a failed request has no response to record.
+	if err != nil || o.respCodec == nil {
+		o.journal.write(entry)
+		return
+	}
+	record(o.journal, entry, o.respCodec, data)
+}
+
+// record encodes data as the payload of entry and records it. Failures are
+// logged rather than returned, as they must never affect the node.
+func record[T any](j *Journal, entry Entry, codec Codec[T], data T) {
+	payload, version, err := codec.Encode(data)
+	if err != nil {
+		j.logger.Error(
+			"failed to encode journal entry",
+			"source", entry.Source, "type", entry.Type, "error", err,
+		)
+	}
+	entry.Payload, entry.Version = payload, version
+	j.write(entry)
+}
+
+// write records entry, logging any failure other than the journal having
+// been closed.
+func (j *Journal) write(entry Entry) {
+	if err := j.Record(entry); err != nil && !errors.Is(err, ErrClosed) {
+		j.logger.Error(
+			"failed to record journal entry",
+			"source", entry.Source, "type", entry.Type, "error", err,
+		)
+	}
+}
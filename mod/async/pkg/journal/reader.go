@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// file is a journal file found on disk.
+type file struct {
+	path  string
+	index uint64
+}
+
+// Read reads every entry of the journal at path, which is either a single
+// journal file or a directory of rotated journal files, read in the order
+// they were written.
+func Read(path string) ([]Entry, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readFile(path)
+	}
+
+	files, err := listFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, ErrNoJournalFiles
+	}
+
+	var entries []Entry
+	for _, f := range files {
+		fileEntries, err := readFile(f.path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+	return entries, nil
+}
+
+// readFile reads the entries of a single journal file.
+func readFile(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	dec := json.NewDecoder(f)
+	for {
+		var entry Entry
+		err = dec.Decode(&entry)
+		switch {
+		case errors.Is(err, io.EOF):
+			return entries, nil
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			// the last entry of a journal written by a crashed node may be
+			// truncated.
+			return entries, nil
+		case err != nil:
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// listFiles returns the journal files in dir ordered by index.
+func listFiles(dir string) ([]file, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []file
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasPrefix(name, filePrefix) ||
+			!strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		index, err := strconv.ParseUint(
+			strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), fileExt),
+			10, 64,
+		)
+		if err != nil {
+			continue
+		}
+		files = append(files, file{
+			path:  filepath.Join(dir, name),
+			index: index,
+		})
+	}
+	slices.SortFunc(files, func(a, b file) int {
+		return cmp.Compare(a.index, b.index)
+	})
+	return files, nil
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// Handler replays a recorded request and returns the encoded response it
+// now resolves to.
+type Handler func(ctx context.Context, entry Entry) ([]byte, error)
+
+// DispatcherHandler returns a handler replaying requests through d. If
+// respCodec is nil, responses are only compared by their error.
+func DispatcherHandler[ReqT, RespT any](
+	d *dispatcher.Dispatcher[ReqT, RespT],
+	reqCodec Codec[ReqT],
+	respCodec Codec[RespT],
+) Handler {
+	return func(ctx context.Context, entry Entry) ([]byte, error) {
+		data, err := reqCodec.Decode(entry.Payload, entry.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode request: %w", err)
+		}
+		resp, err := d.Request(ctx, entry.Type, data)
+		if err != nil || respCodec == nil {
+			return nil, err
+		}
+		payload, _, err := respCodec.Encode(resp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode response: %w", err)
+		}
+		return payload, nil
+	}
+}
+
+// Divergence is a request whose replay resolved differently than recorded.
+type Divergence struct {
+	// Seq is the sequence number of the recorded request.
+	Seq uint64
+	// Source is the dispatcher the request was sent through.
+	Source string
+	// Type is the event type of the request.
+	Type asynctypes.EventID
+	// Recorded is the error the request resolved to when recorded.
+	Recorded string
+	// Replayed is the error the request resolved to when replayed.
+	Replayed string
+	// PayloadMismatch is set if the responses differ in their payload.
+	PayloadMismatch bool
+}
+
+// String returns a human readable description of the divergence.
+func (d Divergence) String() string {
+	switch {
+	case d.Recorded != d.Replayed:
+		return fmt.Sprintf(
+			"#%d %s %s: recorded error %q, replayed error %q",
+			d.Seq, d.Source, d.Type, d.Recorded, d.Replayed,
+		)
+	default:
+		return fmt.Sprintf(
+			"#%d %s %s: response payloads differ",
+			d.Seq, d.Source, d.Type,
+		)
+	}
+}
+
+// Report summarises a replay.
+type Report struct {
+	// Replayed is the number of requests replayed.
+	Replayed uint64
+	// Skipped is the number of entries that were not replayed, either
+	// because they are events or because no handler is registered for
+	// their source.
+	Skipped uint64
+	// Unresolved is the number of replayed requests without a recorded
+	// response to compare against.
+	Unresolved uint64
+	// Divergences are the replayed requests that resolved differently than
+	// recorded, in journal order.
+	Divergences []Divergence
+}
+
+// Replayer feeds the requests of a journal back through registered handlers
+// and compares their responses with the recorded ones.
+type Replayer struct {
+	handlers map[string]Handler
+}
+
+// NewReplayer creates a new replayer.
+func NewReplayer() *Replayer {
+	return &Replayer{
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register registers the handler replaying the requests recorded from the
+// given source.
+func (r *Replayer) Register(source string, handler Handler) {
+	r.handlers[source] = handler
+}
+
+// replayed is a replayed request awaiting its recorded response.
+type replayed struct {
+	request Entry
+	payload []byte
+	err     error
+}
+
+// key identifies a request within a run of the node.
+type key struct {
+	source string
+	id     uint64
+}
+
+// Replay replays entries in order. Every request is replayed as soon as it
+// is read and compared with its response once the response is read.
+func (r *Replayer) Replay(
+	ctx context.Context, entries []Entry,
+) (*Report, error) {
+	report := &Report{}
+	pending := make(map[key]replayed)
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		handler, ok := r.handlers[entry.Source]
+		if !ok || entry.Kind == KindEvent {
+			report.Skipped++
+			continue
+		}
+
+		k := key{source: entry.Source, id: entry.ID}
+		switch entry.Kind {
+		case KindRequest:
+			payload, err := handler(ctx, entry)
+			report.Replayed++
+			// request IDs restart with the node, so a request left
+			// unresolved by a previous run is superseded.
+			if _, ok = pending[k]; ok {
+				report.Unresolved++
+			}
+			pending[k] = replayed{
+				request: entry,
+				payload: payload,
+				err:     err,
+			}
+		case KindResponse:
+			req, found := pending[k]
+			if !found {
+				report.Skipped++
+				continue
+			}
+			delete(pending, k)
+			if d, diverged := compare(req, entry); diverged {
+				report.Divergences = append(report.Divergences, d)
+			}
+		}
+	}
+	report.Unresolved += uint64(len(pending))
+	return report, nil
+}
+
+// compare compares a replayed request with its recorded response.
+func compare(req replayed, resp Entry) (Divergence, bool) {
+	d := Divergence{
+		Seq:      req.request.Seq,
+		Source:   req.request.Source,
+		Type:     req.request.Type,
+		Recorded: resp.Error,
+		Replayed: errString(req.err),
+	}
+	// responses are only compared by payload if one was recorded.
+	d.PayloadMismatch = d.Recorded == "" && d.Replayed == "" &&
+		len(resp.Payload) > 0 && !bytes.Equal(resp.Payload, req.payload)
+	return d, d.Recorded != d.Replayed || d.PayloadMismatch
+}
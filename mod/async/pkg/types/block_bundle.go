@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// BlockBundle pairs a beacon block with the blob sidecars built for it.
+type BlockBundle[BeaconBlockT, BlobSidecarsT any] struct {
+	// block is the beacon block.
+	block BeaconBlockT
+	// sidecars are the blob sidecars of the block.
+	sidecars BlobSidecarsT
+}
+
+// NewBlockBundle creates a new BlockBundle.
+func NewBlockBundle[BeaconBlockT, BlobSidecarsT any](
+	block BeaconBlockT, sidecars BlobSidecarsT,
+) *BlockBundle[BeaconBlockT, BlobSidecarsT] {
+	return &BlockBundle[BeaconBlockT, BlobSidecarsT]{
+		block:    block,
+		sidecars: sidecars,
+	}
+}
+
+// Block returns the beacon block of the bundle.
+func (b *BlockBundle[BeaconBlockT, _]) Block() BeaconBlockT {
+	return b.block
+}
+
+// Sidecars returns the blob sidecars of the bundle.
+func (b *BlockBundle[_, BlobSidecarsT]) Sidecars() BlobSidecarsT {
+	return b.sidecars
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// backfillRetryInterval is the time to wait before retrying after the
+	// block source could not be reached.
+	backfillRetryInterval = 5 * time.Second
+	// backfillLogInterval is the number of slots between progress reports.
+	backfillLogInterval = 256
+)
+
+// BackfillService fills the block store with the historical blocks committed
+// by the consensus engine, walking backwards from the head. Slots that are
+// already stored are skipped, so an interrupted backfill resumes where it
+// left off on the next start.
+type BackfillService[
+	BeaconBlockT BackfillBlock[BeaconBlockT],
+	BlockStoreT BackfillStore[BeaconBlockT],
+] struct {
+	// config is the configuration for the block service.
+	config Config
+	// logger is used for logging information and errors.
+	logger log.Logger[any]
+	// chainSpec is used to determine the fork version of a slot.
+	chainSpec ChainSpec
+	// source provides the blocks committed by the consensus engine.
+	source BlockSource
+	// store is the block store to backfill.
+	store BlockStoreT
+	// txIndex is the index of the beacon block in a block's transactions.
+	txIndex uint
+	// metrics is used to report the backfill progress.
+	metrics *backfillMetrics
+}
+
+// NewBackfillService creates a new block backfill service.
+func NewBackfillService[
+	BeaconBlockT BackfillBlock[BeaconBlockT],
+	BlockStoreT BackfillStore[BeaconBlockT],
+](
+	config Config,
+	logger log.Logger[any],
+	chainSpec ChainSpec,
+	source BlockSource,
+	store BlockStoreT,
+	txIndex uint,
+	telemetrySink TelemetrySink,
+) *BackfillService[BeaconBlockT, BlockStoreT] {
+	return &BackfillService[BeaconBlockT, BlockStoreT]{
+		config:    config,
+		logger:    logger,
+		chainSpec: chainSpec,
+		source:    source,
+		store:     store,
+		txIndex:   txIndex,
+		metrics:   newBackfillMetrics(telemetrySink),
+	}
+}
+
+// Name returns the name of the service.
+func (s *BackfillService[_, _]) Name() string {
+	return "block-backfill-service"
+}
+
+// Start starts backfilling the block store in the background.
+func (s *BackfillService[_, _]) Start(ctx context.Context) error {
+	if !s.config.Enabled || s.config.BackfillDepth == 0 {
+		return nil
+	}
+	go s.run(ctx)
+	return nil
+}
+
+// run backfills the block store, retrying until it succeeds or the context
+// is cancelled.
+func (s *BackfillService[_, _]) run(ctx context.Context) {
+	for {
+		err := s.backfill(ctx)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		s.logger.Error(
+			"failed to backfill blocks, retrying",
+			"retry_in", backfillRetryInterval, "error", err,
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backfillRetryInterval):
+		}
+	}
+}
+
+// backfill stores every missing block from the head of the block source down
+// to the configured depth.
+func (s *BackfillService[_, _]) backfill(ctx context.Context) error {
+	head, err := s.source.Head(ctx)
+	if err != nil {
+		return err
+	}
+	base, err := s.source.Base(ctx)
+	if err != nil {
+		return err
+	}
+
+	lowest := s.lowestSlot(head, base)
+	if head < lowest {
+		return nil
+	}
+	total := head.Unwrap() - lowest.Unwrap() + 1
+	s.logger.Info(
+		"backfilling block store",
+		"from", head, "to", lowest, "slots", total,
+	)
+
+	var filled uint64
+	for slot := head; slot >= lowest; slot-- {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		stored, err := s.backfillSlot(ctx, slot)
+		if err != nil {
+			return errors.Wrapf(err, "slot %d", slot)
+		}
+		if stored {
+			filled++
+			s.metrics.markBlockBackfilled(slot)
+		}
+
+		done := head.Unwrap() - slot.Unwrap() + 1
+		if done%backfillLogInterval == 0 {
+			s.logger.Info(
+				"backfilling block store",
+				"slot", slot, "progress", done, "total", total,
+				"filled", filled,
+			)
+		}
+	}
+
+	s.logger.Info(
+		"finished backfilling block store",
+		"lowest_slot", lowest, "filled", filled,
+	)
+	return nil
+}
+
+// lowestSlot returns the lowest slot to backfill, which is bounded by the
+// configured depth, the availability window and the lowest slot still held
+// by the block source. The genesis slot is never committed as a block.
+func (s *BackfillService[_, _]) lowestSlot(head, base math.Slot) math.Slot {
+	depth := min(s.config.BackfillDepth, s.config.AvailabilityWindow)
+	if head.Unwrap() < depth {
+		return max(base, 1)
+	}
+	return max(base, head-math.Slot(depth), 1)
+}
+
+// backfillSlot stores the block committed at the given slot if it is not
+// already stored. It returns whether a block was stored.
+func (s *BackfillService[BeaconBlockT, _]) backfillSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (bool, error) {
+	if ok, err := s.store.Has(slot); err != nil || ok {
+		return false, err
+	}
+
+	txs, err := s.source.BlockTxs(ctx, slot)
+	if err != nil {
+		return false, err
+	}
+	if uint(len(txs)) <= s.txIndex {
+		s.logger.Warn("no beacon block committed at slot", "slot", slot)
+		return false, nil
+	}
+
+	var blk BeaconBlockT
+	blk, err = blk.NewFromSSZ(
+		txs[s.txIndex], s.chainSpec.ActiveForkVersionForSlot(slot),
+	)
+	if err != nil {
+		return false, err
+	}
+	if blk.GetSlot() != slot {
+		return false, errors.Wrapf(
+			ErrUnexpectedBlockSlot, "got %d", blk.GetSlot(),
+		)
+	}
+	return true, s.store.Set(slot, blk)
+}
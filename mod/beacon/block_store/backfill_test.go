@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockstore
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a beacon block encoded as its slot.
+type testBlock struct {
+	slot math.Slot
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return binary.BigEndian.AppendUint64(nil, b.slot.Unwrap()), nil
+}
+
+func (b *testBlock) GetSlot() math.U64 {
+	return b.slot
+}
+
+func (*testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	if len(bz) != 8 {
+		return nil, errors.New("invalid block")
+	}
+	return &testBlock{slot: math.Slot(binary.BigEndian.Uint64(bz))}, nil
+}
+
+// testBlockSource is a block source holding a block at every slot from
+// its base to its head, unless the slot is marked as empty.
+type testBlockSource struct {
+	base, head math.Slot
+	// empty are the slots without a block.
+	empty map[math.Slot]bool
+	// failures are the slots that fail to be read once.
+	failures map[math.Slot]bool
+	// reads are the slots read, in order.
+	reads []math.Slot
+}
+
+func (s *testBlockSource) Base(context.Context) (math.Slot, error) {
+	return s.base, nil
+}
+
+func (s *testBlockSource) Head(context.Context) (math.Slot, error) {
+	return s.head, nil
+}
+
+func (s *testBlockSource) BlockTxs(
+	_ context.Context, slot math.Slot,
+) ([][]byte, error) {
+	s.reads = append(s.reads, slot)
+	if s.failures[slot] {
+		delete(s.failures, slot)
+		return nil, errors.New("connection refused")
+	}
+	if s.empty[slot] {
+		return nil, nil
+	}
+	bz, err := (&testBlock{slot: slot}).MarshalSSZ()
+	return [][]byte{bz}, err
+}
+
+type testBlockStore struct {
+	blocks map[math.Slot]*testBlock
+}
+
+func (s *testBlockStore) Has(slot math.Slot) (bool, error) {
+	_, ok := s.blocks[slot]
+	return ok, nil
+}
+
+func (s *testBlockStore) Set(slot math.Slot, blk *testBlock) error {
+	s.blocks[slot] = blk
+	return nil
+}
+
+// slots returns the number of stored blocks from the given slot to the
+// given slot, inclusive.
+func (s *testBlockStore) slots(from, to math.Slot) int {
+	var n int
+	for slot := from; slot <= to; slot++ {
+		if _, ok := s.blocks[slot]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// testSink records the backfill metrics.
+type testSink struct {
+	mu     sync.Mutex
+	blocks int
+	lowest int64
+}
+
+func (s *testSink) IncrementCounter(string, ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blocks++
+}
+
+func (s *testSink) SetGauge(_ string, value int64, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lowest = value
+}
+
+// testLogger records the progress reported by the backfill.
+type testLogger struct {
+	progress []any
+}
+
+func (l *testLogger) Info(_ string, keyVals ...any) {
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		if keyVals[i] == "progress" {
+			l.progress = append(l.progress, keyVals[i+1])
+		}
+	}
+}
+
+func (*testLogger) Warn(string, ...any) {}
+
+func (*testLogger) Error(string, ...any) {}
+
+func (*testLogger) Debug(string, ...any) {}
+
+type testChainSpec struct{}
+
+func (testChainSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return 0
+}
+
+func newTestBackfill(
+	depth uint64,
+	source BlockSource,
+) (
+	*BackfillService[*testBlock, *testBlockStore],
+	*testBlockStore,
+	*testSink,
+	*testLogger,
+) {
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.BackfillDepth = depth
+	store := &testBlockStore{blocks: make(map[math.Slot]*testBlock)}
+	sink := &testSink{}
+	logger := &testLogger{}
+	return NewBackfillService[*testBlock, *testBlockStore](
+		cfg, logger, testChainSpec{}, source, store, 0, sink,
+	), store, sink, logger
+}
+
+func TestBackfill(t *testing.T) {
+	source := &testBlockSource{
+		base: 1, head: 600,
+		empty: map[math.Slot]bool{300: true},
+	}
+	s, store, sink, logger := newTestBackfill(550, source)
+
+	require.NoError(t, s.backfill(context.Background()))
+	require.Equal(t, 550, store.slots(50, 600))
+	require.Zero(t, store.slots(1, 49))
+	require.Equal(t, 550, sink.blocks)
+	require.Equal(t, int64(50), sink.lowest)
+	require.Equal(t, []any{uint64(256), uint64(512)}, logger.progress)
+}
+
+func TestBackfillResumesAfterFailure(t *testing.T) {
+	source := &testBlockSource{
+		base: 1, head: 20,
+		failures: map[math.Slot]bool{12: true},
+	}
+	s, store, sink, _ := newTestBackfill(10, source)
+
+	err := s.backfill(context.Background())
+	require.ErrorContains(t, err, "slot 12")
+	require.Equal(t, 8, store.slots(13, 20))
+	require.Equal(t, 8, sink.blocks)
+
+	// The blocks stored before the failure are not read again, and the
+	// head moved on in the meantime.
+	source.reads = nil
+	source.head = 22
+	require.NoError(t, s.backfill(context.Background()))
+	require.Equal(t, 11, store.slots(12, 22))
+	require.Equal(t, []math.Slot{22, 21, 12}, source.reads)
+	require.Equal(t, 11, sink.blocks)
+	require.Equal(t, int64(12), sink.lowest)
+}
+
+func TestBackfillUnexpectedBlockSlot(t *testing.T) {
+	s, store, _, _ := newTestBackfill(10, &shiftedBlockSource{
+		testBlockSource{base: 1, head: 5},
+	})
+
+	require.ErrorIs(t,
+		s.backfill(context.Background()), ErrUnexpectedBlockSlot,
+	)
+	require.Zero(t, store.slots(1, 5))
+}
+
+// shiftedBlockSource serves the block of the next slot at every slot.
+type shiftedBlockSource struct {
+	testBlockSource
+}
+
+func (s *shiftedBlockSource) BlockTxs(
+	ctx context.Context, slot math.Slot,
+) ([][]byte, error) {
+	return s.testBlockSource.BlockTxs(ctx, slot+1)
+}
+
+func TestBackfillLowestSlot(t *testing.T) {
+	tests := []struct {
+		name       string
+		depth      uint64
+		window     uint64
+		head, base math.Slot
+		expected   math.Slot
+	}{
+		{
+			name:  "bounded by depth",
+			depth: 10, window: 100, head: 50, base: 1,
+			expected: 40,
+		},
+		{
+			name:  "bounded by availability window",
+			depth: 100, window: 10, head: 50, base: 1,
+			expected: 40,
+		},
+		{
+			name:  "bounded by block source base",
+			depth: 10, window: 100, head: 50, base: 45,
+			expected: 45,
+		},
+		{
+			name:  "genesis is never backfilled",
+			depth: 100, window: 100, head: 50, base: 0,
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _, _, _ := newTestBackfill(tt.depth, &testBlockSource{})
+			s.config.AvailabilityWindow = tt.window
+			require.Equal(t, tt.expected, s.lowestSlot(tt.head, tt.base))
+		})
+	}
+}
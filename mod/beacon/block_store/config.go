@@ -32,6 +32,10 @@ type Config struct {
 	PrunerEnabled bool `mapstructure:"pruner-enabled"`
 	// AvailabilityWindow is the number of slots to keep in the store.
 	AvailabilityWindow uint64 `mapstructure:"availability-window"`
+	// BackfillDepth is the number of slots below the head to backfill from
+	// the consensus engine on startup. It is capped at the availability
+	// window and 0 disables backfilling.
+	BackfillDepth uint64 `mapstructure:"backfill-depth"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
@@ -40,5 +44,6 @@ func DefaultConfig() Config {
 		Enabled:            false,
 		PrunerEnabled:      false,
 		AvailabilityWindow: DefaultAvailabilityWindow,
+		BackfillDepth:      0,
 	}
 }
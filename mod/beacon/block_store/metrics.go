@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockstore
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// backfillMetrics is a struct that contains metrics for the block backfill.
+type backfillMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newBackfillMetrics creates a new backfillMetrics.
+func newBackfillMetrics(sink TelemetrySink) *backfillMetrics {
+	return &backfillMetrics{
+		sink: sink,
+	}
+}
+
+// markBlockBackfilled reports that the block at the given slot has been
+// backfilled.
+func (m *backfillMetrics) markBlockBackfilled(slot math.Slot) {
+	m.sink.IncrementCounter("beacon_kit.block_store.backfill.blocks")
+	//#nosec:G701 // slots never exceed the maximum int64.
+	m.sink.SetGauge(
+		"beacon_kit.block_store.backfill.lowest_slot", int64(slot.Unwrap()),
+	)
+}
@@ -21,6 +21,8 @@
 package blockstore
 
 import (
+	"context"
+
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -39,6 +41,39 @@ type BlockStore[BeaconBlockT BeaconBlock] interface {
 	Set(index math.Slot, blk BeaconBlockT) error
 }
 
+// BackfillBlock is a beacon block that can be decoded from the consensus
+// engine's block store.
+type BackfillBlock[T any] interface {
+	BeaconBlock
+	// NewFromSSZ creates a new beacon block from the given SSZ bytes and
+	// fork version.
+	NewFromSSZ([]byte, uint32) (T, error)
+}
+
+// BackfillStore is a block store that can be backfilled.
+type BackfillStore[BeaconBlockT BeaconBlock] interface {
+	BlockStore[BeaconBlockT]
+	// Has returns whether a block is stored at a given index.
+	Has(index math.Slot) (bool, error)
+}
+
+// BlockSource provides the blocks committed by the consensus engine.
+type BlockSource interface {
+	// Base returns the lowest slot available in the source.
+	Base(ctx context.Context) (math.Slot, error)
+	// Head returns the latest slot committed to the source.
+	Head(ctx context.Context) (math.Slot, error)
+	// BlockTxs returns the transactions of the block at the given slot.
+	BlockTxs(ctx context.Context, slot math.Slot) ([][]byte, error)
+}
+
+// ChainSpec defines an interface for accessing chain-specific parameters.
+type ChainSpec interface {
+	// ActiveForkVersionForSlot returns the active fork version for a given
+	// slot.
+	ActiveForkVersionForSlot(slot math.Slot) uint32
+}
+
 // Event is an interface for block events.
 type Event[BeaconBlockT BeaconBlock] interface {
 	// Type returns the type of the event.
@@ -54,3 +89,13 @@ type EventFeed[EventT any] interface {
 	// Subscribe returns a channel that will receive events.
 	Subscribe() (chan EventT, error)
 }
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by
+	// the provided key.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets the gauge identified by the provided key to the
+	// provided value.
+	SetGauge(key string, value int64, args ...string)
+}
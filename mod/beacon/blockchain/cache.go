@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	lru "github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// verifiedProposalsCacheSize is the maximum number of verified proposals
+// kept around until the next block is finalized. More than one proposal may
+// be verified for a height if consensus goes through several rounds.
+const verifiedProposalsCacheSize = 8
+
+// verifiedProposalKey identifies a proposal that was verified against a
+// given parent state.
+type verifiedProposalKey struct {
+	// blockRoot is the hash tree root of the verified block.
+	blockRoot common.Root
+	// parentStateRoot is the hash tree root of the state the block was
+	// verified against.
+	parentStateRoot common.Root
+}
+
+// verifiedProposal holds the outcome of verifying a proposal, so that it can
+// be reused when the very same block is finalized.
+type verifiedProposal struct {
+	// valUpdates are the validator updates produced by the state transition.
+	valUpdates transition.ValidatorUpdates
+	// applyStateChanges writes the post state of the state transition onto
+	// the state held by the given context.
+	applyStateChanges func(context.Context)
+}
+
+// newVerifiedProposalsCache creates a new cache for verified proposals.
+func newVerifiedProposalsCache() *lru.LRU[
+	verifiedProposalKey, *verifiedProposal,
+] {
+	return lru.NewLRU[verifiedProposalKey, *verifiedProposal](
+		verifiedProposalsCacheSize, nil, 0,
+	)
+}
@@ -23,11 +23,12 @@ package blockchain
 import (
 	"context"
 	"time"
-
-	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 )
 
-// sendPostBlockFCU sends a forkchoice update to the execution client.
+// sendPostBlockFCU sends a forkchoice update with attributes to the execution
+// client to build the payload for the next slot, unless payloads are built
+// optimistically. The forkchoice update to the block itself is sent by the
+// execution sync service.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
 ]) sendPostBlockFCU(
@@ -35,6 +36,10 @@ func (s *Service[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) {
+	if s.shouldBuildOptimisticPayloads() || !s.lb.Enabled() {
+		return
+	}
+
 	lph, err := st.GetLatestExecutionPayloadHeader()
 	if err != nil {
 		s.logger.Error(
@@ -43,12 +48,7 @@ func (s *Service[
 		)
 		return
 	}
-
-	if !s.shouldBuildOptimisticPayloads() && s.lb.Enabled() {
-		s.sendNextFCUWithAttributes(ctx, st, blk, lph)
-	} else {
-		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
-	}
+	s.sendNextFCUWithAttributes(ctx, st, blk, lph)
 }
 
 // sendNextFCUWithAttributes sends a forkchoice update to the execution
@@ -89,36 +89,6 @@ func (s *Service[
 	}
 }
 
-// sendNextFCUWithoutAttributes sends a forkchoice update to the
-// execution client without attributes.
-func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _,
-	ExecutionPayloadHeaderT, _, PayloadAttributesT, _,
-]) sendNextFCUWithoutAttributes(
-	ctx context.Context,
-	blk BeaconBlockT,
-	lph ExecutionPayloadHeaderT,
-) {
-	if _, _, err := s.ee.NotifyForkchoiceUpdate(
-		ctx,
-		// TODO: Switch to New().
-		engineprimitives.
-			BuildForkchoiceUpdateRequestNoAttrs[PayloadAttributesT](
-			&engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
-				SafeBlockHash:      lph.GetParentHash(),
-				FinalizedBlockHash: lph.GetParentHash(),
-			},
-			s.cs.ActiveForkVersionForSlot(blk.GetSlot()),
-		),
-	); err != nil {
-		s.logger.Error(
-			"failed to send forkchoice update without attributes",
-			"error", err,
-		)
-	}
-}
-
 // calculateNextTimestamp calculates the next timestamp for an execution
 // payload.
 //
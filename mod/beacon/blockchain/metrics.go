@@ -116,3 +116,19 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// markVerifiedProposalCacheHit increments the counter for the number of
+// finalized blocks that reused the outcome of their proposal verification.
+func (cm *chainMetrics) markVerifiedProposalCacheHit() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.verified_proposal_cache.hit",
+	)
+}
+
+// markVerifiedProposalCacheMiss increments the counter for the number of
+// finalized blocks that had to be fully re-executed.
+func (cm *chainMetrics) markVerifiedProposalCacheMiss() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.verified_proposal_cache.miss",
+	)
+}
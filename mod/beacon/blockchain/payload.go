@@ -27,33 +27,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// forceStartupHead sends a force head FCU to the execution client.
-func (s *Service[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _,
-]) forceStartupHead(
-	ctx context.Context,
-	st BeaconStateT,
-) {
-	slot, err := st.GetSlot()
-	if err != nil {
-		s.logger.Error(
-			"failed to get slot for force startup head",
-			"error", err,
-		)
-		return
-	}
-
-	// TODO: Verify if the slot number is correct here, I believe in current
-	// form
-	// it should be +1'd. Not a big deal until hardforks are in play though.
-	if err = s.lb.SendForceHeadFCU(ctx, st, slot+1); err != nil {
-		s.logger.Error(
-			"failed to send force head FCU",
-			"error", err,
-		)
-	}
-}
-
 // handleRebuildPayloadForRejectedBlock handles the case where the incoming
 // block was rejected and we need to rebuild the payload for the current slot.
 func (s *Service[
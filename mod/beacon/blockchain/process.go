@@ -58,13 +58,15 @@ func (s *Service[
 		return nil, ErrNilBlk
 	}
 
-	// We set `OptimisticEngine` to true since this is called during
-	// FinalizeBlock. We want to assume the payload is valid. If it
+	// If the very same block was verified against the very same state
+	// during ProcessProposal, we reuse the outcome of its state transition.
+	// Otherwise we set `OptimisticEngine` to true since this is called
+	// during FinalizeBlock. We want to assume the payload is valid. If it
 	// ends up not being valid later, the node will simply AppHash,
 	// which is completely fine. This means we were syncing from a
 	// bad peer, and we would likely AppHash anyways.
 	st := s.sb.StateFromContext(ctx)
-	valUpdates, err := s.executeStateTransition(ctx, st, blk)
+	valUpdates, err := s.finalizeStateTransition(ctx, st, blk)
 	if err != nil {
 		return nil, err
 	}
@@ -78,11 +80,8 @@ func (s *Service[
 		return nil, ErrDataNotAvailable
 	}
 
-	// If required, we want to forkchoice at the end of post
-	// block processing.
-	// TODO: this is hood as fuck.
-	// We won't send a fcu if the block is bad, should be addressed
-	// via ticker later.
+	// Publishing the finalized block lets the execution sync service
+	// forkchoice the execution client to it.
 	if err = s.blkBroker.Publish(ctx,
 		asynctypes.NewEvent(
 			ctx, events.BeaconBlockFinalized, blk,
@@ -96,6 +95,39 @@ func (s *Service[
 	return valUpdates.RemoveDuplicates().Sort(), nil
 }
 
+// finalizeStateTransition applies the state transition of the block, reusing
+// the outcome of its verification if it is cached.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
+]) finalizeStateTransition(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (transition.ValidatorUpdates, error) {
+	// Verified proposals for this height are of no use past this point.
+	defer s.verifiedProposals.Purge()
+
+	var (
+		proposal *verifiedProposal
+		ok       bool
+	)
+	// Hashing the state is only worth it if there is anything to hit.
+	if s.verifiedProposals.Len() > 0 {
+		proposal, ok = s.verifiedProposals.Get(verifiedProposalKey{
+			blockRoot:       blk.HashTreeRoot(),
+			parentStateRoot: st.HashTreeRoot(),
+		})
+	}
+	if !ok {
+		s.metrics.markVerifiedProposalCacheMiss()
+		return s.executeStateTransition(ctx, st, blk)
+	}
+
+	s.metrics.markVerifiedProposalCacheHit()
+	proposal.applyStateChanges(ctx)
+	return proposal.valUpdates, nil
+}
+
 // executeStateTransition runs the stf.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
@@ -26,6 +26,7 @@ import (
 
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -51,12 +52,6 @@ func (s *Service[
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.sb.StateFromContext(ctx)
 
-	// Force a sync of the startup head if we haven't done so already.
-	//
-	// TODO: This is a super hacky. It should be handled better elsewhere,
-	// ideally via some broader sync service.
-	s.forceStartupSyncOnce.Do(func() { s.forceStartupHead(ctx, preState) })
-
 	// If the block is nil or a nil pointer, exit early.
 	if blk.IsNil() {
 		s.logger.Warn(
@@ -70,16 +65,21 @@ func (s *Service[
 		"state_root", blk.GetStateRoot(),
 	)
 
-	// We purposefully make a copy of the BeaconState in orer
-	// to avoid modifying the underlying state, for the event in which
-	// we have to rebuild a payload for this slot again, if we do not agree
-	// with the incoming block.
-	postState := preState.Copy()
+	// We purposefully verify the block against a recorded branch of the
+	// BeaconState in order to avoid modifying the underlying state, for the
+	// event in which we have to rebuild a payload for this slot again, if we
+	// do not agree with the incoming block. The recorded writes are then
+	// reused to finalize the block if it ends up being committed.
+	preSlot, err := preState.GetSlot()
+	if err != nil {
+		return err
+	}
+	recordCtx, applyStateChanges := s.sb.RecordStateChanges(ctx)
+	postState := s.sb.StateFromContext(recordCtx)
 
 	// Verify the state root of the incoming block.
-	if err := s.verifyStateRoot(
-		ctx, postState, blk,
-	); err != nil {
+	valUpdates, complete, err := s.verifyStateRoot(ctx, postState, blk)
+	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
 			"state_root",
@@ -101,24 +101,34 @@ func (s *Service[
 		blk.GetStateRoot(),
 	)
 
+	// Only a fully executed state transition can be reused at finalization.
+	if complete {
+		s.cacheVerifiedProposal(
+			postState, preSlot, blk, valUpdates, applyStateChanges,
+		)
+	}
+
 	if s.shouldBuildOptimisticPayloads() {
-		go s.handleOptimisticPayloadBuild(ctx, postState, blk)
+		// The payload build advances the state, so it must not write to the
+		// recorded post state.
+		go s.handleOptimisticPayloadBuild(ctx, postState.Copy(), blk)
 	}
 
 	return nil
 }
 
-// verifyStateRoot verifies the state root of an incoming block.
+// verifyStateRoot verifies the state root of an incoming block. It reports
+// whether the state transition was fully executed.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
 ]) verifyStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) error {
+) (transition.ValidatorUpdates, bool, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
-	if _, err := s.sp.Transition(
+	valUpdates, err := s.sp.Transition(
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
 		&transition.Context{
@@ -129,18 +139,54 @@ func (s *Service[
 			SkipValidateRandao:      false,
 		},
 		st, blk,
-	); errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
+	)
+	if errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
 		// It is safe for the validator to ignore this error since
 		// the state transition will enforce that the block is part
 		// of the canonical chain.
 		//
 		// TODO: this is only true because we are assuming SSF.
-		return nil
+		return nil, false, nil
 	} else if err != nil {
-		return err
+		return nil, false, err
 	}
 
-	return nil
+	return valUpdates, true, nil
+}
+
+// cacheVerifiedProposal caches the outcome of verifying a block, keyed by the
+// block root and the root of the state it was verified against.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
+]) cacheVerifiedProposal(
+	postState BeaconStateT,
+	preSlot math.Slot,
+	blk BeaconBlockT,
+	valUpdates transition.ValidatorUpdates,
+	applyStateChanges func(context.Context),
+) {
+	// The state transition stores the root of the pre state in the state
+	// roots history, which spares us from hashing the pre state again.
+	parentStateRoot, err := postState.StateRootAtIndex(
+		preSlot.Unwrap() % s.cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed to cache verified beacon block", "error", err,
+		)
+		return
+	}
+
+	s.verifiedProposals.Add(
+		verifiedProposalKey{
+			blockRoot:       blk.HashTreeRoot(),
+			parentStateRoot: parentStateRoot,
+		},
+		&verifiedProposal{
+			valUpdates:        valUpdates,
+			applyStateChanges: applyStateChanges,
+		},
+	)
 }
 
 // shouldBuildOptimisticPayloads returns true if optimistic
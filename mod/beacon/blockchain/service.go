@@ -22,13 +22,14 @@ package blockchain
 
 import (
 	"context"
-	"sync"
 
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	lru "github.com/hashicorp/golang-lru/v2/expirable"
 )
 
 // Service is the blockchain service.
@@ -62,9 +63,6 @@ type Service[
 	logger log.Logger[any]
 	// cs holds the chain specifications.
 	cs common.ChainSpec
-	// ee is the execution engine responsible for processing execution payloads.
-
-	ee ExecutionEngine[PayloadAttributesT]
 	// lb is a local builder for constructing new beacon states.
 	lb LocalBuilder[BeaconStateT]
 	// sp is the state processor for beacon blocks and states.
@@ -77,17 +75,18 @@ type Service[
 	]
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
-	// genesisBroker is the event feed for genesis data.
-	genesisBroker EventFeed[*asynctypes.Event[GenesisT]]
-	// blkBroker is the event feed for new blocks.
-	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]]
-	// validatorUpdateBroker is the event feed for validator updates.
-	validatorUpdateBroker EventFeed[*asynctypes.Event[transition.ValidatorUpdates]]
+	// genesisRequests serves the requests to process genesis data.
+	genesisRequests RequestHandler[GenesisT, transition.ValidatorUpdates]
+	// blkRequests serves the requests to verify and finalize blocks.
+	blkRequests RequestHandler[BeaconBlockT, transition.ValidatorUpdates]
+	// blkBroker is the event feed for finalized blocks.
+	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]]
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
-	// forceStartupSyncOnce is used to force a sync of the startup head.
-	forceStartupSyncOnce *sync.Once
+	// verifiedProposals caches the outcome of verifying proposals, such
+	// that finalizing a verified block does not re-execute it.
+	verifiedProposals *lru.LRU[verifiedProposalKey, *verifiedProposal]
 }
 
 // NewService creates a new validator service.
@@ -118,7 +117,6 @@ func NewService[
 	],
 	logger log.Logger[any],
 	cs common.ChainSpec,
-	ee ExecutionEngine[PayloadAttributesT],
 	lb LocalBuilder[BeaconStateT],
 	sp StateProcessor[
 		BeaconBlockT,
@@ -128,10 +126,9 @@ func NewService[
 		ExecutionPayloadHeaderT,
 	],
 	ts TelemetrySink,
-	genesisBroker EventFeed[*asynctypes.Event[GenesisT]],
-	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]],
-	//nolint:lll // annoying formatter.
-	validatorUpdateBroker EventFeed[*asynctypes.Event[transition.ValidatorUpdates]],
+	genesisRequests RequestHandler[GenesisT, transition.ValidatorUpdates],
+	blkRequests RequestHandler[BeaconBlockT, transition.ValidatorUpdates],
+	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]],
 	optimisticPayloadBuilds bool,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -146,15 +143,14 @@ func NewService[
 		sb:                      sb,
 		logger:                  logger,
 		cs:                      cs,
-		ee:                      ee,
 		lb:                      lb,
 		sp:                      sp,
 		metrics:                 newChainMetrics(ts),
-		genesisBroker:           genesisBroker,
+		genesisRequests:         genesisRequests,
+		blkRequests:             blkRequests,
 		blkBroker:               blkBroker,
-		validatorUpdateBroker:   validatorUpdateBroker,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
-		forceStartupSyncOnce:    new(sync.Once),
+		verifiedProposals:       newVerifiedProposalsCache(),
 	}
 }
 
@@ -168,15 +164,15 @@ func (s *Service[
 func (s *Service[
 	_, _, _, _, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
-	subBlkCh, err := s.blkBroker.Subscribe()
+	blkReqs, err := s.blkRequests.Serve()
 	if err != nil {
 		return err
 	}
-	subGenCh, err := s.genesisBroker.Subscribe()
+	genReqs, err := s.genesisRequests.Serve()
 	if err != nil {
 		return err
 	}
-	go s.start(ctx, subBlkCh, subGenCh)
+	go s.start(ctx, blkReqs, genReqs)
 	return nil
 }
 
@@ -184,115 +180,81 @@ func (s *Service[
 	_, BeaconBlockT, _, _, _, _, _, _, GenesisT, _, _,
 ]) start(
 	ctx context.Context,
-	subBlkCh chan *asynctypes.Event[BeaconBlockT],
-	subGenCh chan *asynctypes.Event[GenesisT],
+	blkReqs <-chan *dispatcher.Request[
+		BeaconBlockT, transition.ValidatorUpdates,
+	],
+	genReqs <-chan *dispatcher.Request[
+		GenesisT, transition.ValidatorUpdates,
+	],
 ) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case msg := <-subBlkCh:
-			switch msg.Type() {
+		case req := <-blkReqs:
+			switch req.Type() {
 			case events.BeaconBlockReceived:
-				s.handleBeaconBlockReceived(msg)
+				s.handleBeaconBlockReceived(req)
 			case events.BeaconBlockFinalizedRequest:
-				s.handleBeaconBlockFinalization(msg)
+				s.handleBeaconBlockFinalization(req)
+			default:
+				s.respond(req, nil, dispatcher.ErrUnhandledRequest)
 			}
-		case msg := <-subGenCh:
-			if msg.Type() == events.GenesisDataProcessRequest {
-				s.handleProcessGenesisDataRequest(msg)
+		case req := <-genReqs:
+			if !req.Is(events.GenesisDataProcessRequest) {
+				s.respond(req, nil, dispatcher.ErrUnhandledRequest)
+				continue
 			}
+			s.handleProcessGenesisDataRequest(req)
 		}
 	}
 }
 
 func (s *Service[
 	_, _, _, _, _, _, _, _, GenesisT, _, _,
-]) handleProcessGenesisDataRequest(msg *asynctypes.Event[GenesisT]) {
-	if msg.Error() != nil {
-		s.logger.Error("Error processing genesis data", "error", msg.Error())
-		return
-	}
-
+]) handleProcessGenesisDataRequest(
+	req *dispatcher.Request[GenesisT, transition.ValidatorUpdates],
+) {
 	// Process the genesis data.
-	valUpdates, err := s.ProcessGenesisData(msg.Context(), msg.Data())
+	valUpdates, err := s.ProcessGenesisData(req.Context(), req.Data())
 	if err != nil {
 		s.logger.Error("Failed to process genesis data", "error", err)
 	}
-
-	// Publish the validator set updated event.
-	if err = s.validatorUpdateBroker.Publish(
-		msg.Context(),
-		asynctypes.NewEvent(
-			msg.Context(),
-			events.ValidatorSetUpdated,
-			valUpdates,
-			err,
-		),
-	); err != nil {
-		s.logger.Error(
-			"Failed to publish validator set updated event",
-			"error",
-			err,
-		)
-	}
+	s.respond(req, valUpdates, err)
 }
 
 func (s *Service[
 	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
 ]) handleBeaconBlockReceived(
-	msg *asynctypes.Event[BeaconBlockT],
+	req *dispatcher.Request[BeaconBlockT, transition.ValidatorUpdates],
 ) {
-	// If the block is nil, exit early.
-	if msg.Error() != nil {
-		s.logger.Error("Error processing beacon block", "error", msg.Error())
-		return
-	}
-
-	// Publish the verified block event.
-	if err := s.blkBroker.Publish(
-		msg.Context(),
-		asynctypes.NewEvent(
-			msg.Context(),
-			events.BeaconBlockVerified,
-			msg.Data(),
-			s.VerifyIncomingBlock(msg.Context(), msg.Data()),
-		),
-	); err != nil {
-		s.logger.Error("Failed to publish verified block", "error", err)
-	}
+	s.respond(req, nil, s.VerifyIncomingBlock(req.Context(), req.Data()))
 }
 
 func (s *Service[
 	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
 ]) handleBeaconBlockFinalization(
-	msg *asynctypes.Event[BeaconBlockT],
+	req *dispatcher.Request[BeaconBlockT, transition.ValidatorUpdates],
 ) {
-	// If there's an error in the event, log it and return
-	if msg.Error() != nil {
-		s.logger.Error("Error verifying beacon block", "error", msg.Error())
-		return
-	}
-
 	// Process the verified block
-	valUpdates, err := s.ProcessBeaconBlock(msg.Context(), msg.Data())
+	valUpdates, err := s.ProcessBeaconBlock(req.Context(), req.Data())
 	if err != nil {
 		s.logger.Error("Failed to process verified beacon block", "error", err)
 	}
+	s.respond(req, valUpdates, err)
+}
 
-	// Publish the validator set updated event
-	if err = s.validatorUpdateBroker.Publish(
-		msg.Context(),
-		asynctypes.NewEvent(
-			msg.Context(),
-			events.ValidatorSetUpdated,
-			valUpdates,
-			err,
-		)); err != nil {
-		s.logger.Error(
-			"Failed to publish validator set updated event",
-			"error",
-			err,
-		)
+// respond sends the response to a request, logging if it fails.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _,
+]) respond(
+	req interface {
+		Respond(transition.ValidatorUpdates, error) error
+	},
+	valUpdates transition.ValidatorUpdates,
+	err error,
+) {
+	if err = req.Respond(valUpdates, err); err != nil {
+		s.logger.Error("Failed to respond to request", "error", err)
 	}
 }
@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -83,22 +84,10 @@ type BlobSidecars interface {
 	Len() int
 }
 
-// ExecutionEngine is the interface for the execution engine.
-type ExecutionEngine[PayloadAttributesT any] interface {
-	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
-	// update.
-	NotifyForkchoiceUpdate(
-		ctx context.Context,
-		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
-	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
-}
-
-// EventFeed is a generic interface for sending events.
-type EventFeed[EventT any] interface {
+// EventPublisher is a generic interface for sending events.
+type EventPublisher[EventT any] interface {
 	// Publish sends an event and returns an error if any occurred.
 	Publish(ctx context.Context, event EventT) error
-	// Subscribe returns a channel that will receive events.
-	Subscribe() (chan EventT, error)
 }
 
 // ExecutionPayload is the interface for the execution payload.
@@ -140,12 +129,6 @@ type LocalBuilder[BeaconStateT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (*engineprimitives.PayloadID, error)
-	// SendForceHeadFCU sends a force head FCU request.
-	SendForceHeadFCU(
-		ctx context.Context,
-		st BeaconStateT,
-		slot math.Slot,
-	) error
 }
 
 // ReadOnlyBeaconState defines the interface for accessing various components of
@@ -172,6 +155,14 @@ type ReadOnlyBeaconState[
 	GetSlot() (math.Slot, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() common.Root
+	// StateRootAtIndex returns the state root at the given index.
+	StateRootAtIndex(uint64) (common.Root, error)
+}
+
+// RequestHandler is the interface for serving the requests of a dispatcher.
+type RequestHandler[ReqT, RespT any] interface {
+	// Serve returns the channel requests are delivered on.
+	Serve() (<-chan *dispatcher.Request[ReqT, RespT], error)
 }
 
 // StateProcessor defines the interface for processing various state transitions
@@ -215,6 +206,12 @@ type StorageBackend[
 	AvailabilityStore() AvailabilityStoreT
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
+	// RecordStateChanges returns a branch of the given context whose state
+	// writes are recorded, along with a function replaying them onto the
+	// state of another context.
+	RecordStateChanges(
+		context.Context,
+	) (context.Context, func(context.Context))
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
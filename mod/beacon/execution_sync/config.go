@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executionsync
+
+import "time"
+
+const (
+	// defaultInterval is the default interval between reconciliations.
+	defaultInterval = 12 * time.Second
+	// defaultRetryBackoff is the default backoff of the first forkchoice
+	// update retry.
+	defaultRetryBackoff = 500 * time.Millisecond
+	// defaultMaxRetryBackoff is the default cap of the forkchoice update
+	// retry backoff.
+	defaultMaxRetryBackoff = 30 * time.Second
+	// defaultMaxReplayBlocks is the default number of payloads replayed to
+	// the execution client per reconciliation.
+	defaultMaxReplayBlocks = 64
+)
+
+// Config is the configuration for the execution sync service.
+type Config struct {
+	// Interval is the interval at which the head of the execution client is
+	// reconciled with the head of the beacon chain.
+	Interval time.Duration `mapstructure:"interval"`
+	// RetryBackoff is the backoff of the first retry of a failed forkchoice
+	// update. It doubles on every failed attempt.
+	RetryBackoff time.Duration `mapstructure:"retry-backoff"`
+	// MaxRetryBackoff caps the backoff of forkchoice update retries.
+	MaxRetryBackoff time.Duration `mapstructure:"max-retry-backoff"`
+	// MaxReplayBlocks is the number of payloads replayed from the block store
+	// to a lagging execution client per reconciliation.
+	MaxReplayBlocks uint64 `mapstructure:"max-replay-blocks"`
+}
+
+// DefaultConfig returns the default configuration for the execution sync
+// service.
+func DefaultConfig() Config {
+	return Config{
+		Interval:        defaultInterval,
+		RetryBackoff:    defaultRetryBackoff,
+		MaxRetryBackoff: defaultMaxRetryBackoff,
+		MaxReplayBlocks: defaultMaxReplayBlocks,
+	}
+}
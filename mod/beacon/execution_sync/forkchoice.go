@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executionsync
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// head is the head of the beacon chain, as seen by the execution client.
+type head struct {
+	// slot is the slot of the head.
+	slot math.Slot
+	// blockHash is the hash of the execution block of the head.
+	blockHash common.ExecutionHash
+	// parentHash is the hash of the parent of the execution block.
+	parentHash common.ExecutionHash
+	// number is the number of the execution block of the head.
+	number math.U64
+}
+
+// newHead creates a head out of the latest execution payload (header) at the
+// given slot.
+func newHead(slot math.Slot, h ExecutionPayloadHeader) *head {
+	return &head{
+		slot:       slot,
+		blockHash:  h.GetBlockHash(),
+		parentHash: h.GetParentHash(),
+		number:     h.GetNumber(),
+	}
+}
+
+// forkchoiceUpdate forkchoices the execution client to the head of the beacon
+// chain. If the update fails, it is retried with exponential backoff until it
+// succeeds or is superseded by the update to a newer head.
+func (s *Service[
+	_, _, _, _, _, _, _, _, PayloadAttributesT, _,
+]) forkchoiceUpdate(ctx context.Context) {
+	s.retryCh = nil
+	if s.head == nil {
+		return
+	}
+
+	// The payload from the parent block is deemed finalized, as the beacon
+	// chain provides single slot finality.
+	_, _, err := s.ee.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequestNoAttrs[PayloadAttributesT](
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash:      s.head.blockHash,
+				SafeBlockHash:      s.head.parentHash,
+				FinalizedBlockHash: s.head.parentHash,
+			},
+			s.cs.ActiveForkVersionForSlot(s.head.slot),
+		),
+	)
+	if err == nil {
+		s.backoff = 0
+		return
+	}
+
+	s.backoff = min(max(2*s.backoff, s.config.RetryBackoff),
+		s.config.MaxRetryBackoff)
+	s.logger.Warn(
+		"Failed to send forkchoice update, retrying",
+		"head_eth1_hash", s.head.blockHash,
+		"for_slot", s.head.slot.Base10(),
+		"retry_in", s.backoff,
+		"error", err,
+	)
+	s.retryCh = time.After(s.backoff)
+}
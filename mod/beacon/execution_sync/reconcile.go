@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executionsync
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// reconcile compares the head of the execution client with the head of the
+// beacon chain, replays the payloads the execution client is missing and
+// forkchoices it to the head of the beacon chain.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) reconcile(ctx context.Context) {
+	if s.head == nil {
+		s.loadHead()
+	}
+	if s.head == nil {
+		return
+	}
+
+	elHead, err := s.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to fetch the head of the execution client",
+			"error", err,
+		)
+		s.setStatus(Status{HeadSlot: s.head.slot, ELOffline: true})
+		return
+	}
+
+	if common.ExecutionHash(elHead.Hash()) == s.head.blockHash {
+		s.setStatus(Status{HeadSlot: s.head.slot})
+		return
+	}
+
+	var distance uint64
+	if number := math.U64(elHead.Number.Uint64()); number < s.head.number {
+		distance = (s.head.number - number).Unwrap()
+		s.logger.Info(
+			"Execution client is behind the beacon chain 🐌",
+			"head_eth1_number", number.Base10(),
+			"beacon_eth1_number", s.head.number.Base10(),
+		)
+		s.replay(ctx, number+1)
+	}
+	s.setStatus(Status{
+		HeadSlot:     s.head.slot,
+		SyncDistance: distance,
+		Syncing:      distance > 0,
+		Optimistic:   true,
+	})
+
+	// Forkchoicing the execution client to the head of the beacon chain
+	// either triggers its sync of the remaining blocks or moves it off a
+	// chain the beacon chain did not finalize.
+	s.forkchoiceUpdate(ctx)
+}
+
+// loadHead loads the head of the beacon chain from the committed beacon state,
+// which is not available before the first block is committed.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) loadHead() {
+	queryCtx, err := s.node.CreateQueryContext(0, false)
+	if err != nil {
+		s.logger.Debug("Beacon chain head is not available yet", "reason", err)
+		return
+	}
+
+	st := s.sb.StateFromContext(queryCtx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		s.logger.Error("Failed to get slot of the startup head", "error", err)
+		return
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		s.logger.Error(
+			"Failed to get latest execution payload header of the startup head",
+			"error", err,
+		)
+		return
+	}
+	s.setHead(newHead(slot, lph))
+}
+
+// replay sends the payloads from the given execution number onwards to the
+// execution client, up to the head of the beacon chain or the configured
+// limit. It stops at the first payload missing from the block store, leaving
+// the remaining blocks to the sync of the execution client.
+func (s *Service[
+	_, _, _, _, _, ExecutionPayloadT, _, _, _, WithdrawalsT,
+]) replay(ctx context.Context, from math.U64) {
+	var replayed uint64
+	to := min(s.head.number, from+math.U64(s.config.MaxReplayBlocks)-1)
+	for number := from; number <= to; number++ {
+		slot, err := s.blockStore.GetSlotByExecutionNumber(number)
+		if err != nil {
+			break
+		}
+		blk, err := s.blockStore.Get(slot)
+		if err != nil || blk.IsNil() {
+			break
+		}
+
+		body := blk.GetBody()
+		parentBlockRoot := blk.GetParentBlockRoot()
+		err = s.ee.VerifyAndNotifyNewPayload(
+			ctx,
+			&engineprimitives.NewPayloadRequest[
+				ExecutionPayloadT, WithdrawalsT,
+			]{
+				ExecutionPayload: body.GetExecutionPayload(),
+				VersionedHashes: body.GetBlobKzgCommitments().
+					ToVersionedHashes(),
+				ParentBeaconBlockRoot: &parentBlockRoot,
+				ForkVersion:           s.cs.ActiveForkVersionForSlot(slot),
+			},
+		)
+		if errors.IsAny(
+			err,
+			engineerrors.ErrAcceptedPayloadStatus,
+			engineerrors.ErrSyncingPayloadStatus,
+		) {
+			// The execution client is missing the parent of the payload, so
+			// it has to sync on its own.
+			break
+		} else if err != nil {
+			s.logger.Error(
+				"Failed to replay payload to the execution client",
+				"eth1_number", number.Base10(),
+				"slot", slot.Base10(),
+				"error", err,
+			)
+			break
+		}
+		replayed++
+	}
+
+	if replayed > 0 {
+		s.logger.Info(
+			"Replayed payloads to the execution client ⏩",
+			"from_eth1_number", from.Base10(),
+			"count", replayed,
+		)
+	}
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executionsync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+)
+
+// Service reconciles the head of the execution client with the head of the
+// beacon chain. On startup and periodically, it replays the payloads the
+// execution client is missing from the block store, and it forkchoices the
+// execution client to every finalized block, retrying failed forkchoice
+// updates with backoff.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT, ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BlockStoreT BlockStore[BeaconBlockT],
+	ContextT context.Context,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT, WithdrawalsT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	NodeT Node[ContextT],
+	PayloadAttributesT any,
+	WithdrawalsT Withdrawals,
+] struct {
+	// config is the configuration for the service.
+	config Config
+	// logger is used for logging messages in the service.
+	logger log.Logger[any]
+	// cs holds the chain specifications.
+	cs common.ChainSpec
+	// ec is the client used to query the head of the execution client.
+	ec EngineClient
+	// ee is the execution engine payloads and forkchoice updates are sent to.
+	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, WithdrawalsT]
+	// sb is the storage backend the startup head is read from.
+	sb StorageBackend[BeaconStateT]
+	// blockStore holds the finalized blocks replayed to the execution client.
+	blockStore BlockStoreT
+	// blkCh is the feed of finalized blocks.
+	blkCh <-chan *asynctypes.Event[BeaconBlockT]
+	// node is used to query the committed beacon state.
+	node NodeT
+
+	// head is the head of the beacon chain, which is only accessed by the
+	// service loop.
+	head *head
+	// backoff is the backoff of the next forkchoice update retry.
+	backoff time.Duration
+	// retryCh fires when the failed forkchoice update is due a retry.
+	retryCh <-chan time.Time
+
+	// mu protects status.
+	mu sync.RWMutex
+	// status is the latest sync status.
+	status Status
+}
+
+// NewService creates a new execution sync service.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT, ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BlockStoreT BlockStore[BeaconBlockT],
+	ContextT context.Context,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT, WithdrawalsT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	NodeT Node[ContextT],
+	PayloadAttributesT any,
+	WithdrawalsT Withdrawals,
+](
+	config Config,
+	logger log.Logger[any],
+	cs common.ChainSpec,
+	ec EngineClient,
+	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, WithdrawalsT],
+	sb StorageBackend[BeaconStateT],
+	blockStore BlockStoreT,
+	blkCh <-chan *asynctypes.Event[BeaconBlockT],
+) *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlockStoreT, ContextT,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, NodeT, PayloadAttributesT,
+	WithdrawalsT,
+] {
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlockStoreT, ContextT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, NodeT, PayloadAttributesT,
+		WithdrawalsT,
+	]{
+		config:     config,
+		logger:     logger,
+		cs:         cs,
+		ec:         ec,
+		ee:         ee,
+		sb:         sb,
+		blockStore: blockStore,
+		blkCh:      blkCh,
+	}
+}
+
+// AttachNode sets the node the committed beacon state is queried from.
+func (s *Service[
+	_, _, _, _, _, _, _, NodeT, _, _,
+]) AttachNode(node NodeT) {
+	s.node = node
+}
+
+// Name returns the name of the service.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) Name() string {
+	return "execution-sync"
+}
+
+// Start spawns the loop of the service.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) Start(ctx context.Context) error {
+	go s.start(ctx)
+	return nil
+}
+
+// SyncStatus returns the latest sync status.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) SyncStatus() *Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	status := s.status
+	return &status
+}
+
+// Status returns an error if the execution client could not be reached.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) Status() error {
+	if s.SyncStatus().IsELOffline() {
+		return ErrELOffline
+	}
+	return nil
+}
+
+// start reconciles the execution client on startup and then on every tick,
+// and forkchoices it to every finalized block.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) start(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	s.reconcile(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-s.blkCh:
+			if !msg.Is(events.BeaconBlockFinalized) {
+				continue
+			}
+			payload := msg.Data().GetBody().GetExecutionPayload()
+			s.setHead(newHead(msg.Data().GetSlot(), payload))
+			s.forkchoiceUpdate(ctx)
+		case <-ticker.C:
+			s.reconcile(ctx)
+		case <-s.retryCh:
+			s.forkchoiceUpdate(ctx)
+		}
+	}
+}
+
+// setHead sets the head of the beacon chain.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) setHead(h *head) {
+	s.head = h
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status.HeadSlot = h.slot
+}
+
+// setStatus sets the latest sync status.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) setStatus(status Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
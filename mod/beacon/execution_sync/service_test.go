@@ -0,0 +1,439 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executionsync
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/mockengine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+type (
+	testWithdrawal        = engineprimitives.Withdrawal
+	testPayloadAttributes = engineprimitives.PayloadAttributes[*testWithdrawal]
+
+	testService = Service[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*testState,
+		*testBlockStore,
+		context.Context,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*testNode,
+		*testPayloadAttributes,
+		engineprimitives.Withdrawals,
+	]
+)
+
+// testState is the committed beacon state the startup head is loaded from.
+type testState struct {
+	slot   math.Slot
+	header *types.ExecutionPayloadHeader
+}
+
+func (s *testState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *testState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return s.header, nil
+}
+
+// testNode serves the committed beacon state, which is not available
+// before it is set.
+type testNode struct {
+	st *testState
+}
+
+func (n *testNode) CreateQueryContext(int64, bool) (context.Context, error) {
+	if n.st == nil {
+		return nil, errors.New("no committed state")
+	}
+	return context.Background(), nil
+}
+
+func (n *testNode) StateFromContext(context.Context) *testState {
+	return n.st
+}
+
+// testBlockStore is an in-memory store of finalized beacon blocks.
+type testBlockStore struct {
+	blocks map[math.Slot]*types.BeaconBlock
+}
+
+func (bs *testBlockStore) Get(slot math.Slot) (*types.BeaconBlock, error) {
+	blk, ok := bs.blocks[slot]
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+	return blk, nil
+}
+
+func (bs *testBlockStore) GetSlotByExecutionNumber(
+	number math.U64,
+) (math.Slot, error) {
+	for slot, blk := range bs.blocks {
+		if blk.GetBody().GetExecutionPayload().GetNumber() == number {
+			return slot, nil
+		}
+	}
+	return 0, errors.New("block not found")
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// testGenesis returns the genesis block of the execution chain.
+func testGenesis() *gethprimitives.Block {
+	return gethprimitives.NewBlockWithHeader(&gethprimitives.Header{
+		UncleHash:   gethprimitives.EmptyUncleHash,
+		TxHash:      gethprimitives.EmptyTxsHash,
+		ReceiptHash: gethprimitives.EmptyReceiptsHash,
+		Difficulty:  new(big.Int),
+		Number:      new(big.Int),
+		GasLimit:    30_000_000,
+		BaseFee:     big.NewInt(1e9),
+	})
+}
+
+// serveEngine serves a mock engine starting at the genesis block.
+func serveEngine(t *testing.T) (*mockengine.Engine, *httptest.Server) {
+	t.Helper()
+	el := mockengine.New(big.NewInt(testChainID), testGenesis())
+	handler, err := el.Handler()
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return el, server
+}
+
+// buildChain builds a chain of n finalized beacon blocks, one per slot,
+// whose payloads are built by a separate mock engine.
+func buildChain(t *testing.T, n int) []*types.BeaconBlock {
+	t.Helper()
+	ctx := context.Background()
+	el, server := serveEngine(t)
+	rpcClient, err := rpc.DialContext(ctx, server.URL)
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+
+	blocks := make([]*types.BeaconBlock, 0, n)
+	for i := range n {
+		head := el.Head()
+		beaconRoot := gethprimitives.ExecutionHash{byte(i + 1)}
+		var resp gethprimitives.ForkChoiceResponse
+		require.NoError(t, rpcClient.CallContext(
+			ctx, &resp, "engine_forkchoiceUpdatedV3",
+			gethprimitives.ForkchoiceStateV1{HeadBlockHash: head.Hash()},
+			&gethprimitives.PayloadAttributes{
+				Timestamp:   head.Time() + 1,
+				Withdrawals: []*gethprimitives.Withdrawal{},
+				BeaconRoot:  &beaconRoot,
+			},
+		))
+		var envelope gethprimitives.ExecutionPayloadEnvelope
+		require.NoError(t, rpcClient.CallContext(
+			ctx, &envelope, "engine_getPayloadV3", resp.PayloadID,
+		))
+		var status gethprimitives.PayloadStatusV1
+		require.NoError(t, rpcClient.CallContext(
+			ctx, &status, "engine_newPayloadV3", envelope.ExecutionPayload,
+			[]gethprimitives.ExecutionHash{}, &beaconRoot,
+		))
+		require.NoError(t, rpcClient.CallContext(
+			ctx, &resp, "engine_forkchoiceUpdatedV3",
+			gethprimitives.ForkchoiceStateV1{
+				HeadBlockHash: envelope.ExecutionPayload.BlockHash,
+			}, nil,
+		))
+
+		bz, err := json.Marshal(envelope.ExecutionPayload)
+		require.NoError(t, err)
+		payload := new(types.ExecutionPayload)
+		require.NoError(t, json.Unmarshal(bz, payload))
+		blocks = append(blocks, &types.BeaconBlock{
+			Slot:       math.Slot(i + 1),
+			ParentRoot: common.Root(beaconRoot),
+			Body:       &types.BeaconBlockBody{ExecutionPayload: payload},
+		})
+	}
+	return blocks
+}
+
+// newTestService returns a service reconciling a mock engine at genesis with
+// the given finalized blocks, of which the store only holds the stored ones.
+func newTestService(
+	t *testing.T,
+	cfg Config,
+	stored []*types.BeaconBlock,
+) (*testService, *mockengine.Engine, *httptest.Server) {
+	t.Helper()
+	el, server := serveEngine(t)
+	dialURL, err := url.NewFromRaw(server.URL)
+	require.NoError(t, err)
+
+	clientCfg := client.DefaultConfig()
+	clientCfg.RPCDialURL = dialURL
+	clientCfg.RPCHealthCheckInterval = time.Hour
+	ec := client.New[*types.ExecutionPayload, *testPayloadAttributes](
+		&clientCfg, noop.NewLogger[any](), nil, noopSink{},
+		big.NewInt(testChainID),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, ec.Start(ctx))
+
+	node := &testNode{}
+	blockStore := &testBlockStore{
+		blocks: make(map[math.Slot]*types.BeaconBlock),
+	}
+	for _, blk := range stored {
+		blockStore.blocks[blk.GetSlot()] = blk
+	}
+
+	s := NewService[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*testState,
+		*testBlockStore,
+		context.Context,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*testNode,
+		*testPayloadAttributes,
+		engineprimitives.Withdrawals,
+	](
+		cfg,
+		noop.NewLogger[any](),
+		chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:      32,
+			DenebPlusForkEpoch: math.Epoch(^uint64(0)),
+			ElectraForkEpoch:   math.Epoch(^uint64(0)),
+		}),
+		ec,
+		engine.New[
+			*types.ExecutionPayload,
+			*testPayloadAttributes,
+			engineprimitives.PayloadID,
+			engineprimitives.Withdrawals,
+		](ec, noop.NewLogger[any](), nil, noopSink{}),
+		node,
+		blockStore,
+		nil,
+	)
+	s.AttachNode(node)
+	return s, el, server
+}
+
+// hasBlock returns true if the mock engine served by the server knows the
+// payload of the given block.
+func hasBlock(
+	t *testing.T,
+	server *httptest.Server,
+	blk *types.BeaconBlock,
+) bool {
+	t.Helper()
+	rpcClient, err := rpc.DialContext(context.Background(), server.URL)
+	require.NoError(t, err)
+	defer rpcClient.Close()
+
+	var block map[string]any
+	require.NoError(t, rpcClient.CallContext(
+		context.Background(), &block, "eth_getBlockByHash",
+		blk.GetBody().GetExecutionPayload().GetBlockHash(), false,
+	))
+	return block != nil
+}
+
+// headOf returns the head of the given finalized block.
+func headOf(blk *types.BeaconBlock) *head {
+	return newHead(blk.GetSlot(), blk.GetBody().GetExecutionPayload())
+}
+
+func TestReconcileReplaysFromBlockStore(t *testing.T) {
+	blocks := buildChain(t, 3)
+	s, el, _ := newTestService(t, DefaultConfig(), blocks)
+	s.setHead(headOf(blocks[2]))
+
+	s.reconcile(context.Background())
+	require.Equal(t,
+		blocks[2].GetBody().GetExecutionPayload().GetBlockHash(),
+		common.ExecutionHash(el.Head().Hash()),
+	)
+	require.Equal(t, &Status{
+		HeadSlot:     3,
+		SyncDistance: 3,
+		Syncing:      true,
+		Optimistic:   true,
+	}, s.SyncStatus())
+	require.NoError(t, s.Status())
+
+	// Once the execution client caught up, the head is validated.
+	s.reconcile(context.Background())
+	require.Equal(t, &Status{HeadSlot: 3}, s.SyncStatus())
+}
+
+func TestReconcileReplaysUpToLimit(t *testing.T) {
+	blocks := buildChain(t, 3)
+	cfg := DefaultConfig()
+	cfg.MaxReplayBlocks = 2
+	s, el, server := newTestService(t, cfg, blocks)
+	s.setHead(headOf(blocks[2]))
+
+	// The execution client is left syncing the last block on its own.
+	s.reconcile(context.Background())
+	require.True(t, hasBlock(t, server, blocks[0]))
+	require.True(t, hasBlock(t, server, blocks[1]))
+	require.False(t, hasBlock(t, server, blocks[2]))
+	require.Equal(t, uint64(0), el.Head().NumberU64())
+	require.Equal(t, &Status{
+		HeadSlot:     3,
+		SyncDistance: 3,
+		Syncing:      true,
+		Optimistic:   true,
+	}, s.SyncStatus())
+}
+
+func TestReconcileStopsAtMissingBlock(t *testing.T) {
+	blocks := buildChain(t, 3)
+	s, _, server := newTestService(t, DefaultConfig(),
+		[]*types.BeaconBlock{blocks[0], blocks[2]},
+	)
+	s.setHead(headOf(blocks[2]))
+
+	s.reconcile(context.Background())
+	require.True(t, hasBlock(t, server, blocks[0]))
+	require.False(t, hasBlock(t, server, blocks[2]))
+	require.True(t, s.SyncStatus().IsSyncing())
+}
+
+func TestReconcileLoadsStartupHead(t *testing.T) {
+	blocks := buildChain(t, 1)
+	s, el, _ := newTestService(t, DefaultConfig(), blocks)
+
+	// Nothing is reconciled before the first block is committed.
+	s.reconcile(context.Background())
+	require.Nil(t, s.head)
+	require.Equal(t, &Status{}, s.SyncStatus())
+
+	payload := blocks[0].GetBody().GetExecutionPayload()
+	header, err := payload.ToHeader(0, testChainID)
+	require.NoError(t, err)
+	s.node.st = &testState{slot: 1, header: header}
+
+	s.reconcile(context.Background())
+	require.Equal(t, payload.GetBlockHash(), s.head.blockHash)
+	require.Equal(t, uint64(1), el.Head().NumberU64())
+}
+
+func TestReconcileELOffline(t *testing.T) {
+	blocks := buildChain(t, 1)
+	s, _, server := newTestService(t, DefaultConfig(), blocks)
+	s.setHead(headOf(blocks[0]))
+
+	server.Close()
+	s.reconcile(context.Background())
+	require.Equal(t, &Status{HeadSlot: 1, ELOffline: true}, s.SyncStatus())
+	require.ErrorIs(t, s.Status(), ErrELOffline)
+}
+
+func TestForkchoiceUpdateBackoff(t *testing.T) {
+	blocks := buildChain(t, 1)
+	cfg := DefaultConfig()
+	cfg.RetryBackoff = time.Second
+	cfg.MaxRetryBackoff = 3 * time.Second
+	s, el, _ := newTestService(t, cfg, blocks)
+	s.setHead(headOf(blocks[0]))
+	s.replay(context.Background(), 1)
+
+	for _, backoff := range []time.Duration{
+		time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second,
+	} {
+		el.InjectFault("engine_forkchoiceUpdatedV3", mockengine.FaultInvalid)
+		s.forkchoiceUpdate(context.Background())
+		require.Equal(t, backoff, s.backoff)
+		require.NotNil(t, s.retryCh)
+		require.Equal(t, uint64(0), el.Head().NumberU64())
+	}
+
+	s.forkchoiceUpdate(context.Background())
+	require.Zero(t, s.backoff)
+	require.Nil(t, s.retryCh)
+	require.Equal(t, uint64(1), el.Head().NumberU64())
+}
+
+func TestForkchoiceUpdateSuperseded(t *testing.T) {
+	blocks := buildChain(t, 2)
+	cfg := DefaultConfig()
+	// Failed updates are never retried within the test.
+	cfg.RetryBackoff = time.Hour
+	s, el, _ := newTestService(t, cfg, blocks)
+	s.setHead(headOf(blocks[1]))
+	s.replay(context.Background(), 1)
+	s.head = nil
+
+	blkCh := make(chan *asynctypes.Event[*types.BeaconBlock])
+	s.blkCh = blkCh
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	el.InjectFault("engine_forkchoiceUpdatedV3", mockengine.FaultInvalid)
+	require.NoError(t, s.Start(ctx))
+
+	// The update to the first block fails, and its retry is superseded by
+	// the update to the second block.
+	blkCh <- asynctypes.NewEvent(ctx, events.BeaconBlockFinalized, blocks[0])
+	blkCh <- asynctypes.NewEvent(ctx, events.BeaconBlockFinalized, blocks[1])
+	require.Eventually(t, func() bool {
+		return el.Head().NumberU64() == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, math.Slot(2), s.SyncStatus().GetHeadSlot())
+}
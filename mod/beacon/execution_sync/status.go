@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executionsync
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// Status is the sync status of the execution client relative to the head of
+// the beacon chain.
+type Status struct {
+	// HeadSlot is the slot of the head of the beacon chain.
+	HeadSlot math.Slot
+	// SyncDistance is the number of execution blocks the execution client
+	// lags behind the head of the beacon chain.
+	SyncDistance uint64
+	// Syncing is set while the execution client catches up with the head of
+	// the beacon chain.
+	Syncing bool
+	// Optimistic is set while the head of the beacon chain has not been
+	// validated by the execution client.
+	Optimistic bool
+	// ELOffline is set if the execution client could not be reached.
+	ELOffline bool
+}
+
+// GetHeadSlot returns the slot of the head of the beacon chain.
+func (s *Status) GetHeadSlot() math.Slot {
+	return s.HeadSlot
+}
+
+// GetSyncDistance returns the number of execution blocks the execution client
+// lags behind.
+func (s *Status) GetSyncDistance() uint64 {
+	return s.SyncDistance
+}
+
+// IsSyncing returns true if the execution client is syncing.
+func (s *Status) IsSyncing() bool {
+	return s.Syncing
+}
+
+// IsOptimistic returns true if the head of the beacon chain has not been
+// validated by the execution client.
+func (s *Status) IsOptimistic() bool {
+	return s.Optimistic
+}
+
+// IsELOffline returns true if the execution client could not be reached.
+func (s *Status) IsELOffline() bool {
+	return s.ELOffline
+}
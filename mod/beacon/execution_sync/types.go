@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executionsync
+
+import (
+	stdbytes "bytes"
+	"context"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock represents a beacon block interface.
+type BeaconBlock[
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT any,
+] interface {
+	constraints.Nillable
+	// GetSlot returns the slot of the beacon block.
+	GetSlot() math.Slot
+	// GetParentBlockRoot returns the parent block root of the beacon block.
+	GetParentBlockRoot() common.Root
+	// GetBody returns the body of the beacon block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody represents the interface for the beacon block body.
+type BeaconBlockBody[ExecutionPayloadT any] interface {
+	// GetExecutionPayload returns the execution payload of the beacon block
+	// body.
+	GetExecutionPayload() ExecutionPayloadT
+	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+}
+
+// BeaconState defines the interface for accessing the beacon state.
+type BeaconState[ExecutionPayloadHeaderT any] interface {
+	// GetLatestExecutionPayloadHeader returns the most recent execution payload
+	// header.
+	GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+	// GetSlot retrieves the current slot of the beacon state.
+	GetSlot() (math.Slot, error)
+}
+
+// BlockStore is the interface for the store of finalized beacon blocks.
+type BlockStore[BeaconBlockT any] interface {
+	// Get retrieves the block at the given slot.
+	Get(slot math.Slot) (BeaconBlockT, error)
+	// GetSlotByExecutionNumber retrieves the slot of the block carrying the
+	// execution payload with the given number.
+	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+}
+
+// EngineClient is the interface for the client of the execution client.
+type EngineClient interface {
+	// HeaderByNumber retrieves the block header by its number, or the latest
+	// block header if the number is nil.
+	HeaderByNumber(
+		ctx context.Context, number *big.Int,
+	) (*gethprimitives.Header, error)
+}
+
+// ExecutionEngine is the interface for the execution engine.
+type ExecutionEngine[
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT, WithdrawalsT],
+	PayloadAttributesT any,
+	WithdrawalsT Withdrawals,
+] interface {
+	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
+	// update.
+	NotifyForkchoiceUpdate(
+		ctx context.Context,
+		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
+	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
+	// VerifyAndNotifyNewPayload verifies the new payload and notifies the
+	// execution client.
+	VerifyAndNotifyNewPayload(
+		ctx context.Context,
+		req *engineprimitives.NewPayloadRequest[ExecutionPayloadT, WithdrawalsT],
+	) error
+}
+
+// ExecutionPayload is the interface for the execution payload.
+type ExecutionPayload[ExecutionPayloadT, WithdrawalsT any] interface {
+	constraints.ForkTyped[ExecutionPayloadT]
+	ExecutionPayloadHeader
+	GetPrevRandao() common.Bytes32
+	GetGasLimit() math.U64
+	GetGasUsed() math.U64
+	GetTimestamp() math.U64
+	GetExtraData() []byte
+	GetBaseFeePerGas() *math.U256
+	GetFeeRecipient() common.ExecutionAddress
+	GetStateRoot() common.Bytes32
+	GetReceiptsRoot() common.Bytes32
+	GetLogsBloom() bytes.B256
+	GetBlobGasUsed() math.U64
+	GetExcessBlobGas() math.U64
+	GetWithdrawals() WithdrawalsT
+	GetTransactions() engineprimitives.Transactions
+}
+
+// ExecutionPayloadHeader is the interface for the execution payload header.
+type ExecutionPayloadHeader interface {
+	// GetBlockHash returns the block hash.
+	GetBlockHash() common.ExecutionHash
+	// GetParentHash returns the parent hash.
+	GetParentHash() common.ExecutionHash
+	// GetNumber returns the block number.
+	GetNumber() math.U64
+}
+
+// Node is the interface for a node.
+type Node[ContextT any] interface {
+	// CreateQueryContext creates a query context for a given height and proof
+	// flag.
+	CreateQueryContext(height int64, prove bool) (ContextT, error)
+}
+
+// StorageBackend defines an interface for accessing the beacon state.
+type StorageBackend[BeaconStateT any] interface {
+	// StateFromContext retrieves the beacon state from the given context.
+	StateFromContext(context.Context) BeaconStateT
+}
+
+// Withdrawals is the interface for the withdrawals of an execution payload.
+type Withdrawals interface {
+	Len() int
+	EncodeIndex(int, *stdbytes.Buffer)
+}
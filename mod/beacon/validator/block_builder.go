@@ -38,7 +38,7 @@ import (
 // buildBlockAndSidecars builds a new beacon block.
 func (s *Service[
 	AttestationDataT, BeaconBlockT, _, _,
-	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, SlotDataT,
+	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, SlotDataT, _, _,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	slotData SlotDataT,
@@ -122,19 +122,52 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	value := s.recordBlockValue(blk, envelope)
 	s.logger.Info(
 		"Beacon block successfully built",
 		"slot", slotData.GetSlot().Base10(),
 		"state_root", blk.GetStateRoot(),
+		"block_value", value.Dec(),
+		"should_override_builder", envelope.ShouldOverrideBuilder(),
 		"duration", time.Since(startTime).String(),
 	)
 
 	return blk, sidecars, nil
 }
 
+// recordBlockValue reports the value in Wei of the payload of the block and
+// records it alongside the block, returning the value.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, ExecutionPayloadT, _, _, _, _, _, _,
+]) recordBlockValue(
+	blk BeaconBlockT,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) *math.U256 {
+	value := envelope.GetValue()
+	if value == nil {
+		value = new(math.U256)
+	}
+
+	s.metrics.reportBlockValue(
+		blk.GetSlot(),
+		math.GweiFromWei(value.ToBig()),
+		envelope.ShouldOverrideBuilder(),
+	)
+	if err := s.blockValues.SetBlockValue(
+		blk.GetSlot(), blk.HashTreeRoot(), value,
+	); err != nil {
+		s.logger.Error(
+			"Failed to record block value",
+			"slot", blk.GetSlot().Base10(),
+			"err", err,
+		)
+	}
+	return value
+}
+
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -172,7 +205,7 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
@@ -199,15 +232,44 @@ func (s *Service[
 }
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
+// The payload of the external builders is used if it is worth more than
+// the local payload, which is used otherwise.
 func (s *Service[
 	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _, _, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
+	envelope, err := s.retrieveLocalPayload(ctx, st, blk)
+	if err != nil || envelope == nil ||
+		!s.externalPayloadBuilder.Enabled() {
+		return envelope, err
+	}
+
+	external, err := s.externalPayloadBuilder.GetPayload(
+		ctx, st, blk.GetSlot(), blk.GetParentBlockRoot(), envelope,
+	)
+	if err != nil {
+		s.metrics.markExternalPayloadSkipped(blk.GetSlot(), err)
+		s.logger.Info(
+			"Using local payload over external builders",
+			"slot", blk.GetSlot().Base10(),
+			"reason", err,
+		)
+		return envelope, nil
+	}
+	s.metrics.markExternalPayloadUsed(blk.GetSlot())
+	return external, nil
+}
+
+// retrieveLocalPayload retrieves the execution payload for the block from
+// the local builder, building it synchronously if it was not built ahead.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _, _, _,
+]) retrieveLocalPayload(
+	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
@@ -215,6 +277,13 @@ func (s *Service[
 			blk.GetSlot(),
 			blk.GetParentBlockRoot(),
 		)
+	if blk.GetSlot() == s.prebuiltSlot {
+		if err == nil {
+			s.metrics.markPrebuiltPayloadUsed(blk.GetSlot())
+		} else {
+			s.metrics.markPrebuiltPayloadMissed(blk.GetSlot())
+		}
+	}
 	if err != nil {
 		s.metrics.failedToRetrievePayload(
 			blk.GetSlot(),
@@ -259,6 +328,7 @@ func (s *Service[
 func (s *Service[
 	AttestationDataT, BeaconBlockT, _, BeaconStateT, _,
 	_, _, Eth1DataT, ExecutionPayloadT, _, _, SlashingInfoT, SlotDataT,
+	_, _,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
@@ -290,10 +360,23 @@ func (s *Service[
 		return ErrNilDepositIndexStart
 	}
 
+	// Log based deposits are only included up to the index of the first
+	// deposit received through an execution request.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	numDeposits := s.chainSpec.MaxDepositsPerBlock()
+	if depositIndex >= startIndex {
+		numDeposits = 0
+	} else {
+		numDeposits = min(numDeposits, startIndex-depositIndex)
+	}
+
 	// Dequeue deposits from the state.
 	deposits, err := s.bsb.DepositStore().GetDepositsByIndex(
 		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
+		numDeposits,
 	)
 	if err != nil {
 		return err
@@ -326,6 +409,11 @@ func (s *Service[
 		body.SetSlashingInfo(slotData.GetSlashingInfo())
 	}
 
+	if activeForkVersion >= version.Electra {
+		// Set the execution requests on the block body.
+		body.SetExecutionRequests(envelope.GetExecutionRequests())
+	}
+
 	body.SetExecutionPayload(envelope.GetExecutionPayload())
 	return nil
 }
@@ -333,7 +421,7 @@ func (s *Service[
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -354,7 +442,7 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -20,6 +20,8 @@
 
 package validator
 
+import "time"
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -27,6 +29,14 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultEnableSlotClock is the default for enabling the local slot
+	// clock.
+	defaultEnableSlotClock = false
+
+	// defaultSlotClockLead is the default for how long ahead of a proposal
+	// its payload starts being built.
+	defaultSlotClockLead = 1 * time.Second
 )
 
 // Config is the validator configuration.
@@ -39,6 +49,14 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// EnableSlotClock enables the local slot clock, which builds the payload
+	// of the slots this node is expected to propose ahead of the proposals.
+	EnableSlotClock bool `mapstructure:"enable-slot-clock"`
+
+	// SlotClockLead is how long ahead of the estimated start of a slot its
+	// payload starts being built.
+	SlotClockLead time.Duration `mapstructure:"slot-clock-lead"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -46,5 +64,7 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		EnableSlotClock:               defaultEnableSlotClock,
+		SlotClockLead:                 defaultSlotClockLead,
 	}
 }
@@ -37,4 +37,8 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrUnexpectedSlotTick is an error for when a slot tick is not for
+	// the slot following the committed beacon state.
+	ErrUnexpectedSlotTick = errors.New("unexpected slot tick")
 )
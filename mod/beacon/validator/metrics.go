@@ -21,6 +21,7 @@
 package validator
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -74,3 +75,77 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// markPrebuiltPayloadUsed increments the counter for the number of times
+// the payload built ahead of the proposal was used in the block.
+func (cm *validatorMetrics) markPrebuiltPayloadUsed(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.prebuilt_payload_used",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// markPrebuiltPayloadMissed increments the counter for the number of times
+// the payload built ahead of the proposal could not be retrieved.
+func (cm *validatorMetrics) markPrebuiltPayloadMissed(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.prebuilt_payload_missed",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// failedToPrebuildPayload increments the counter for the number of times
+// the validator failed to build a payload ahead of the proposal.
+func (cm *validatorMetrics) failedToPrebuildPayload(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.failed_to_prebuild_payload",
+		"slot",
+		slot.Base10(),
+		"error",
+		err.Error(),
+	)
+}
+
+// markExternalPayloadUsed increments the counter for the number of times
+// the payload of an external builder was used in the block.
+func (cm *validatorMetrics) markExternalPayloadUsed(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.external_payload_used",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// markExternalPayloadSkipped increments the counter for the number of
+// times the local payload was used over the payload of an external builder.
+func (cm *validatorMetrics) markExternalPayloadSkipped(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.external_payload_skipped",
+		"slot",
+		slot.Base10(),
+		"reason",
+		err.Error(),
+	)
+}
+
+// reportBlockValue sets the gauge for the value in Gwei of the payload of
+// the block proposed at the given slot.
+func (cm *validatorMetrics) reportBlockValue(
+	slot math.Slot, value math.Gwei, overrideBuilder bool,
+) {
+	cm.sink.SetGauge(
+		"beacon_kit.validator.block_value_gwei",
+		//#nosec:G701 // not an issue in practice.
+		int64(value),
+		"slot",
+		slot.Base10(),
+		"should_override_builder",
+		strconv.FormatBool(overrideBuilder),
+	)
+}
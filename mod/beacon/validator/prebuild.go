@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// handleSlotTick builds the payload for a slot this node is expected to
+// propose, ahead of the proposal.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) handleSlotTick(ctx context.Context, slot math.Slot) {
+	if err := s.prebuildPayload(ctx, slot); err != nil {
+		s.metrics.failedToPrebuildPayload(slot, err)
+		s.logger.Error(
+			"Failed to build payload ahead of proposal",
+			"slot", slot.Base10(), "error", err,
+		)
+		return
+	}
+	s.prebuiltSlot = slot
+	s.logger.Info(
+		"Requested payload ahead of proposal", "for_slot", slot.Base10(),
+	)
+}
+
+// prebuildPayload sends a forkchoice update with the payload attributes of
+// the given slot to the execution client, on top of the committed beacon
+// state.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) prebuildPayload(ctx context.Context, slot math.Slot) error {
+	queryCtx, err := s.node.CreateQueryContext(0, false)
+	if err != nil {
+		return err
+	}
+
+	// The state of the query context is never committed, hence it can be
+	// prepared for the slot in place.
+	st := s.bsb.StateFromContext(queryCtx)
+	headSlot, err := st.GetSlot()
+	if err != nil {
+		return err
+	} else if headSlot+1 != slot {
+		return errors.Wrapf(
+			ErrUnexpectedSlotTick,
+			"state at slot %d, tick for slot %d", headSlot, slot,
+		)
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.stateProcessor.ProcessSlots(st, slot); err != nil {
+		return err
+	}
+
+	parentBlockRoot, err := st.GetBlockRootAtIndex(
+		uint64(slot-1) % s.chainSpec.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.localPayloadBuilder.RequestPayloadAsync(
+		ctx,
+		st,
+		slot,
+		max(
+			//#nosec:G701 // not an issue in practice.
+			uint64(time.Now().Add(s.cfg.SlotClockLead).Unix()),
+			uint64(lph.GetTimestamp()+1),
+		),
+		parentBlockRoot,
+		lph.GetBlockHash(),
+		lph.GetParentHash(),
+	)
+	return err
+}
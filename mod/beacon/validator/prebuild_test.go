@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+type testService = Service[
+	*types.AttestationData,
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+	*testBeaconState,
+	any,
+	*types.Deposit,
+	*testDepositStore,
+	*types.Eth1Data,
+	*types.ExecutionPayload,
+	*types.ExecutionPayloadHeader,
+	*types.ForkData,
+	*types.SlashingInfo,
+	*testSlotData,
+	context.Context,
+	*testNode,
+]
+
+// testBeaconState is a beacon state whose block roots are their index.
+type testBeaconState struct {
+	slot   math.Slot
+	header *types.ExecutionPayloadHeader
+}
+
+func (st *testBeaconState) GetBlockRootAtIndex(i uint64) (common.Root, error) {
+	return common.Root{byte(i)}, nil
+}
+
+func (st *testBeaconState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return st.header, nil
+}
+
+func (st *testBeaconState) GetSlot() (math.Slot, error) {
+	return st.slot, nil
+}
+
+func (*testBeaconState) HashTreeRoot() common.Root {
+	return common.Root{}
+}
+
+func (*testBeaconState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+func (*testBeaconState) GetEth1DepositIndex() (uint64, error) {
+	return 0, nil
+}
+
+func (*testBeaconState) GetDepositRequestsStartIndex() (uint64, error) {
+	return 0, nil
+}
+
+func (*testBeaconState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return common.Root{}, nil
+}
+
+type testDepositStore struct{}
+
+func (*testDepositStore) GetDepositsByIndex(
+	uint64, uint64,
+) ([]*types.Deposit, error) {
+	return nil, nil
+}
+
+type testSlotData struct{}
+
+func (*testSlotData) GetSlot() math.Slot { return 0 }
+
+func (*testSlotData) GetAttestationData() []*types.AttestationData {
+	return nil
+}
+
+func (*testSlotData) GetSlashingInfo() []*types.SlashingInfo { return nil }
+
+// testNode serves a copy of the committed beacon state on every query, or
+// the error if set.
+type testNode struct {
+	st  *testBeaconState
+	err error
+}
+
+func (n *testNode) CreateQueryContext(int64, bool) (context.Context, error) {
+	return context.Background(), n.err
+}
+
+func (*testNode) DepositStore() *testDepositStore {
+	return &testDepositStore{}
+}
+
+func (n *testNode) StateFromContext(context.Context) *testBeaconState {
+	st := *n.st
+	return &st
+}
+
+type testStateProcessor struct{}
+
+func (testStateProcessor) ProcessSlots(
+	st *testBeaconState, slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	st.slot = slot
+	return nil, nil
+}
+
+func (testStateProcessor) Transition(
+	*transition.Context, *testBeaconState, *types.BeaconBlock,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+// payloadRequest is a payload requested from the test payload builder.
+type payloadRequest struct {
+	slot            math.Slot
+	timestamp       uint64
+	parentBlockRoot common.Root
+	headBlockHash   common.ExecutionHash
+}
+
+// testPayloadBuilder records the payloads requested from it, and only
+// retrieves the payloads requested asynchronously.
+type testPayloadBuilder struct {
+	async []payloadRequest
+	sync  []payloadRequest
+}
+
+func (b *testPayloadBuilder) RetrievePayload(
+	_ context.Context, slot math.Slot, _ common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	for _, req := range b.async {
+		if req.slot == slot {
+			return nil, nil
+		}
+	}
+	return nil, errors.New("payload not found")
+}
+
+func (b *testPayloadBuilder) RequestPayloadAsync(
+	_ context.Context,
+	_ *testBeaconState,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash common.ExecutionHash,
+	_ common.ExecutionHash,
+) (*engineprimitives.PayloadID, error) {
+	b.async = append(b.async, payloadRequest{
+		slot, timestamp, parentBlockRoot, headEth1BlockHash,
+	})
+	return &engineprimitives.PayloadID{}, nil
+}
+
+func (b *testPayloadBuilder) RequestPayloadSync(
+	_ context.Context,
+	_ *testBeaconState,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash common.ExecutionHash,
+	_ common.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	b.sync = append(b.sync, payloadRequest{
+		slot, timestamp, parentBlockRoot, headEth1BlockHash,
+	})
+	return nil, nil
+}
+
+// countingSink counts the increments of each counter.
+type countingSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *countingSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+}
+
+func (*countingSink) MeasureSince(string, time.Time, ...string) {}
+
+func (*countingSink) SetGauge(string, int64, ...string) {}
+
+func (s *countingSink) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key]
+}
+
+// newPrebuildService returns a validator service building payloads on top
+// of a committed state at the given slot.
+func newPrebuildService(
+	slot math.Slot,
+) (*testService, *testNode, *testPayloadBuilder, *countingSink) {
+	cfg := DefaultConfig()
+	node := &testNode{st: &testBeaconState{
+		slot: slot,
+		header: &types.ExecutionPayloadHeader{
+			BlockHash: common.ExecutionHash{0x0a},
+			Timestamp: 100,
+		},
+	}}
+	builder := &testPayloadBuilder{}
+	sink := &countingSink{counters: make(map[string]int)}
+	return &testService{
+		cfg:    &cfg,
+		logger: noop.NewLogger[any](),
+		chainSpec: chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot,
+			any,
+		]{
+			SlotsPerHistoricalRoot: 8,
+		}),
+		bsb:                 node,
+		stateProcessor:      testStateProcessor{},
+		localPayloadBuilder: builder,
+		metrics:             newValidatorMetrics(sink),
+		node:                node,
+	}, node, builder, sink
+}
+
+func TestHandleSlotTickPrebuildsPayload(t *testing.T) {
+	s, node, builder, sink := newPrebuildService(12)
+
+	s.handleSlotTick(context.Background(), 13)
+	require.Equal(t, math.Slot(13), s.prebuiltSlot)
+	require.Len(t, builder.async, 1)
+	req := builder.async[0]
+	require.Equal(t, math.Slot(13), req.slot)
+	require.Equal(t, common.Root{12 % 8}, req.parentBlockRoot)
+	require.Equal(t, common.ExecutionHash{0x0a}, req.headBlockHash)
+	require.GreaterOrEqual(t, req.timestamp, uint64(time.Now().Unix()))
+
+	// The committed state is left untouched by the preparation for the
+	// slot.
+	require.Equal(t, math.Slot(12), node.st.slot)
+	require.Zero(t, sink.count("beacon_kit.validator.failed_to_prebuild_payload"))
+}
+
+func TestHandleSlotTickFailures(t *testing.T) {
+	s, node, builder, sink := newPrebuildService(12)
+	s.prebuiltSlot = 11
+
+	// The tick is for a slot that does not follow the committed state.
+	s.handleSlotTick(context.Background(), 14)
+	require.ErrorIs(t,
+		s.prebuildPayload(context.Background(), 14), ErrUnexpectedSlotTick,
+	)
+
+	// The committed state is not available.
+	node.err = errors.New("no committed state")
+	s.handleSlotTick(context.Background(), 13)
+
+	require.Equal(t, math.Slot(11), s.prebuiltSlot)
+	require.Empty(t, builder.async)
+	require.Equal(t, 2,
+		sink.count("beacon_kit.validator.failed_to_prebuild_payload"),
+	)
+}
+
+func TestRetrieveLocalPayloadPrebuiltAccounting(t *testing.T) {
+	const (
+		used   = "beacon_kit.validator.prebuilt_payload_used"
+		missed = "beacon_kit.validator.prebuilt_payload_missed"
+	)
+	s, node, builder, sink := newPrebuildService(12)
+	st := node.st
+	ctx := context.Background()
+
+	// The payload built ahead of the proposal is used.
+	s.handleSlotTick(ctx, 13)
+	_, err := s.retrieveLocalPayload(ctx, st, &types.BeaconBlock{Slot: 13})
+	require.NoError(t, err)
+	require.Equal(t, 1, sink.count(used))
+	require.Zero(t, sink.count(missed))
+	require.Empty(t, builder.sync)
+
+	// The payload built ahead of the proposal is gone, so it is built
+	// synchronously instead.
+	builder.async = nil
+	_, err = s.retrieveLocalPayload(ctx, st, &types.BeaconBlock{Slot: 13})
+	require.NoError(t, err)
+	require.Equal(t, 1, sink.count(used))
+	require.Equal(t, 1, sink.count(missed))
+	require.Len(t, builder.sync, 1)
+
+	// Payloads not built ahead of the proposal are not accounted for.
+	_, err = s.retrieveLocalPayload(ctx, st, &types.BeaconBlock{Slot: 14})
+	require.NoError(t, err)
+	require.Equal(t, 1, sink.count(used))
+	require.Equal(t, 1, sink.count(missed))
+	require.Len(t, builder.sync, 2)
+}
@@ -23,11 +23,13 @@ package validator
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -51,6 +53,8 @@ type Service[
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	ContextT context.Context,
+	NodeT Node[ContextT],
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
@@ -81,17 +85,27 @@ type Service[
 	// Building blocks are done by submitting forkchoice updates through.
 	// The local Builder.
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT]
-	// remotePayloadBuilders represents a list of remote block builders, these
-	// builders are connected to other execution clients via the EngineAPI.
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// externalPayloadBuilder sources payloads from builders outside of this
+	// node, which are used over the local payload if they are worth more.
+	externalPayloadBuilder ExternalPayloadBuilder[
+		BeaconStateT, ExecutionPayloadT,
+	]
+	// blockValues records the values of the blocks proposed by this node.
+	blockValues BlockValueStore
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
-	// blkBroker is a publisher for blocks.
-	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]]
-	// sidecarBroker is a publisher for sidecars.
-	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]]
-	// newSlotSub is a feed for slots.
-	newSlotSub chan *asynctypes.Event[SlotDataT]
+	// slotRequests serves the requests to build a block for a slot.
+	slotRequests RequestHandler[
+		SlotDataT, *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	]
+	// slotTicker ticks ahead of the slots this node is expected to propose,
+	// if payloads are built ahead of the proposals.
+	slotTicker SlotTicker
+	// node is used to query the committed beacon state.
+	node NodeT
+	// prebuiltSlot is the latest slot a payload was built ahead of the
+	// proposal for.
+	prebuiltSlot math.Slot
 }
 
 // NewService creates a new validator service.
@@ -114,6 +128,8 @@ func NewService[
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	ContextT context.Context,
+	NodeT Node[ContextT],
 ](
 	cfg *Config,
 	logger log.Logger[any],
@@ -133,101 +149,139 @@ func NewService[
 		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	externalPayloadBuilder ExternalPayloadBuilder[
+		BeaconStateT, ExecutionPayloadT,
+	],
+	blockValues BlockValueStore,
 	ts TelemetrySink,
-	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]],
-	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]],
-	newSlotSub chan *asynctypes.Event[SlotDataT],
+	slotRequests RequestHandler[
+		SlotDataT, *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	],
+	slotTicker SlotTicker,
 ) *Service[
 	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+	ContextT, NodeT,
 ] {
 	return &Service[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 		BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+		ContextT, NodeT,
 	]{
-		cfg:                   cfg,
-		logger:                logger,
-		bsb:                   bsb,
-		chainSpec:             chainSpec,
-		signer:                signer,
-		stateProcessor:        stateProcessor,
-		blobFactory:           blobFactory,
-		localPayloadBuilder:   localPayloadBuilder,
-		remotePayloadBuilders: remotePayloadBuilders,
-		metrics:               newValidatorMetrics(ts),
-		blkBroker:             blkBroker,
-		sidecarBroker:         sidecarBroker,
-		newSlotSub:            newSlotSub,
+		cfg:                    cfg,
+		logger:                 logger,
+		bsb:                    bsb,
+		chainSpec:              chainSpec,
+		signer:                 signer,
+		stateProcessor:         stateProcessor,
+		blobFactory:            blobFactory,
+		localPayloadBuilder:    localPayloadBuilder,
+		externalPayloadBuilder: externalPayloadBuilder,
+		blockValues:            blockValues,
+		metrics:                newValidatorMetrics(ts),
+		slotRequests:           slotRequests,
+		slotTicker:             slotTicker,
 	}
 }
 
+// AttachNode sets the node the committed beacon state is queried from.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT,
+]) AttachNode(node NodeT) {
+	s.node = node
+}
+
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
 
 // Start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
-	go s.start(ctx)
+	slotReqs, err := s.slotRequests.Serve()
+	if err != nil {
+		return err
+	}
+
+	// Without a slot ticker, ticks is nil and never delivers.
+	var ticks <-chan math.Slot
+	if s.slotTicker != nil {
+		if err = s.slotTicker.Start(ctx); err != nil {
+			return err
+		}
+		ticks = s.slotTicker.Ticks()
+	}
+	go s.start(ctx, slotReqs, ticks)
 	return nil
 }
 
 // start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	_, _,
 ]) start(
 	ctx context.Context,
+	slotReqs <-chan *dispatcher.Request[
+		SlotDataT, *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	],
+	ticks <-chan math.Slot,
 ) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case req := <-s.newSlotSub:
-			if req.Type() == events.NewSlot {
-				s.handleNewSlot(req)
+		case slot := <-ticks:
+			s.handleSlotTick(ctx, slot)
+		case req := <-slotReqs:
+			if !req.Is(events.NewSlot) {
+				s.respond(req, nil, dispatcher.ErrUnhandledRequest)
+				continue
 			}
+			s.handleNewSlot(req)
 		}
 	}
 }
 
-// handleBlockRequest handles a block request.
+// handleNewSlot builds a block and its sidecars for the requested slot.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, SlotDataT,
-]) handleNewSlot(msg *asynctypes.Event[SlotDataT]) {
+	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	_, _,
+]) handleNewSlot(
+	req *dispatcher.Request[
+		SlotDataT, *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	],
+) {
 	blk, sidecars, err := s.buildBlockAndSidecars(
-		msg.Context(), msg.Data(),
+		req.Context(), req.Data(),
 	)
 	if err != nil {
 		s.logger.Error("failed to build block", "err", err)
+		s.respond(req, nil, err)
+		return
 	}
+	s.respond(req, asynctypes.NewBlockBundle(blk, sidecars), nil)
+}
 
-	// Publish our built block to the broker.
-	if blkErr := s.blkBroker.Publish(
-		msg.Context(),
-		asynctypes.NewEvent(
-			msg.Context(), events.BeaconBlockBuilt, blk, err,
-		)); blkErr != nil {
-		// Propagate the error from buildBlockAndSidecars
-		s.logger.Error("failed to publish block", "err", err)
-	}
-
-	// Publish our built blobs to the broker.
-	if sidecarsErr := s.sidecarBroker.Publish(
-		msg.Context(),
-		asynctypes.NewEvent(
-			// Propagate the error from buildBlockAndSidecars
-			msg.Context(), events.BlobSidecarsBuilt, sidecars, err,
-		),
-	); sidecarsErr != nil {
-		s.logger.Error("failed to publish sidecars", "err", err)
+// respond sends the response to a slot request, logging if it fails.
+func (s *Service[
+	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	_, _,
+]) respond(
+	req *dispatcher.Request[
+		SlotDataT, *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	],
+	bundle *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	err error,
+) {
+	if err = req.Respond(bundle, err); err != nil {
+		s.logger.Error("failed to respond to slot request", "err", err)
 	}
 }
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// minSlotClockPollInterval is the lower bound of the interval the slot clock
+// polls the head of the chain at.
+const minSlotClockPollInterval = 100 * time.Millisecond
+
+// SlotClock is a SlotTicker keeping a local estimate of when slots start.
+// The first slot starts at genesis, and every following slot starts once the
+// consensus engine waited for the commit timeout after committing the
+// previous one. The clock is re-anchored every time a new block is observed,
+// and ticks ahead of the slots this node is expected to propose.
+type SlotClock struct {
+	// logger is used for logging messages in the clock.
+	logger log.Logger[any]
+	// schedule estimates the proposers of upcoming slots.
+	schedule ProposerSchedule
+	// pubkey is the public key of this node.
+	pubkey crypto.BLSPubkey
+	// timeoutCommit is how long the consensus engine waits after committing
+	// a block before starting the next slot.
+	timeoutCommit time.Duration
+	// lead is how long ahead of the start of a slot the clock ticks.
+	lead time.Duration
+	// ticks is the channel the slots to prepare for are delivered on.
+	ticks chan math.Slot
+	// head is the latest committed slot observed.
+	head math.Slot
+	// headTime is the time the latest committed slot was observed at.
+	headTime time.Time
+	// ticked is the latest slot the clock considered ticking for.
+	ticked math.Slot
+}
+
+// NewSlotClock creates a new slot clock.
+func NewSlotClock(
+	logger log.Logger[any],
+	schedule ProposerSchedule,
+	pubkey crypto.BLSPubkey,
+	timeoutCommit time.Duration,
+	lead time.Duration,
+) *SlotClock {
+	return &SlotClock{
+		logger:        logger,
+		schedule:      schedule,
+		pubkey:        pubkey,
+		timeoutCommit: timeoutCommit,
+		lead:          lead,
+		ticks:         make(chan math.Slot, 1),
+	}
+}
+
+// Start starts the slot clock.
+func (c *SlotClock) Start(ctx context.Context) error {
+	go c.start(ctx)
+	return nil
+}
+
+// Ticks returns the channel the slots this node is expected to propose are
+// delivered on, ahead of their start.
+func (c *SlotClock) Ticks() <-chan math.Slot {
+	return c.ticks
+}
+
+// start runs the slot clock until the context is cancelled.
+func (c *SlotClock) start(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			timer.Reset(c.tick(ctx))
+		}
+	}
+}
+
+// tick ticks for the next slot if its start is within the lead and this
+// node is expected to propose it. It returns how long to wait before
+// ticking again.
+func (c *SlotClock) tick(ctx context.Context) time.Duration {
+	pollInterval := max(c.timeoutCommit/4, minSlotClockPollInterval)
+	if err := c.observeHead(ctx); err != nil {
+		c.logger.Debug("Failed to observe the head of the chain", "error", err)
+		return pollInterval
+	}
+
+	next := c.head + 1
+	if c.ticked >= next {
+		return pollInterval
+	}
+
+	// Keep polling the head while waiting, as the clock is re-anchored
+	// whenever the slot starts earlier than estimated.
+	start := c.headTime.Add(c.timeoutCommit)
+	if wait := time.Until(start.Add(-c.lead)); wait > 0 {
+		return min(wait, pollInterval)
+	}
+
+	c.ticked = next
+	isProposer, err := c.schedule.IsProposer(ctx, next, c.pubkey)
+	if err != nil {
+		c.logger.Error(
+			"Failed to estimate the proposer of the next slot",
+			"slot", next.Base10(), "error", err,
+		)
+		return pollInterval
+	} else if !isProposer {
+		return pollInterval
+	}
+
+	select {
+	case c.ticks <- next:
+	default:
+		c.logger.Warn(
+			"Dropping slot tick, previous tick not consumed",
+			"slot", next.Base10(),
+		)
+	}
+	return pollInterval
+}
+
+// observeHead re-anchors the clock to the latest committed slot.
+func (c *SlotClock) observeHead(ctx context.Context) error {
+	if c.headTime.IsZero() {
+		genesisTime, err := c.schedule.GenesisTime(ctx)
+		if err != nil {
+			return err
+		}
+		// The first slot starts at genesis, without waiting for a commit.
+		c.headTime = genesisTime.Add(-c.timeoutCommit)
+	}
+
+	head, err := c.schedule.Head(ctx)
+	if err != nil {
+		return err
+	}
+	if head > c.head {
+		c.head, c.headTime = head, time.Now()
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTimeoutCommit = 2 * time.Second
+	testLead          = 500 * time.Millisecond
+	testPollInterval  = testTimeoutCommit / 4
+)
+
+var testPubkey = crypto.BLSPubkey{0x01}
+
+// testSchedule is a proposer schedule whose head and proposers are set by
+// the tests.
+type testSchedule struct {
+	mu          sync.Mutex
+	genesisTime time.Time
+	head        math.Slot
+	proposed    map[math.Slot]bool
+	err         error
+}
+
+func newTestSchedule(
+	genesisTime time.Time,
+	proposed ...math.Slot,
+) *testSchedule {
+	s := &testSchedule{
+		genesisTime: genesisTime,
+		proposed:    make(map[math.Slot]bool),
+	}
+	for _, slot := range proposed {
+		s.proposed[slot] = true
+	}
+	return s
+}
+
+func (s *testSchedule) GenesisTime(context.Context) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.genesisTime, s.err
+}
+
+func (s *testSchedule) Head(context.Context) (math.Slot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.head, s.err
+}
+
+func (s *testSchedule) IsProposer(
+	_ context.Context, slot math.Slot, pubkey crypto.BLSPubkey,
+) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return pubkey == testPubkey && s.proposed[slot], s.err
+}
+
+func (s *testSchedule) setHead(head math.Slot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.head = head
+}
+
+func newTestSlotClock(schedule *testSchedule) *SlotClock {
+	return NewSlotClock(
+		noop.NewLogger[any](), schedule, testPubkey, testTimeoutCommit,
+		testLead,
+	)
+}
+
+// requireTick requires the clock to have ticked for the given slot.
+func requireTick(t *testing.T, c *SlotClock, slot math.Slot) {
+	t.Helper()
+	select {
+	case ticked := <-c.Ticks():
+		require.Equal(t, slot, ticked)
+	default:
+		require.Fail(t, "no tick", "slot %d", slot)
+	}
+}
+
+// requireNoTick requires the clock not to have ticked.
+func requireNoTick(t *testing.T, c *SlotClock) {
+	t.Helper()
+	select {
+	case ticked := <-c.Ticks():
+		require.Fail(t, "unexpected tick", "slot %d", ticked)
+	default:
+	}
+}
+
+func TestSlotClockTicksForProposedSlots(t *testing.T) {
+	schedule := newTestSchedule(time.Now().Add(-time.Minute), 1, 3)
+	c := newTestSlotClock(schedule)
+	ctx := context.Background()
+
+	// The first slot starts at genesis.
+	require.Equal(t, testPollInterval, c.tick(ctx))
+	requireTick(t, c, 1)
+
+	// Every slot is only considered once.
+	require.Equal(t, testPollInterval, c.tick(ctx))
+	requireNoTick(t, c)
+
+	// Slot 2 is proposed by another validator.
+	schedule.setHead(1)
+	c.tick(ctx)
+	c.headTime = time.Now().Add(-testTimeoutCommit)
+	require.Equal(t, testPollInterval, c.tick(ctx))
+	requireNoTick(t, c)
+	require.Equal(t, math.Slot(2), c.ticked)
+
+	schedule.setHead(2)
+	c.tick(ctx)
+	c.headTime = time.Now().Add(-testTimeoutCommit)
+	c.tick(ctx)
+	requireTick(t, c, 3)
+}
+
+func TestSlotClockTicksAheadOfSlotStart(t *testing.T) {
+	genesisTime := time.Now().Add(testLead + 3*testPollInterval)
+	c := newTestSlotClock(newTestSchedule(genesisTime, 1))
+	ctx := context.Background()
+
+	// The clock keeps polling until the start of the slot is within the
+	// lead, and then waits for the remainder only.
+	require.Equal(t, testPollInterval, c.tick(ctx))
+	requireNoTick(t, c)
+
+	c.headTime = c.headTime.Add(-5 * testPollInterval / 2)
+	wait := c.tick(ctx)
+	require.Positive(t, wait)
+	require.Less(t, wait, testPollInterval)
+	requireNoTick(t, c)
+
+	c.headTime = c.headTime.Add(-testPollInterval)
+	c.tick(ctx)
+	requireTick(t, c, 1)
+}
+
+func TestSlotClockReanchorsOnNewHead(t *testing.T) {
+	schedule := newTestSchedule(time.Now().Add(-time.Minute), 6)
+	schedule.setHead(4)
+	c := newTestSlotClock(schedule)
+	ctx := context.Background()
+
+	// The head was committed a while ago as far as the genesis time tells,
+	// but the clock only observed it now, so slot 5 starts after the
+	// commit timeout.
+	c.tick(ctx)
+	require.Equal(t, math.Slot(4), c.head)
+	require.WithinDuration(t, time.Now(), c.headTime, time.Second)
+	require.Equal(t, math.Slot(0), c.ticked)
+
+	// A slot starting earlier than estimated re-anchors the clock to the
+	// time it was observed at.
+	c.headTime = time.Now().Add(-time.Minute)
+	c.tick(ctx)
+	require.Equal(t, math.Slot(5), c.ticked)
+	schedule.setHead(5)
+	c.tick(ctx)
+	require.Equal(t, math.Slot(5), c.head)
+	require.WithinDuration(t, time.Now(), c.headTime, time.Second)
+	requireNoTick(t, c)
+
+	// An older head is never observed.
+	headTime := c.headTime
+	schedule.setHead(3)
+	c.tick(ctx)
+	require.Equal(t, math.Slot(5), c.head)
+	require.Equal(t, headTime, c.headTime)
+}
+
+func TestSlotClockDropsUnconsumedTicks(t *testing.T) {
+	schedule := newTestSchedule(time.Now().Add(-time.Minute), 1, 2)
+	c := newTestSlotClock(schedule)
+	ctx := context.Background()
+
+	c.tick(ctx)
+	schedule.setHead(1)
+	c.tick(ctx)
+	c.headTime = time.Now().Add(-testTimeoutCommit)
+	c.tick(ctx)
+	require.Equal(t, math.Slot(2), c.ticked)
+	requireTick(t, c, 1)
+	requireNoTick(t, c)
+}
+
+func TestSlotClockScheduleErrors(t *testing.T) {
+	schedule := newTestSchedule(time.Now().Add(-time.Minute), 1)
+	schedule.err = errors.New("rpc unavailable")
+	c := newTestSlotClock(schedule)
+
+	require.Equal(t, testPollInterval, c.tick(context.Background()))
+	require.True(t, c.headTime.IsZero())
+	requireNoTick(t, c)
+}
+
+func TestSlotClockStart(t *testing.T) {
+	c := newTestSlotClock(newTestSchedule(time.Now().Add(-time.Minute), 1))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, c.Start(ctx))
+
+	select {
+	case slot := <-c.Ticks():
+		require.Equal(t, math.Slot(1), slot)
+	case <-time.After(time.Second):
+		require.Fail(t, "no tick")
+	}
+}
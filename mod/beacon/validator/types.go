@@ -24,7 +24,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -63,6 +65,8 @@ type BeaconBlock[
 	GetStateRoot() common.Root
 	// GetBody returns the body of the beacon block.
 	GetBody() BeaconBlockBodyT
+	// HashTreeRoot returns the hash tree root of the beacon block.
+	HashTreeRoot() common.Root
 }
 
 // BeaconBlockBody represents a beacon block body interface.
@@ -88,6 +92,9 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetExecutionRequests sets the EIP-7685 encoded execution requests of
+	// the beacon block body.
+	SetExecutionRequests([]bytes.Bytes)
 }
 
 // BeaconState represents a beacon state interface.
@@ -108,6 +115,9 @@ type BeaconState[ExecutionPayloadHeaderT any] interface {
 	// GetEth1DepositIndex returns the latest deposit index from the beacon
 	// state.
 	GetEth1DepositIndex() (uint64, error)
+	// GetDepositRequestsStartIndex returns the index of the first deposit
+	// received through an execution request.
+	GetDepositRequestsStartIndex() (uint64, error)
 	// GetGenesisValidatorsRoot returns the genesis validators root.
 	GetGenesisValidatorsRoot() (common.Root, error)
 }
@@ -135,6 +145,13 @@ type BlobFactory[
 	) (BlobSidecarsT, error)
 }
 
+// BlockValueStore records the values of the blocks proposed by this node.
+type BlockValueStore interface {
+	// SetBlockValue records the value in Wei of the block with the given root
+	// proposed at the given slot.
+	SetBlockValue(slot math.Slot, root common.Root, value *math.U256) error
+}
+
 // DepositStore defines the interface for deposit storage.
 type DepositStore[DepositT any] interface {
 	// GetDepositsByIndex returns `numView` expected deposits.
@@ -167,12 +184,6 @@ type ExecutionPayloadHeader interface {
 // EventSubscription represents the event subscription interface.
 type EventSubscription[T any] chan T
 
-// EventPublisher represents the event publisher interface.
-type EventPublisher[T any] interface {
-	// PublishEvent publishes an event.
-	Publish(context.Context, T) error
-}
-
 // ForkData represents the fork data interface.
 type ForkData[T any] interface {
 	// New creates a new fork data with the given parameters.
@@ -187,6 +198,30 @@ type ForkData[T any] interface {
 	) common.Root
 }
 
+// Node is the interface for a node.
+type Node[ContextT any] interface {
+	// CreateQueryContext creates a query context for a given height and proof
+	// flag.
+	CreateQueryContext(height int64, prove bool) (ContextT, error)
+}
+
+// ExternalPayloadBuilder represents a source of payloads built outside of
+// this node, such as the builder relays.
+type ExternalPayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
+	// Enabled returns true if payloads are sourced externally.
+	Enabled() bool
+	// GetPayload returns a payload for the block of the given slot proposed
+	// on top of the given state, if it commits to the same attributes as
+	// the local payload and is worth more.
+	GetPayload(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		parentBlockRoot common.Root,
+		local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
@@ -196,6 +231,17 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		slot math.Slot,
 		parentBlockRoot common.Root,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+	// RequestPayloadAsync requests a payload for the given slot without
+	// waiting for it to be built.
+	RequestPayloadAsync(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		timestamp uint64,
+		parentBlockRoot common.Root,
+		headEth1BlockHash common.ExecutionHash,
+		finalEth1BlockHash common.ExecutionHash,
+	) (*engineprimitives.PayloadID, error)
 	// RequestPayloadSync requests a payload for the given slot and
 	// blocks until the payload is delivered.
 	RequestPayloadSync(
@@ -209,6 +255,25 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
+// ProposerSchedule estimates the proposers of upcoming slots.
+type ProposerSchedule interface {
+	// GenesisTime returns the time the chain started at.
+	GenesisTime(ctx context.Context) (time.Time, error)
+	// Head returns the latest committed slot.
+	Head(ctx context.Context) (math.Slot, error)
+	// IsProposer returns whether the validator with the given public key
+	// is expected to propose the given slot.
+	IsProposer(
+		ctx context.Context, slot math.Slot, pubkey crypto.BLSPubkey,
+	) (bool, error)
+}
+
+// RequestHandler is the interface for serving the requests of a dispatcher.
+type RequestHandler[ReqT, RespT any] interface {
+	// Serve returns the channel requests are delivered on.
+	Serve() (<-chan *dispatcher.Request[ReqT, RespT], error)
+}
+
 // SlotData represents the slot data interface.
 type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	// GetSlot returns the slot of the incoming slot.
@@ -219,6 +284,15 @@ type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	GetSlashingInfo() []SlashingInfoT
 }
 
+// SlotTicker ticks ahead of the slots this node is expected to propose, such
+// that their payloads are built ahead of the proposals.
+type SlotTicker interface {
+	// Start starts the ticker.
+	Start(ctx context.Context) error
+	// Ticks returns the channel the slots to prepare for are delivered on.
+	Ticks() <-chan math.Slot
+}
+
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
@@ -259,4 +333,7 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
@@ -183,6 +183,10 @@ type Spec[
 	// GetCometBFTConfigForSlot retrieves the CometBFT config for a specific
 	// slot.
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
+
+	// VoteExtensionsEnableHeight returns the height from which validators
+	// extend their votes, or 0 if vote extensions are disabled.
+	VoteExtensionsEnableHeight() uint64
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -470,9 +474,26 @@ func (c chainSpec[
 }
 
 // GetCometBFTConfigForSlot returns the CometBFT configuration for the given
-// slot.
+// slot, that is the one of the latest upgrade activated at or before the slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT {
+	var (
+		cfg        = c.Data.CometValues
+		activation SlotT
+	)
+	for upgradeSlot, upgrade := range c.Data.CometUpgrades {
+		if upgradeSlot <= slot && upgradeSlot >= activation {
+			cfg, activation = upgrade, upgradeSlot
+		}
+	}
+	return cfg
+}
+
+// VoteExtensionsEnableHeight returns the height from which validators extend
+// their votes, or 0 if vote extensions are disabled.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
-]) GetCometBFTConfigForSlot(_ SlotT) CometBFTConfigT {
-	return c.Data.CometValues
+]) VoteExtensionsEnableHeight() uint64 {
+	return c.Data.VoteExtensionsEnableHeight
 }
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetCometBFTConfigForSlot tests the GetCometBFTConfigForSlot method.
+func TestGetCometBFTConfigForSlot(t *testing.T) {
+	type cometConfig struct{ maxBytes int64 }
+
+	spec := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometConfig,
+		]{
+			CometValues: cometConfig{maxBytes: 1},
+			CometUpgrades: map[slot]cometConfig{
+				10: {maxBytes: 2},
+				20: {maxBytes: 3},
+			},
+		},
+	)
+
+	tests := []struct {
+		name     string
+		slot     slot
+		expected int64
+	}{
+		{name: "Genesis", slot: 0, expected: 1},
+		{name: "Before First Upgrade", slot: 9, expected: 1},
+		{name: "At First Upgrade", slot: 10, expected: 2},
+		{name: "Between Upgrades", slot: 19, expected: 2},
+		{name: "At Second Upgrade", slot: 20, expected: 3},
+		{name: "After Second Upgrade", slot: 1000, expected: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.expected, spec.GetCometBFTConfigForSlot(tt.slot).maxBytes,
+			)
+		})
+	}
+}
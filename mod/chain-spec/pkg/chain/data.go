@@ -146,4 +146,11 @@ type SpecData[
 
 	// CometValues
 	CometValues CometBFTConfigT `mapstructure:"comet-bft-config"`
+	// CometUpgrades are the CometBFT configs replacing CometValues, keyed by
+	// the slot at which they are activated.
+	CometUpgrades map[SlotT]CometBFTConfigT `mapstructure:"comet-bft-upgrades"`
+	// VoteExtensionsEnableHeight is the height from which validators extend
+	// their votes to attest to the availability of blob sidecars. A value of
+	// 0 disables vote extensions. Once enabled, it must not be changed.
+	VoteExtensionsEnableHeight uint64 `mapstructure:"vote-extensions-enable-height"`
 }
@@ -59,6 +59,8 @@ type CLIBuilder[
 	// eventually called by the cosmos-sdk.
 	// TODO: CLI should not know about the AppCreator
 	nodeBuilderFunc servertypes.AppCreator[T]
+	// replayerCreator builds the replayer used by the debug replay command.
+	replayerCreator types.ReplayerCreator
 	// rootCmdSetup is a function that sets up the root command.
 	rootCmdSetup rootCmdSetup[T]
 }
@@ -124,6 +126,7 @@ func (cb *CLIBuilder[T, ExecutionPayloadT]) Build() (*cmdlib.Root, error) {
 		rootCmd,
 		mm,
 		cb.nodeBuilderFunc,
+		cb.replayerCreator,
 		chainSpec,
 	)
 
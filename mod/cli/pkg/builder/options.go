@@ -125,3 +125,16 @@ func WithNodeBuilderFunc[
 		cb.nodeBuilderFunc = nodeBuilderFunc
 	}
 }
+
+// WithReplayerCreator sets the function building the journal replayer for
+// the CLIBuilder.
+func WithReplayerCreator[
+	T types.Node,
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+](
+	replayerCreator types.ReplayerCreator,
+) Opt[T, ExecutionPayloadT] {
+	return func(cb *CLIBuilder[T, ExecutionPayloadT]) {
+		cb.replayerCreator = replayerCreator
+	}
+}
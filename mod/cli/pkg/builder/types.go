@@ -35,6 +35,7 @@ type rootCmdSetup[T types.Node] func(
 	cmd *cmdlib.Root,
 	mm *module.Manager,
 	appCreator servertypes.AppCreator[T],
+	replayerCreator types.ReplayerCreator,
 	chainSpec common.ChainSpec,
 )
 
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/require"
+)
+
+// execute runs the db command with the given arguments against the given
+// home directory and returns its output.
+func execute(t *testing.T, home string, args ...string) (string, error) {
+	t.Helper()
+	var (
+		out bytes.Buffer
+		cmd = db.Commands(spec.DevnetChainSpec())
+		ctx = context.WithValue(
+			context.Background(),
+			client.ClientContextKey,
+			&client.Context{HomeDir: home},
+		)
+	)
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+// enqueueDeposits writes deposits with the given indexes to the deposit
+// store of the given home directory.
+func enqueueDeposits(t *testing.T, home string, indexes ...uint64) {
+	t.Helper()
+	kvp, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, "deposits", filepath.Join(home, "data"), nil,
+	)
+	require.NoError(t, err)
+	store := deposit.NewStore[*types.Deposit](storage.NewKVStoreProvider(kvp))
+	for _, index := range indexes {
+		require.NoError(t, store.EnqueueDeposit(&types.Deposit{
+			Amount: 32e9,
+			Index:  index,
+		}))
+	}
+	require.NoError(t, kvp.Close())
+}
+
+func TestCommands(t *testing.T) {
+	cmd := db.Commands(spec.DevnetChainSpec())
+	names := make([]string, 0, len(cmd.Commands()))
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	require.ElementsMatch(t, []string{
+		"get-block", "get-deposit", "get-sidecars", "inspect", "migrate",
+		"prune", "state",
+	}, names)
+}
+
+func TestGetDepositAndPrune(t *testing.T) {
+	home := t.TempDir()
+	enqueueDeposits(t, home, 0, 1, 2, 3)
+
+	out, err := execute(t, home, "get-deposit", "2")
+	require.NoError(t, err)
+	require.Contains(t, out, `"index": 2`)
+	require.Contains(t, out, `"amount": "0x`)
+
+	out, err = execute(t, home, "inspect")
+	require.NoError(t, err)
+	require.Regexp(t, `deposits\s+deposit\s+0x\S+\s+4\s`, out)
+
+	_, err = execute(t, home, "prune", "--store", "deposits", "--below", "3")
+	require.NoError(t, err)
+
+	_, err = execute(t, home, "get-deposit", "2")
+	require.ErrorIs(t, err, deposit.ErrDepositPruned)
+	out, err = execute(t, home, "get-deposit", "3")
+	require.NoError(t, err)
+	require.Contains(t, out, `"index": 3`)
+
+	_, err = execute(t, home, "get-deposit", "7")
+	require.ErrorIs(t, err, db.ErrNotFound)
+}
+
+func TestGetMissing(t *testing.T) {
+	home := t.TempDir()
+
+	_, err := execute(t, home, "get-block", "1")
+	require.Error(t, err)
+	_, err = execute(t, home, "get-sidecars", "1")
+	require.ErrorIs(t, err, db.ErrNotFound)
+	_, err = execute(t, home, "prune", "--store", "state", "--below", "1")
+	require.ErrorIs(t, err, db.ErrUnknownStore)
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	home := t.TempDir()
+
+	out, err := execute(t, home, "migrate", "--dry-run")
+	require.NoError(t, err)
+	require.Contains(t, out, "beacon-state: 0 -> 1")
+	require.Contains(t, out, "5 pending migration(s), none applied.")
+
+	_, err = execute(t, home, "migrate")
+	require.NoError(t, err)
+
+	out, err = execute(t, home, "migrate", "--dry-run")
+	require.NoError(t, err)
+	require.Contains(t, out, "All stores are up to date.")
+}
+
+func TestInspectKnowsAllPrefixes(t *testing.T) {
+	home := t.TempDir()
+	enqueueDeposits(t, home, 0, 1, 2)
+
+	// Migrating seeds the deposit sync cursor and records the schema
+	// versions of the stores.
+	_, err := execute(t, home, "migrate")
+	require.NoError(t, err)
+
+	out, err := execute(t, home, "inspect")
+	require.NoError(t, err)
+	require.Regexp(t, `deposits\s+deposit\s+0x\S+\s+3\s`, out)
+	require.Regexp(t, `deposits\s+next_index\s+0x\S+\s+1\s`, out)
+	require.Regexp(t, `deposits\s+schema_version\s+0x\S+\s+1\s`, out)
+	require.Regexp(t, `blocks\s+block_values\s+0x\S+\s+0\s`, out)
+	require.Regexp(t, `metadata\s+schema_version\s+0x\S+\s+1\s`, out)
+	require.NotContains(t, out, "unknown")
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/cobra"
+)
+
+// NewGetBlockCommand creates a new command for printing a stored block.
+func NewGetBlockCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-block <slot|root>",
+		Short: "Prints a block from the block store as JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			s, err := openStores(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, s.close()) }()
+
+			var slot math.Slot
+			if strings.HasPrefix(args[0], "0x") {
+				var root common.Root
+				if root, err = common.NewRootFromHex(args[0]); err != nil {
+					return err
+				}
+				if slot, err = s.blocks.GetSlotByRoot(root); err != nil {
+					return errors.Wrapf(err, "block root %s", root)
+				}
+			} else if slot, err = parseIndex(args[0]); err != nil {
+				return err
+			}
+
+			s.blocks.SetActiveForkVersion(
+				chainSpec.ActiveForkVersionForSlot(slot),
+			)
+			blk, err := s.blocks.Get(slot)
+			if err != nil {
+				return errors.Wrapf(err, "block at slot %d", slot)
+			}
+			return printJSON(cmd, blk)
+		},
+	}
+}
+
+// NewGetDepositCommand creates a new command for printing a stored deposit.
+func NewGetDepositCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-deposit <index>",
+		Short: "Prints a deposit from the deposit store as JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			index, err := parseIndex(args[0])
+			if err != nil {
+				return err
+			}
+
+			s, err := openStores(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, s.close()) }()
+
+			deposits, err := s.deposits.GetDepositsByIndex(index.Unwrap(), 1)
+			if err != nil {
+				return err
+			}
+			if len(deposits) == 0 {
+				return errors.Wrapf(ErrNotFound, "deposit %d", index)
+			}
+			return printJSON(cmd, deposits[0])
+		},
+	}
+}
+
+// NewGetSidecarsCommand creates a new command for printing the blob sidecars
+// stored for a slot.
+func NewGetSidecarsCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-sidecars <slot>",
+		Short: "Prints the blob sidecars of a slot as JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			slot, err := parseIndex(args[0])
+			if err != nil {
+				return err
+			}
+
+			s, err := openStores(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, s.close()) }()
+
+			sidecars, err := s.availability.GetBlobSidecars(slot)
+			if err != nil {
+				return err
+			}
+			if len(sidecars.Sidecars) == 0 {
+				return errors.Wrapf(ErrNotFound, "sidecars at slot %d", slot)
+			}
+			return printJSON(cmd, sidecars.Sidecars)
+		},
+	}
+}
+
+// parseIndex parses a slot or index given in base 10.
+func parseIndex(arg string) (math.U64, error) {
+	index, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid index %q", arg)
+	}
+	return math.U64(index), nil
+}
+
+// printJSON prints the given value as indented JSON.
+func printJSON(cmd *cobra.Command, v any) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+	return nil
+}
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	corestore "cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/spf13/cobra"
+)
+
+// prefix is a key prefix of a store.
+type prefix struct {
+	name string
+	key  []byte
+}
+
+// prefixStats holds the number of entries and bytes under a prefix.
+type prefixStats struct {
+	prefix
+	entries uint64
+	bytes   uint64
+}
+
+var (
+	// blockPrefixes are the key prefixes of the block store.
+	blockPrefixes = []prefix{
+		{block.BlocksMapName, []byte{block.BlockKeyPrefix}},
+		{block.RootsMapName, []byte{block.RootsKeyPrefix}},
+		{
+			block.ExecutionNumbersMapName,
+			[]byte{block.ExecutionNumbersKeyPrefix},
+		},
+		{block.SchemaVersionItemName, []byte{block.SchemaVersionKeyPrefix}},
+		{block.BlockValuesMapName, []byte{block.BlockValuesKeyPrefix}},
+	}
+	// depositPrefixes are the key prefixes of the deposit store.
+	depositPrefixes = []prefix{
+		{deposit.KeyDepositPrefix, []byte(deposit.KeyDepositPrefix)},
+		{
+			deposit.KeyLowestRetainedPrefix,
+			[]byte(deposit.KeyLowestRetainedPrefix),
+		},
+		{
+			deposit.KeySchemaVersionPrefix,
+			[]byte(deposit.KeySchemaVersionPrefix),
+		},
+		{deposit.KeyNextIndexPrefix, []byte(deposit.KeyNextIndexPrefix)},
+		{deposit.KeyLastBlockPrefix, []byte(deposit.KeyLastBlockPrefix)},
+	}
+	// metadataPrefixes are the key prefixes of the metadata of the beacon
+	// state.
+	metadataPrefixes = []prefix{
+		{keys.SchemaVersionKey, []byte(keys.SchemaVersionKey)},
+	}
+)
+
+// NewInspectCommand creates a new command for listing the key prefixes of
+// the node's local stores and their sizes.
+func NewInspectCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect",
+		Short: "Lists the key prefixes of the local stores and their sizes",
+		Long: `This command lists, for the block, deposit and metadata stores,
+the number of entries and bytes under each key prefix, and for the
+availability store the number of slots and bytes of stored sidecars. The node
+must be stopped while this command runs.`,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			s, err := openStores(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, s.close()) }()
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "STORE\tPREFIX\tKEY\tENTRIES\tBYTES")
+			for _, st := range []struct {
+				name     string
+				db       corestore.KVStoreWithBatch
+				prefixes []prefix
+			}{
+				{blocksDBName, s.blocksDB, blockPrefixes},
+				{depositsDBName, s.depositsDB, depositPrefixes},
+				{metadataDBName, s.metadataDB, metadataPrefixes},
+			} {
+				var stats []prefixStats
+				if stats, err = scanPrefixes(st.db, st.prefixes); err != nil {
+					return err
+				}
+				for _, ps := range stats {
+					fmt.Fprintf(
+						w, "%s\t%s\t%s\t%d\t%d\n", st.name, ps.name,
+						hex.FromBytes(ps.key).Unwrap(), ps.entries, ps.bytes,
+					)
+				}
+			}
+
+			slots, size, err := scanBlobs(filepath.Join(s.dataDir, blobsDir))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s\tslots\t-\t%d\t%d\n", blobsDir, slots, size)
+			return w.Flush()
+		},
+	}
+}
+
+// scanPrefixes counts the entries and bytes stored under each prefix of the
+// given database. Keys matching no prefix are reported as unknown.
+func scanPrefixes(
+	db corestore.KVStoreWithBatch,
+	prefixes []prefix,
+) ([]prefixStats, error) {
+	stats := make([]prefixStats, len(prefixes), len(prefixes)+1)
+	for i, p := range prefixes {
+		stats[i].prefix = p
+	}
+	unknown := prefixStats{prefix: prefix{name: "unknown"}}
+
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		match := &unknown
+		for i := range stats {
+			if bytes.HasPrefix(key, stats[i].key) &&
+				(match == &unknown || len(stats[i].key) > len(match.key)) {
+				match = &stats[i]
+			}
+		}
+		match.entries++
+		match.bytes += uint64(len(key) + len(value))
+	}
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+
+	if unknown.entries > 0 {
+		stats = append(stats, unknown)
+	}
+	return stats, nil
+}
+
+// scanBlobs returns the number of slots and bytes held by the availability
+// store in the given directory.
+func scanBlobs(dir string) (uint64, uint64, error) {
+	var slots, size uint64
+	walkFn := func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			if filepath.Dir(path) == dir {
+				if _, err = strconv.ParseUint(d.Name(), 10, 64); err == nil {
+					slots++
+				}
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		//#nosec:G701 // file sizes are never negative.
+		size += uint64(info.Size())
+		return nil
+	}
+	err := filepath.WalkDir(dir, walkFn)
+	return slots, size, err
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+// FlagDryRun is the flag to print pending migrations without applying them.
+const FlagDryRun = "dry-run"
+
+// NewMigrateCommand creates a new command for migrating the schema of the
+// node's local stores.
+func NewMigrateCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrates the node's local stores to the latest schema",
+		Long: `This command applies the pending schema migrations to the block,
+deposit and availability stores, and records the schema version of the beacon
+state in the node-local metadata. Migrations are also applied when the node
+starts. The node must be stopped while this command runs. Since the beacon
+state is not read by this command, a deposit store migrated by it syncs its
+deposits again from the first execution block, skipping the ones it holds.`,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			dryRun, err := cmd.Flags().GetBool(FlagDryRun)
+			if err != nil {
+				return err
+			}
+
+			s, err := openStores(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, s.close()) }()
+
+			registry, err := components.ProvideMigrationRegistry(
+				components.MigrationRegistryInput{
+					AvailabilityStore: s.availability,
+					BlockStore:        s.blocks,
+					DepositStore:      s.deposits,
+					KVStore:           s.beacon,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return migrate(cmd, registry, dryRun)
+		},
+	}
+
+	cmd.Flags().Bool(
+		FlagDryRun, false, "Print the pending migrations without applying them",
+	)
+	return cmd
+}
+
+// migrate applies, or only prints if dryRun is set, the pending migrations.
+func migrate(
+	cmd *cobra.Command,
+	registry *migration.Registry,
+	dryRun bool,
+) error {
+	var (
+		steps []migration.Step
+		err   error
+	)
+	if dryRun {
+		steps, err = registry.Plan()
+	} else {
+		steps, err = registry.Run(cmd.Context())
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(steps) == 0 {
+		cmd.Println("All stores are up to date.")
+		return nil
+	}
+	for _, step := range steps {
+		cmd.Printf(
+			"%s: %d -> %d (%s)\n",
+			step.Store, step.From, step.To, step.Description,
+		)
+	}
+	if dryRun {
+		cmd.Printf("%d pending migration(s), none applied.\n", len(steps))
+	} else {
+		cmd.Printf("Applied %d migration(s).\n", len(steps))
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagStore is the flag for the store to prune.
+	FlagStore = "store"
+	// FlagBelow is the flag for the exclusive upper bound of the prune.
+	FlagBelow = "below"
+)
+
+// prunableStore is a store that can be pruned manually.
+type prunableStore interface {
+	pruner.Prunable
+	pruner.LowestRetained
+}
+
+// NewPruneCommand creates a new command for manually pruning a store.
+func NewPruneCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prunes a local store below a given slot or index",
+		Long: `This command removes every entry of the given store below the
+given slot, or below the given deposit index for the deposit store. Supported
+stores are "blocks", "deposits" and "blobs". The node must be stopped while
+this command runs.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			name, err := cmd.Flags().GetString(FlagStore)
+			if err != nil {
+				return err
+			}
+			below, err := cmd.Flags().GetUint64(FlagBelow)
+			if err != nil {
+				return err
+			}
+
+			s, err := openStores(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, s.close()) }()
+
+			var store prunableStore
+			switch name {
+			case blocksDBName:
+				store = s.blocks
+			case depositsDBName:
+				store = s.deposits
+			case blobsDir:
+				store = s.blobsDB
+			default:
+				return errors.Wrap(ErrUnknownStore, name)
+			}
+
+			lowest, err := store.GetLowestRetainedIndex()
+			if err != nil {
+				return err
+			}
+			if lowest >= below {
+				cmd.Printf("Nothing to prune, lowest retained is %d.\n", lowest)
+				return nil
+			}
+			if err = store.Prune(lowest, below); err != nil {
+				return err
+			}
+			cmd.Printf("Pruned %s [%d, %d).\n", name, lowest, below)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(
+		FlagStore, blocksDBName, "Store to prune (blocks, deposits or blobs)",
+	)
+	cmd.Flags().Uint64(
+		FlagBelow, 0, "Exclusive upper bound of the slots or indexes to prune",
+	)
+	return cmd
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"cosmossdk.io/core/appmodule"
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// appDBName is the name of the application database.
+	appDBName = "application"
+	// beaconStoreKey is the name of the store holding the beacon state,
+	// which is the name of the beacon module.
+	beaconStoreKey = "beacon"
+)
+
+// NewStateCommand creates a new command for printing the beacon state at a
+// given height.
+func NewStateCommand(chainSpec common.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "state <height>",
+		Short: "Prints the beacon state at a given height as JSON",
+		Long: `This command prints the fields of the beacon state committed at
+the given height, or at the latest height if it is 0. The height must not have
+been pruned from the application database. The node must be stopped while this
+command runs.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			height, err := parseIndex(args[0])
+			if err != nil {
+				return err
+			}
+			dataDir, err := getDataDir(cmd)
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB(
+				appDBName,
+				server.GetAppDBBackend(
+					context.GetServerContextFromCmd(cmd).Viper,
+				),
+				dataDir,
+			)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, db.Close()) }()
+
+			logger := sdklog.NewNopLogger()
+			key := storetypes.NewKVStoreKey(beaconStoreKey)
+			cms := store.NewCommitMultiStore(
+				db, logger, storemetrics.NewNoOpMetrics(),
+			)
+			cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+			if height == 0 {
+				err = cms.LoadLatestVersion()
+			} else {
+				//#nosec:G701 // heights never exceed the maximum int64.
+				err = cms.LoadVersion(int64(height.Unwrap()))
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to load height %d", height)
+			}
+
+			metadataDB, err := openMetadataDB(dataDir)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, metadataDB.Close()) }()
+
+			kvs := components.ProvideKVStore(components.KVStoreInput{
+				Environment: appmodule.Environment{
+					KVStoreService: runtime.NewKVStoreService(key),
+				},
+				MetadataDB: &components.BeaconMetadataDB{
+					KVStoreProvider: storage.NewKVStoreProvider(metadataDB),
+				},
+			}).WithContext(
+				sdk.NewContext(cms.CacheMultiStore(), false, logger),
+			)
+			schemaVersion, err := kvs.GetSchemaVersion()
+			if err != nil {
+				return err
+			}
+			st, err := new(components.BeaconState).
+				NewFromDB(kvs, chainSpec).
+				GetMarshallable()
+			if err != nil {
+				return err
+			}
+
+			return printJSON(cmd, struct {
+				Height        int64                               `json:"height"`
+				SchemaVersion uint64                              `json:"schema_version"`
+				State         *components.BeaconStateMarshallable `json:"state"`
+			}{
+				Height:        cms.LastCommitID().Version,
+				SchemaVersion: schemaVersion,
+				State:         st,
+			})
+		},
+	}
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/core/appmodule"
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2/db"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// blocksDBName is the name of the block store database.
+	blocksDBName = "blocks"
+	// depositsDBName is the name of the deposit store database.
+	depositsDBName = "deposits"
+	// metadataDBName is the name of the database holding the metadata of
+	// the beacon state.
+	metadataDBName = "metadata"
+	// blobsDir is the directory of the availability store.
+	blobsDir = "blobs"
+)
+
+// stores holds the node's local stores, opened from its home directory.
+type stores struct {
+	dataDir      string
+	availability *components.AvailabilityStore
+	blobsDB      *components.IndexDB
+	blocks       *components.BlockStore
+	blocksDB     corestore.KVStoreWithBatch
+	deposits     *components.DepositStore
+	depositsDB   corestore.KVStoreWithBatch
+	beacon       *components.KVStore
+	metadataDB   corestore.KVStoreWithBatch
+	closers      []func() error
+}
+
+// openStores opens the node's local stores. The node must not be running,
+// since the stores can only be opened by a single process.
+func openStores(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (*stores, error) {
+	dataDir, err := getDataDir(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &stores{dataDir: dataDir}
+	blocksDB, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, blocksDBName, dataDir, nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open block store")
+	}
+	s.closers = append(s.closers, blocksDB.Close)
+	s.blocksDB = blocksDB
+	s.blocks = block.NewStore[*components.BeaconBlock](
+		storage.NewKVStoreProvider(blocksDB),
+	)
+
+	depositsDB, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, depositsDBName, dataDir, nil,
+	)
+	if err != nil {
+		return nil, errors.Join(
+			errors.Wrap(err, "failed to open deposit store"), s.close(),
+		)
+	}
+	s.closers = append(s.closers, depositsDB.Close)
+	s.depositsDB = depositsDB
+	s.deposits = deposit.NewStore[*components.Deposit](
+		storage.NewKVStoreProvider(depositsDB),
+	)
+
+	metadataDB, err := openMetadataDB(dataDir)
+	if err != nil {
+		return nil, errors.Join(err, s.close())
+	}
+	s.closers = append(s.closers, metadataDB.Close)
+	s.metadataDB = metadataDB
+	// Only the schema version of the beacon state is read from the local
+	// stores, so the application database holding the state is not opened.
+	s.beacon = components.ProvideKVStore(components.KVStoreInput{
+		Environment: appmodule.Environment{
+			KVStoreService: runtime.NewKVStoreService(
+				storetypes.NewKVStoreKey(beaconStoreKey),
+			),
+		},
+		MetadataDB: &components.BeaconMetadataDB{
+			KVStoreProvider: storage.NewKVStoreProvider(metadataDB),
+		},
+	})
+
+	logger := noop.NewLogger[log.Logger[any]]()
+	s.blobsDB = filedb.NewRangeDB(
+		filedb.NewDB(
+			filedb.WithRootDirectory(filepath.Join(dataDir, blobsDir)),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(logger),
+		),
+	)
+	s.availability = dastore.New[*components.BeaconBlockBody](
+		s.blobsDB, logger, chainSpec,
+	)
+	return s, nil
+}
+
+// openMetadataDB opens the database holding the metadata of the beacon
+// state.
+func openMetadataDB(dataDir string) (corestore.KVStoreWithBatch, error) {
+	db, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, metadataDBName, dataDir, nil,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open metadata store")
+	}
+	return db, nil
+}
+
+// getDataDir returns the data directory of the node's home directory.
+func getDataDir(cmd *cobra.Command) (string, error) {
+	clientCtx, ok := cmd.Context().
+		Value(client.ClientContextKey).(*client.Context)
+	if !ok {
+		return "", ErrNoClientCtx
+	}
+	return filepath.Join(clientCtx.HomeDir, "data"), nil
+}
+
+// close closes the stores.
+func (s *stores) close() error {
+	var errs []error
+	for _, closeFn := range s.closers {
+		errs = append(errs, closeFn())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrReplayUnsupported is returned when the node does not support
+	// replaying journals.
+	ErrReplayUnsupported = errors.New("node does not support replay")
+	// ErrReplayDiverged is returned when a replay diverges from the journal.
+	ErrReplayDiverged = errors.New("replay diverged from the journal")
+	// ErrReplayNoEngine is returned when no execution client is given for
+	// a replay to drive.
+	ErrReplayNoEngine = errors.New("no replay engine set with --engine")
+	// ErrReplayLiveEngine is returned when a replay would drive the
+	// execution client the node is configured with.
+	ErrReplayLiveEngine = errors.New(
+		"replay engine is the execution client of the node",
+	)
+)
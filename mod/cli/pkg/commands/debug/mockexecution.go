@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/mockengine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagAddr is the flag for the address the mock engine listens on.
+	flagAddr = "addr"
+	// defaultMockExecutionAddr is the default address of the mock engine,
+	// matching the default engine dial url of the node.
+	defaultMockExecutionAddr = "localhost:8551"
+	// readHeaderTimeout is the timeout for reading request headers.
+	readHeaderTimeout = 5 * time.Second
+)
+
+// NewMockExecutionCommand creates a new command for serving an in-process
+// mock execution engine.
+func NewMockExecutionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mock-execution <eth-genesis>",
+		Short: "Serves a mock execution engine for local devnets",
+		Long: `This command serves a deterministic mock of the Engine API and of the
+eth namespace methods the node relies on, starting from the given execution
+genesis file. Built blocks carry no transactions, and the engine does not
+authenticate requests, so it must only be used for local testing.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			genesis := new(gethprimitives.Genesis)
+			if err = json.Unmarshal(bz, genesis); err != nil {
+				return err
+			}
+			handler, err := mockengine.NewFromGenesis(genesis).Handler()
+			if err != nil {
+				return err
+			}
+			addr, err := cmd.Flags().GetString(flagAddr)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Serving mock execution engine on %s\n", addr)
+			server := &http.Server{
+				Addr:              addr,
+				Handler:           handler,
+				ReadHeaderTimeout: readHeaderTimeout,
+			}
+			return server.ListenAndServe()
+		},
+	}
+	cmd.Flags().String(
+		flagAddr, defaultMockExecutionAddr,
+		"address the mock execution engine listens on",
+	)
+	return cmd
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	dbm "github.com/cosmos/cosmos-db"
+	clientflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// appDBName is the name of the application database.
+	appDBName = "application"
+	// flagHeight is the flag for the height of the state to replay against.
+	flagHeight = "height"
+	// flagEngine is the flag for the dial url of the throwaway execution
+	// client driven by the replay.
+	flagEngine = "engine"
+)
+
+// replayedData are the entries of the node's data directory, its local
+// stores and the state of its signer, that the replayed services run against
+// a copy of.
+//
+//nolint:gochecknoglobals // list of constant names.
+var replayedData = []string{
+	"blocks.db", "deposits.db", "metadata.db", "blobs",
+	"priv_validator_state.json",
+}
+
+// NewReplayCommand creates a new command for replaying an event journal.
+func NewReplayCommand(replayerCreator types.ReplayerCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <journal>",
+		Short: "Replays an event journal against a copy of the node's state",
+		Long: `This command feeds the requests recorded in an event journal, either a
+single journal file or a journal directory, back through the blockchain and DA
+services and reports every request that resolves differently than recorded.
+
+The services run against a copy of the application state committed at the
+height preceding the first block of the journal, unless --height is set, and
+against temporary copies of the block, deposit and blob stores, so the node's
+data is never modified. They drive the execution client set with --engine,
+which must be a throwaway client: the command refuses to run against the
+execution client the node is configured with. The node must be stopped while
+this command runs.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if replayerCreator == nil {
+				return ErrReplayUnsupported
+			}
+
+			engine, err := cmd.Flags().GetString(flagEngine)
+			if err != nil {
+				return err
+			}
+			if engine == "" {
+				return ErrReplayNoEngine
+			}
+			serverCtx := context.GetServerContextFromCmd(cmd)
+			if sameURL(engine, serverCtx.Viper.GetString(flags.RPCDialURL)) {
+				return errors.Wrap(ErrReplayLiveEngine, engine)
+			}
+
+			entries, err := journal.Read(args[0])
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			if height < 0 {
+				if height, err = components.JournalStartHeight(
+					entries,
+				); err != nil {
+					return err
+				}
+			}
+
+			home := serverCtx.Config.RootDir
+			db, err := dbm.NewDB(
+				appDBName,
+				server.GetAppDBBackend(serverCtx.Viper),
+				filepath.Join(home, "data"),
+			)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, db.Close()) }()
+
+			// the replayed services write to a scratch home holding a copy
+			// of the node's configuration and local stores.
+			scratchHome, err := os.MkdirTemp("", "beacond-replay-")
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, os.RemoveAll(scratchHome)) }()
+			if err = copyHome(home, scratchHome); err != nil {
+				return err
+			}
+			serverCtx.Viper.Set(clientflags.FlagHome, scratchHome)
+			serverCtx.Viper.Set(flags.RPCDialURL, engine)
+
+			replayer, err := replayerCreator(
+				serverCtx.Logger, db, serverCtx.Viper, height,
+			)
+			if err != nil {
+				return err
+			}
+			defer replayer.Close()
+
+			report, err := replayer.Replay(cmd.Context(), entries)
+			if err != nil {
+				return err
+			}
+			printReport(cmd, height, report)
+			if len(report.Divergences) > 0 {
+				return ErrReplayDiverged
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(
+		flagHeight, -1,
+		"height of the state to replay against, derived from the journal "+
+			"if negative",
+	)
+	cmd.Flags().String(
+		flagEngine, "",
+		"dial url of the throwaway execution client driven by the replay",
+	)
+	flags.AddBeaconKitFlags(cmd)
+	return cmd
+}
+
+// printReport prints the report of a replay.
+func printReport(cmd *cobra.Command, height int64, report *journal.Report) {
+	cmd.Printf(
+		"replayed %d requests against the state at height %d "+
+			"(%d entries skipped, %d requests unresolved)\n",
+		report.Replayed, height, report.Skipped, report.Unresolved,
+	)
+	if len(report.Divergences) == 0 {
+		cmd.Println("no divergence found")
+		return
+	}
+	cmd.Printf("%d divergences found:\n", len(report.Divergences))
+	for _, d := range report.Divergences {
+		cmd.Println(d.String())
+	}
+}
+
+// sameURL returns true if both strings are the same url, ignoring the case
+// of the host and a trailing slash.
+func sameURL(a, b string) bool {
+	ua, errA := url.Parse(strings.TrimSuffix(a, "/"))
+	ub, errB := url.Parse(strings.TrimSuffix(b, "/"))
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return strings.EqualFold(ua.Host, ub.Host) &&
+		ua.Scheme == ub.Scheme && ua.Path == ub.Path
+}
+
+// copyHome copies the configuration and the replayed data of the node's home
+// directory to dst. Data the node has not created yet is skipped.
+func copyHome(home, dst string) error {
+	if err := copyDir(
+		filepath.Join(home, "config"), filepath.Join(dst, "config"),
+	); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(dst, "data"), os.ModePerm); err != nil {
+		return err
+	}
+	for _, name := range replayedData {
+		src := filepath.Join(home, "data", name)
+		if _, err := os.Stat(src); errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := copyDir(src, filepath.Join(dst, "data", name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyDir copies the regular files of the src directory tree, or the src
+// file, to dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(
+		src, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(src, path)
+			if err != nil {
+				return err
+			}
+			target := filepath.Join(dst, rel)
+			if entry.IsDir() {
+				return os.MkdirAll(target, os.ModePerm)
+			}
+			if !entry.Type().IsRegular() {
+				return nil
+			}
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to copy %s: %w", path, err)
+			}
+			return os.WriteFile(target, bz, info.Mode().Perm())
+		},
+	)
+}
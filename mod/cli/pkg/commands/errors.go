@@ -27,4 +27,7 @@ var (
 	ErrNoClientCtx = errors.New("client context not found")
 	// ErrNoHomeDir indicates that the home directory was not found.
 	ErrNoHomeDir = errors.New("home directory not found")
+	// ErrGRPCOnlyUnsupported indicates that the node was asked to serve
+	// gRPC only, which it does not serve.
+	ErrGRPCOnlyUnsupported = errors.New("grpc-only mode is not supported")
 )
@@ -23,6 +23,8 @@ package commands
 import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
@@ -47,11 +49,13 @@ func DefaultRootCommandSetup[
 	root *Root,
 	mm *module.Manager,
 	appCreator servertypes.AppCreator[T],
+	replayerCreator types.ReplayerCreator,
 	chainSpec common.ChainSpec,
 ) {
 	// Setup the custom start command options.
 	startCmdOptions := server.StartCmdOptions[T]{
-		AddFlags: flags.AddBeaconKitFlags,
+		AddFlags:            flags.AddBeaconKitFlags,
+		StartCommandHandler: startCommandHandler[T],
 	}
 
 	// Add all the commands to the root command.
@@ -61,6 +65,10 @@ func DefaultRootCommandSetup[
 
 		// `config`
 		confixcmd.ConfigCommand(),
+		// `db`
+		db.Commands(chainSpec),
+		// `debug`
+		debug.Commands(replayerCreator),
 		// `init`
 		genutilcli.InitCmd(mm),
 		// `genesis`
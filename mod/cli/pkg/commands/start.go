@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	abciserver "github.com/cometbft/cometbft/abci/server"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// flagAddress is the address the ABCI server listens on when CometBFT
+	// runs out of process.
+	flagAddress = "address"
+	// flagTransport is the transport of the ABCI server.
+	flagTransport = "transport"
+	// flagTraceStore is the file the KV store traces are written to.
+	flagTraceStore = "trace-store"
+	// flagGRPCOnly starts the node without CometBFT, serving gRPC only.
+	flagGRPCOnly = "grpc-only"
+)
+
+// startCommandHandler starts the node the way the default start command
+// does, except that CometBFT runs the reactors of the node alongside its own
+// when in process. The gRPC and API servers are not started, the node
+// registering no service with them.
+func startCommandHandler[T types.Node](
+	svrCtx *server.Context,
+	_ client.Context,
+	appCreator servertypes.AppCreator[T],
+	inProcessConsensus bool,
+	opts server.StartCmdOptions[T],
+) error {
+	if svrCtx.Viper.GetBool(flagGRPCOnly) {
+		return ErrGRPCOnlyUnsupported
+	}
+	svrCfg, err := serverconfig.GetConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if err = svrCfg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	app, cleanupApp, err := startApp(svrCtx, appCreator, opts)
+	if err != nil {
+		return err
+	}
+	defer cleanupApp()
+
+	if _, err = telemetry.New(svrCfg.Telemetry); err != nil {
+		return err
+	}
+
+	if !inProcessConsensus {
+		return startStandAlone(svrCtx, app)
+	}
+	return startInProcess(svrCtx, app)
+}
+
+// startApp opens the application database and creates the node.
+func startApp[T types.Node](
+	svrCtx *server.Context,
+	appCreator servertypes.AppCreator[T],
+	opts server.StartCmdOptions[T],
+) (T, func(), error) {
+	var app T
+	traceWriter, cleanupTrace, err := server.SetupTraceWriter(
+		svrCtx.Logger, svrCtx.Viper.GetString(flagTraceStore),
+	)
+	if err != nil {
+		return app, nil, err
+	}
+
+	db, err := opts.DBOpener(
+		svrCtx.Config.RootDir, server.GetAppDBBackend(svrCtx.Viper),
+	)
+	if err != nil {
+		cleanupTrace()
+		return app, nil, err
+	}
+
+	app = appCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
+	return app, func() {
+		cleanupTrace()
+		if err = app.Close(); err != nil {
+			svrCtx.Logger.Error(err.Error())
+		}
+	}, nil
+}
+
+// startStandAlone serves the node to a CometBFT running out of process,
+// without the reactors of the node.
+func startStandAlone[T types.Node](svrCtx *server.Context, app T) error {
+	svr, err := abciserver.NewServer(
+		svrCtx.Viper.GetString(flagAddress),
+		svrCtx.Viper.GetString(flagTransport),
+		server.NewCometABCIWrapper(app),
+	)
+	if err != nil {
+		return err
+	}
+	svr.SetLogger(servercmtlog.CometLoggerWrapper{
+		Logger: svrCtx.Logger.With("module", "abci-server"),
+	})
+
+	g, ctx := getCtx(svrCtx, false)
+	g.Go(func() error {
+		if sErr := svr.Start(); sErr != nil {
+			return sErr
+		}
+		<-ctx.Done()
+		return svr.Stop()
+	})
+	return g.Wait()
+}
+
+// startInProcess runs CometBFT in process along with the reactors of the
+// node, until a quit signal is received.
+func startInProcess[T types.Node](svrCtx *server.Context, app T) error {
+	g, ctx := getCtx(svrCtx, true)
+
+	cfg := svrCtx.Config
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+	cmtNode, err := node.NewNode(
+		ctx,
+		cfg,
+		privval.LoadOrGenFilePV(
+			cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(),
+		),
+		nodeKey,
+		proxy.NewLocalClientCreator(server.NewCometABCIWrapper(app)),
+		genDocProvider(cfg),
+		cmtcfg.DefaultDBProvider,
+		node.DefaultMetricsProvider(cfg.Instrumentation),
+		servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger},
+		node.CustomReactors(app.Reactors()),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Let the components of the node read the stores of CometBFT.
+	rpcEnv, err := cmtNode.ConfigureRPC()
+	if err != nil {
+		return err
+	}
+	app.AttachCometStores(cmtNode.BlockStore(), rpcEnv.StateStore)
+
+	if err = cmtNode.Start(); err != nil {
+		return err
+	}
+	defer func() {
+		if cmtNode.IsRunning() {
+			_ = cmtNode.Stop()
+		}
+	}()
+
+	// Wait for the quit signal, which the error group always listens for.
+	return g.Wait()
+}
+
+// getCtx returns an error group whose context is canceled on a quit signal.
+func getCtx(
+	svrCtx *server.Context, block bool,
+) (*errgroup.Group, context.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+	server.ListenForQuitSignals(g, block, cancel, svrCtx.Logger)
+	return g, ctx
+}
+
+// genDocProvider returns the genesis document of the genesis file, along
+// with the checksum the default start command computes for it.
+func genDocProvider(
+	cfg *cmtcfg.Config,
+) func() (node.ChecksummedGenesisDoc, error) {
+	return func() (node.ChecksummedGenesisDoc, error) {
+		doc := node.ChecksummedGenesisDoc{Sha256Checksum: []byte{}}
+		appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+		if err != nil {
+			return doc, err
+		}
+		gen, err := appGenesis.ToGenesisDoc()
+		if err != nil {
+			return doc, err
+		}
+		appState, err := gen.AppState.MarshalJSON()
+		if err != nil {
+			return doc, err
+		}
+		bz, err := json.Marshal(appState)
+		if err != nil {
+			return doc, err
+		}
+		sum := sha256.Sum256(bz)
+		doc.GenesisDoc, doc.Sha256Checksum = gen, sum[:]
+		return doc, nil
+	}
+}
@@ -37,16 +37,22 @@ const (
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
 
 	// Validator Config.
-	validatorRoot = beaconKitRoot + "validator."
-	Graffiti      = validatorRoot + "graffiti"
+	validatorRoot   = beaconKitRoot + "validator."
+	Graffiti        = validatorRoot + "graffiti"
+	EnableSlotClock = validatorRoot + "enable-slot-clock"
+	SlotClockLead   = validatorRoot + "slot-clock-lead"
 
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	RPCFallbackDialURLs     = engineRoot + "rpc-fallback-dial-urls"
+	FallbackJWTSecretPaths  = engineRoot + "fallback-jwt-secret-paths"
+	ShadowDialURLs          = engineRoot + "shadow-dial-urls"
+	ShadowJWTSecretPaths    = engineRoot + "shadow-jwt-secret-paths"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
-	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
+	RPCHealthCheckInterval  = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
 
@@ -68,12 +74,66 @@ const (
 		"pruner-enabled"
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
+	BlockStoreServiceBackfillDepth = blockStoreServiceRoot +
+		"backfill-depth"
+
+	// Execution Sync Config.
+	executionSyncRoot            = beaconKitRoot + "execution-sync."
+	ExecutionSyncInterval        = executionSyncRoot + "interval"
+	ExecutionSyncRetryBackoff    = executionSyncRoot + "retry-backoff"
+	ExecutionSyncMaxRetryBackoff = executionSyncRoot + "max-retry-backoff"
+	ExecutionSyncMaxReplayBlocks = executionSyncRoot + "max-replay-blocks"
+
+	// Pruner Config.
+	prunerRoot          = beaconKitRoot + "pruner."
+	PrunerInterval      = prunerRoot + "interval"
+	PrunerBatchSize     = prunerRoot + "batch-size"
+	PrunerBlocksMaxSize = prunerRoot + "blocks.max-size-bytes"
+	PrunerBlocksMaxAge  = prunerRoot + "blocks.max-age"
+	PrunerBlobsMaxSize  = prunerRoot + "blobs.max-size-bytes"
+	PrunerBlobsMaxAge   = prunerRoot + "blobs.max-age"
 
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+
+	// Journal Config.
+	journalRoot        = beaconKitRoot + "journal."
+	JournalEnabled     = journalRoot + "enabled"
+	JournalDir         = journalRoot + "dir"
+	JournalMaxFileSize = journalRoot + "max-file-size"
+	JournalMaxFiles    = journalRoot + "max-files"
+
+	// Gossip Config.
+	gossipRoot         = beaconKitRoot + "gossip."
+	GossipEnabled      = gossipRoot + "enabled"
+	GossipFetchTimeout = gossipRoot + "fetch-timeout"
+	GossipPoolSize     = gossipRoot + "pool-size"
+
+	// Fee Recipient Config.
+	feeRecipientRoot             = beaconKitRoot + "fee-recipient."
+	FeeRecipientFile             = feeRecipientRoot + "file"
+	FeeRecipientReloadInterval   = feeRecipientRoot + "reload-interval"
+	FeeRecipientAPIEnabled       = feeRecipientRoot + "api-enabled"
+	FeeRecipientAPIListenAddress = feeRecipientRoot + "api-listen-address"
+	FeeRecipientAPITokenPath     = feeRecipientRoot + "api-token-path"
+	FeeRecipientAPIStoreFile     = feeRecipientRoot + "api-store-file"
+
+	// Relay Config.
+	relayRoot                 = beaconKitRoot + "relay."
+	RelayEnabled              = relayRoot + "enabled"
+	RelayURLs                 = relayRoot + "urls"
+	RelayMinBid               = relayRoot + "min-bid"
+	RelayTimeout              = relayRoot + "timeout"
+	RelayGasLimit             = relayRoot + "gas-limit"
+	RelayRegistrationInterval = relayRoot + "registration-interval"
+
+	// Deposit Sync Config.
+	depositSyncRoot          = beaconKitRoot + "deposit-sync."
+	DepositSyncBatchSize     = depositSyncRoot + "batch-size"
+	DepositSyncRetryInterval = depositSyncRoot + "retry-interval"
 )
 
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
@@ -87,6 +147,26 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
+	startCmd.Flags().StringSlice(
+		RPCFallbackDialURLs,
+		defaultCfg.Engine.RPCFallbackDialURLs,
+		"rpc dial urls to fail over to",
+	)
+	startCmd.Flags().StringSlice(
+		FallbackJWTSecretPaths,
+		defaultCfg.Engine.FallbackJWTSecretPaths,
+		"paths to the fallback execution client secrets",
+	)
+	startCmd.Flags().StringSlice(
+		ShadowDialURLs,
+		defaultCfg.Engine.ShadowDialURLs,
+		"rpc dial urls of shadow execution clients",
+	)
+	startCmd.Flags().StringSlice(
+		ShadowJWTSecretPaths,
+		defaultCfg.Engine.ShadowJWTSecretPaths,
+		"paths to the shadow execution client secrets",
+	)
 	startCmd.Flags().Uint64(
 		RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries",
 	)
@@ -103,6 +183,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInterval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
@@ -133,6 +218,16 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Logger.Style,
 		"style",
 	)
+	startCmd.Flags().Bool(
+		EnableSlotClock,
+		defaultCfg.Validator.EnableSlotClock,
+		"enable slot clock",
+	)
+	startCmd.Flags().Duration(
+		SlotClockLead,
+		defaultCfg.Validator.SlotClockLead,
+		"slot clock lead",
+	)
 	startCmd.Flags().Bool(
 		BlockStoreServiceEnabled,
 		defaultCfg.BlockStoreService.Enabled,
@@ -148,6 +243,61 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().Uint64(
+		BlockStoreServiceBackfillDepth,
+		defaultCfg.BlockStoreService.BackfillDepth,
+		"block service backfill depth",
+	)
+	startCmd.Flags().Duration(
+		ExecutionSyncInterval,
+		defaultCfg.ExecutionSync.Interval,
+		"execution sync interval",
+	)
+	startCmd.Flags().Duration(
+		ExecutionSyncRetryBackoff,
+		defaultCfg.ExecutionSync.RetryBackoff,
+		"execution sync forkchoice update retry backoff",
+	)
+	startCmd.Flags().Duration(
+		ExecutionSyncMaxRetryBackoff,
+		defaultCfg.ExecutionSync.MaxRetryBackoff,
+		"execution sync forkchoice update max retry backoff",
+	)
+	startCmd.Flags().Uint64(
+		ExecutionSyncMaxReplayBlocks,
+		defaultCfg.ExecutionSync.MaxReplayBlocks,
+		"execution sync max replayed payloads per reconciliation",
+	)
+	startCmd.Flags().Duration(
+		PrunerInterval,
+		defaultCfg.Pruner.Interval,
+		"pruner interval",
+	)
+	startCmd.Flags().Uint64(
+		PrunerBatchSize,
+		defaultCfg.Pruner.BatchSize,
+		"pruner batch size",
+	)
+	startCmd.Flags().Uint64(
+		PrunerBlocksMaxSize,
+		defaultCfg.Pruner.Blocks.MaxSizeBytes,
+		"block store max size in bytes",
+	)
+	startCmd.Flags().Duration(
+		PrunerBlocksMaxAge,
+		defaultCfg.Pruner.Blocks.MaxAge,
+		"block store max age",
+	)
+	startCmd.Flags().Uint64(
+		PrunerBlobsMaxSize,
+		defaultCfg.Pruner.Blobs.MaxSizeBytes,
+		"blob store max size in bytes",
+	)
+	startCmd.Flags().Duration(
+		PrunerBlobsMaxAge,
+		defaultCfg.Pruner.Blobs.MaxAge,
+		"blob store max age",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -163,4 +313,109 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Bool(
+		JournalEnabled,
+		defaultCfg.Journal.Enabled,
+		"event journal enabled",
+	)
+	startCmd.Flags().String(
+		JournalDir,
+		defaultCfg.Journal.Dir,
+		"event journal directory",
+	)
+	startCmd.Flags().Uint64(
+		JournalMaxFileSize,
+		defaultCfg.Journal.MaxFileSize,
+		"event journal max file size in bytes",
+	)
+	startCmd.Flags().Uint64(
+		JournalMaxFiles,
+		defaultCfg.Journal.MaxFiles,
+		"event journal max files",
+	)
+	startCmd.Flags().Bool(
+		GossipEnabled,
+		defaultCfg.Gossip.Enabled,
+		"gossip reactor enabled",
+	)
+	startCmd.Flags().Duration(
+		GossipFetchTimeout,
+		defaultCfg.Gossip.FetchTimeout,
+		"gossip reactor fetch timeout",
+	)
+	startCmd.Flags().Int(
+		GossipPoolSize,
+		defaultCfg.Gossip.PoolSize,
+		"gossip reactor pool size",
+	)
+	startCmd.Flags().Uint64(
+		DepositSyncBatchSize,
+		defaultCfg.DepositSync.BatchSize,
+		"deposit sync batch size",
+	)
+	startCmd.Flags().Duration(
+		DepositSyncRetryInterval,
+		defaultCfg.DepositSync.RetryInterval,
+		"deposit sync retry interval",
+	)
+	startCmd.Flags().String(
+		FeeRecipientFile,
+		defaultCfg.FeeRecipient.File,
+		"path to the fee recipient file",
+	)
+	startCmd.Flags().Duration(
+		FeeRecipientReloadInterval,
+		defaultCfg.FeeRecipient.ReloadInterval,
+		"fee recipient file reload interval",
+	)
+	startCmd.Flags().Bool(
+		FeeRecipientAPIEnabled,
+		defaultCfg.FeeRecipient.APIEnabled,
+		"fee recipient api enabled",
+	)
+	startCmd.Flags().String(
+		FeeRecipientAPIListenAddress,
+		defaultCfg.FeeRecipient.APIListenAddress,
+		"fee recipient api listen address",
+	)
+	startCmd.Flags().String(
+		FeeRecipientAPITokenPath,
+		defaultCfg.FeeRecipient.APITokenPath,
+		"path to the fee recipient api token",
+	)
+	startCmd.Flags().String(
+		FeeRecipientAPIStoreFile,
+		defaultCfg.FeeRecipient.APIStoreFile,
+		"path to the fee recipient api store file",
+	)
+	startCmd.Flags().Bool(
+		RelayEnabled,
+		defaultCfg.Relay.Enabled,
+		"source payloads from builder relays",
+	)
+	startCmd.Flags().StringSlice(
+		RelayURLs,
+		defaultCfg.Relay.URLs,
+		"builder relay urls",
+	)
+	startCmd.Flags().Uint64(
+		RelayMinBid,
+		defaultCfg.Relay.MinBid,
+		"minimum builder bid in gwei",
+	)
+	startCmd.Flags().Duration(
+		RelayTimeout,
+		defaultCfg.Relay.Timeout,
+		"builder relay timeout",
+	)
+	startCmd.Flags().Uint64(
+		RelayGasLimit,
+		defaultCfg.Relay.GasLimit,
+		"gas limit registered with the builder relays",
+	)
+	startCmd.Flags().Duration(
+		RelayRegistrationInterval,
+		defaultCfg.Relay.RegistrationInterval,
+		"builder relay registration interval",
+	)
 }
@@ -21,16 +21,23 @@
 package config
 
 import (
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
+	executionsync "github.com/berachain/beacon-kit/mod/beacon/execution_sync"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/p2p/pkg/gossip"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/feerecipient"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -47,9 +54,16 @@ func DefaultConfig() *Config {
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
+		FeeRecipient:      feerecipient.DefaultConfig(),
+		Relay:             relay.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
+		ExecutionSync:     executionsync.DefaultConfig(),
+		Pruner:            pruner.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Journal:           journal.DefaultConfig(),
+		Gossip:            gossip.DefaultConfig(),
+		DepositSync:       deposit.DefaultConfig(),
 	}
 }
 
@@ -63,12 +77,27 @@ type Config struct {
 	KZG kzg.Config `mapstructure:"kzg"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// FeeRecipient is the configuration for the fee recipients of the
+	// validators.
+	FeeRecipient feerecipient.Config `mapstructure:"fee-recipient"`
+	// Relay is the configuration for sourcing payloads from builder relays.
+	Relay relay.Config `mapstructure:"relay"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// ExecutionSync is the configuration for the execution sync service.
+	ExecutionSync executionsync.Config `mapstructure:"execution-sync"`
+	// Pruner is the configuration for the storage pruners.
+	Pruner pruner.Config `mapstructure:"pruner"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Journal is the configuration for the event journal.
+	Journal journal.Config `mapstructure:"journal"`
+	// Gossip is the configuration for the gossip reactor.
+	Gossip gossip.Config `mapstructure:"gossip"`
+	// DepositSync is the configuration for the deposit service.
+	DepositSync deposit.Config `mapstructure:"deposit-sync"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -42,9 +42,29 @@ rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Interval for probing the health of the execution client endpoints.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Urls of the execution client JSON-RPC endpoints to fail over to, in order
+# of preference.
+rpc-fallback-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.RPCFallbackDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Paths to the JWT-secrets of the fallback endpoints. Endpoints without a path
+# use the JWT-secret at jwt-secret-path.
+fallback-jwt-secret-paths = [{{ range $i, $path := .BeaconKit.Engine.FallbackJWTSecretPaths }}{{ if $i }}, {{ end }}"{{ $path }}"{{ end }}]
+
+# Urls of shadow execution client JSON-RPC endpoints. Shadows are sent every
+# newPayload and forkchoiceUpdated call, and their responses are compared
+# with the primary's, but never used.
+shadow-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.ShadowDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Paths to the JWT-secrets of the shadow endpoints. Endpoints without a path
+# use the JWT-secret at jwt-secret-path.
+shadow-jwt-secret-paths = [{{ range $i, $path := .BeaconKit.Engine.ShadowJWTSecretPaths }}{{ if $i }}, {{ end }}"{{ $path }}"{{ end }}]
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -77,6 +97,56 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.fee-recipient]
+# File is the path to a JSON or TOML file mapping validator public keys to fee
+# recipients, which is reloaded when it changes. Validators that are not in the
+# file use the suggested-fee-recipient of the payload builder.
+file = "{{ .BeaconKit.FeeRecipient.File }}"
+
+# ReloadInterval is the interval at which the file is checked for changes.
+reload-interval = "{{ .BeaconKit.FeeRecipient.ReloadInterval }}"
+
+# APIEnabled determines if the keymanager fee recipient API is served, through
+# which the fee recipients are changed at runtime. Fee recipients set through
+# the API take precedence over the file, and persist across restarts.
+api-enabled = {{ .BeaconKit.FeeRecipient.APIEnabled }}
+
+# APIListenAddress is the address the fee recipient API is served at.
+api-listen-address = "{{ .BeaconKit.FeeRecipient.APIListenAddress }}"
+
+# APITokenPath is the path to the bearer token that authorizes requests to the
+# fee recipient API. It is required if the API is enabled.
+api-token-path = "{{ .BeaconKit.FeeRecipient.APITokenPath }}"
+
+# APIStoreFile is the path to the JSON file the fee recipients set through the
+# fee recipient API are persisted to. Defaults to data/fee_recipients.json.
+api-store-file = "{{ .BeaconKit.FeeRecipient.APIStoreFile }}"
+
+[beacon-kit.relay]
+# Enabled determines if payloads are sourced from external builders through
+# the relays, falling back to the local payload.
+enabled = {{ .BeaconKit.Relay.Enabled }}
+
+# URLs of the builder API relays that are queried for bids. The public key of
+# a relay may be given as the user of its URL, as in https://0xabc...@relay.xyz,
+# to only accept bids signed with it.
+urls = [{{ range $i, $url := .BeaconKit.Relay.URLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# MinBid is the minimum bid, in Gwei, that a relay has to offer for its payload
+# to be used over the local one.
+min-bid = "{{ .BeaconKit.Relay.MinBid }}"
+
+# Timeout is the time the proposer waits for bids and for the revealed payload
+# before falling back to the local payload.
+timeout = "{{ .BeaconKit.Relay.Timeout }}"
+
+# GasLimit is the gas limit registered with the relays.
+gas-limit = "{{ .BeaconKit.Relay.GasLimit }}"
+
+# RegistrationInterval is the interval at which the validator registration is
+# resubmitted to the relays.
+registration-interval = "{{ .BeaconKit.Relay.RegistrationInterval }}"
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
@@ -85,6 +155,13 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# EnableSlotClock enables the local slot clock, which estimates from the genesis time and
+# timeout_commit when this node proposes next, and builds the payload ahead of the proposal.
+enable-slot-clock = "{{.BeaconKit.Validator.EnableSlotClock}}"
+
+# SlotClockLead is how long ahead of the estimated start of a slot its payload starts being built.
+slot-clock-lead = "{{.BeaconKit.Validator.SlotClockLead}}"
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -95,6 +172,50 @@ pruner-enabled = "{{ .BeaconKit.BlockStoreService.PrunerEnabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+# BackfillDepth is the number of slots below the head to backfill from CometBFT on startup.
+# It is capped at the availability window. If zero, backfilling is disabled.
+backfill-depth = "{{ .BeaconKit.BlockStoreService.BackfillDepth }}"
+
+[beacon-kit.execution-sync]
+# Interval is the interval at which the head of the execution client is
+# reconciled with the head of the beacon chain.
+interval = "{{ .BeaconKit.ExecutionSync.Interval }}"
+
+# RetryBackoff is the backoff of the first retry of a failed forkchoice update.
+# It doubles on every failed attempt.
+retry-backoff = "{{ .BeaconKit.ExecutionSync.RetryBackoff }}"
+
+# MaxRetryBackoff caps the backoff of forkchoice update retries.
+max-retry-backoff = "{{ .BeaconKit.ExecutionSync.MaxRetryBackoff }}"
+
+# MaxReplayBlocks is the number of payloads replayed from the block store to a
+# lagging execution client per reconciliation.
+max-replay-blocks = "{{ .BeaconKit.ExecutionSync.MaxReplayBlocks }}"
+
+[beacon-kit.pruner]
+# Interval is the interval at which pending indexes are pruned in the background.
+# If zero, stores are pruned inline on every finalized block.
+interval = "{{ .BeaconKit.Pruner.Interval }}"
+
+# BatchSize is the maximum number of indexes pruned per interval.
+batch-size = "{{ .BeaconKit.Pruner.BatchSize }}"
+
+[beacon-kit.pruner.blocks]
+# MaxSizeBytes is the disk usage target for the block store. Zero disables it.
+max-size-bytes = "{{ .BeaconKit.Pruner.Blocks.MaxSizeBytes }}"
+
+# MaxAge is the retention for the block store, measured by block
+# timestamps. Zero disables it.
+max-age = "{{ .BeaconKit.Pruner.Blocks.MaxAge }}"
+
+[beacon-kit.pruner.blobs]
+# MaxSizeBytes is the disk usage target for the blob store. Zero disables it.
+max-size-bytes = "{{ .BeaconKit.Pruner.Blobs.MaxSizeBytes }}"
+
+# MaxAge is the retention for the blob store, measured by block
+# timestamps. Zero disables it.
+max-age = "{{ .BeaconKit.Pruner.Blobs.MaxAge }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -104,4 +225,40 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.journal]
+# Enabled determines if the events passing through the node are recorded to a
+# journal that can be replayed with "beacond debug replay".
+enabled = "{{ .BeaconKit.Journal.Enabled }}"
+
+# Dir is the directory of the journal. Defaults to data/journal in the home directory.
+dir = "{{ .BeaconKit.Journal.Dir }}"
+
+# MaxFileSize is the size in bytes at which a journal file is rotated.
+max-file-size = "{{ .BeaconKit.Journal.MaxFileSize }}"
+
+# MaxFiles is the number of journal files kept on disk. Zero keeps every file.
+max-files = "{{ .BeaconKit.Journal.MaxFiles }}"
+
+[beacon-kit.gossip]
+# Enabled determines if blob sidecars are announced in proposals by their KZG
+# commitments and fetched out of band from the peers over a dedicated CometBFT
+# channel, rather than carried inside the proposals. They are still carried
+# inside whenever a peer does not serve the channel.
+enabled = "{{ .BeaconKit.Gossip.Enabled }}"
+
+# FetchTimeout is the timeout of fetching blob sidecars from a single peer.
+fetch-timeout = "{{ .BeaconKit.Gossip.FetchTimeout }}"
+
+# PoolSize is the number of announced blob sidecars kept around to serve peers.
+pool-size = "{{ .BeaconKit.Gossip.PoolSize }}"
+
+[beacon-kit.deposit-sync]
+# BatchSize is the number of execution blocks whose deposit logs are fetched
+# in a single eth_getLogs query.
+batch-size = "{{ .BeaconKit.DepositSync.BatchSize }}"
+
+# RetryInterval is the interval at which the deposit service catches up with
+# the execution blocks it failed to process.
+retry-interval = "{{ .BeaconKit.DepositSync.RetryInterval }}"
 `
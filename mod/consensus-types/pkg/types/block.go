@@ -239,3 +239,9 @@ func (b *BeaconBlock) GetHeader() *BeaconBlockHeader {
 func (b *BeaconBlock) GetExecutionNumber() math.U64 {
 	return b.Body.ExecutionPayload.Number
 }
+
+// GetTimestamp retrieves the timestamp of the BeaconBlock from the
+// ExecutionPayload.
+func (b *BeaconBlock) GetTimestamp() math.U64 {
+	return b.Body.ExecutionPayload.Timestamp
+}
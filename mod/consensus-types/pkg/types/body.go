@@ -26,6 +26,7 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
@@ -289,6 +290,17 @@ func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
 	panic("not implemented")
 }
 
+// GetExecutionRequests returns nil, as BeaconBlockDeneb carries no
+// execution requests.
+func (b *BeaconBlockBody) GetExecutionRequests() []bytes.Bytes {
+	return nil
+}
+
+// SetExecutionRequests is not implemented for BeaconBlockDeneb.
+func (b *BeaconBlockBody) SetExecutionRequests(_ []bytes.Bytes) {
+	panic("not implemented")
+}
+
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 	return []common.Root{
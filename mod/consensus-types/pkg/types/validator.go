@@ -318,6 +318,21 @@ func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch when the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch when the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
@@ -823,6 +823,17 @@ func TestValidator_GetWithdrawableEpoch(t *testing.T) {
 	}
 }
 
+func TestValidator_SetExitAndWithdrawableEpoch(t *testing.T) {
+	v := &types.Validator{
+		ExitEpoch:         math.Epoch(constants.FarFutureEpoch),
+		WithdrawableEpoch: math.Epoch(constants.FarFutureEpoch),
+	}
+	v.SetExitEpoch(10)
+	v.SetWithdrawableEpoch(11)
+	require.Equal(t, math.Epoch(10), v.GetExitEpoch())
+	require.Equal(t, math.Epoch(11), v.GetWithdrawableEpoch())
+}
+
 func TestValidator_GetWithdrawalCredentials(t *testing.T) {
 	tests := []struct {
 		name      string
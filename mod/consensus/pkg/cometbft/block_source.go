@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/store"
+)
+
+// BlockSource reads the blocks committed by the CometBFT node the application
+// runs in straight from its block store.
+type BlockSource struct {
+	stores *Stores
+}
+
+// NewBlockSource returns a new BlockSource reading from the block store of the
+// given stores.
+func NewBlockSource(stores *Stores) *BlockSource {
+	return &BlockSource{stores: stores}
+}
+
+// Base returns the lowest slot available in the CometBFT block store.
+func (s *BlockSource) Base(ctx context.Context) (math.Slot, error) {
+	bs, err := s.blockStore(ctx)
+	if err != nil {
+		return 0, err
+	}
+	//#nosec:G701 // heights are never negative.
+	return math.Slot(bs.Base()), nil
+}
+
+// Head returns the latest slot committed to the CometBFT block store.
+func (s *BlockSource) Head(ctx context.Context) (math.Slot, error) {
+	bs, err := s.blockStore(ctx)
+	if err != nil {
+		return 0, err
+	}
+	//#nosec:G701 // heights are never negative.
+	return math.Slot(bs.Height()), nil
+}
+
+// BlockTxs returns the transactions of the block committed at the given
+// slot.
+func (s *BlockSource) BlockTxs(
+	ctx context.Context,
+	slot math.Slot,
+) ([][]byte, error) {
+	bs, err := s.blockStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G701 // slots never exceed the maximum height.
+	blk, _ := bs.LoadBlock(int64(slot.Unwrap()))
+	if blk == nil {
+		return nil, ErrBlockNotFound
+	}
+
+	txs := make([][]byte, len(blk.Txs))
+	for i, tx := range blk.Txs {
+		txs[i] = tx
+	}
+	return txs, nil
+}
+
+// blockStore waits for the block store of the CometBFT node to be attached.
+func (s *BlockSource) blockStore(
+	ctx context.Context,
+) (*store.BlockStore, error) {
+	select {
+	case <-s.stores.Attached():
+		return s.stores.BlockStore()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -34,33 +34,40 @@ import (
 // eventually fully decouple this.
 type ConsensusEngine[
 	AttestationDataT AttestationData[AttestationDataT],
-	BeaconStateT BeaconState,
+	BeaconStateT BeaconState[ValidatorT],
 	SlashingInfoT SlashingInfo[SlashingInfoT],
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT, SlotDataT],
 	StorageBackendT StorageBackend[BeaconStateT],
+	ValidatorT Validator,
 	ValidatorUpdateT any,
 ] struct {
 	Middleware[AttestationDataT, SlashingInfoT, SlotDataT]
-	sb StorageBackendT
+	cs     ChainSpec
+	sb     StorageBackendT
+	stores *Stores
 }
 
 // NewConsensusEngine returns a new consensus middleware.
 func NewConsensusEngine[
 	AttestationDataT AttestationData[AttestationDataT],
-	BeaconStateT BeaconState,
+	BeaconStateT BeaconState[ValidatorT],
 	SlashingInfoT SlashingInfo[SlashingInfoT],
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT, SlotDataT],
 	StorageBackendT StorageBackend[BeaconStateT],
+	ValidatorT Validator,
 	ValidatorUpdateT any,
 ](
+	cs ChainSpec,
 	m Middleware[AttestationDataT, SlashingInfoT, SlotDataT],
 	sb StorageBackendT,
+	stores *Stores,
 ) *ConsensusEngine[
 	AttestationDataT,
 	BeaconStateT,
 	SlashingInfoT,
 	SlotDataT,
 	StorageBackendT,
+	ValidatorT,
 	ValidatorUpdateT,
 ] {
 	return &ConsensusEngine[
@@ -69,14 +76,17 @@ func NewConsensusEngine[
 		SlashingInfoT,
 		SlotDataT,
 		StorageBackendT,
+		ValidatorT,
 		ValidatorUpdateT,
 	]{
 		Middleware: m,
+		cs:         cs,
 		sb:         sb,
+		stores:     stores,
 	}
 }
 
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) InitGenesis(
+func (c *ConsensusEngine[_, _, _, _, _, _, ValidatorUpdateT]) InitGenesis(
 	ctx context.Context,
 	genesisBz []byte,
 ) ([]ValidatorUpdateT, error) {
@@ -89,7 +99,7 @@ func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) InitGenesis(
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, _]) PrepareProposal(
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) PrepareProposal(
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
@@ -107,16 +117,30 @@ func (c *ConsensusEngine[_, _, _, _, _, _]) PrepareProposal(
 	if err != nil {
 		return nil, err
 	}
-	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
-	}, nil
+	txs := [][]byte{blkBz, sidecarsBz}
+
+	// Carry the extended commit of the previous height, such that the
+	// availability attestations of the validators can be verified.
+	if c.voteExtensionsEnabled(req.Height - 1) {
+		commitBz, cErr := req.LocalLastCommit.Marshal()
+		if cErr != nil {
+			return nil, cErr
+		}
+		txs = append(txs, commitBz)
+	}
+	return &cmtabci.PrepareProposalResponse{Txs: txs}, nil
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) ProcessProposal(
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) ProcessProposal(
 	ctx sdk.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
+	if c.voteExtensionsEnabled(req.Height - 1) {
+		if err := c.verifyExtendedCommit(ctx, req); err != nil {
+			return nil, err
+		}
+	}
 	resp, err := c.Middleware.ProcessProposal(ctx, req)
 	if err != nil {
 		return nil, err
@@ -125,14 +149,38 @@ func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) ProcessProposal(
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) PreBlock(
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) ExtendVote(
+	ctx sdk.Context,
+	req *cmtabci.ExtendVoteRequest,
+) (*cmtabci.ExtendVoteResponse, error) {
+	resp, err := c.Middleware.ExtendVote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*cmtabci.ExtendVoteResponse), nil
+}
+
+// TODO: Decouple Comet Types
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) VerifyVoteExtension(
+	ctx sdk.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) (*cmtabci.VerifyVoteExtensionResponse, error) {
+	resp, err := c.Middleware.VerifyVoteExtension(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*cmtabci.VerifyVoteExtensionResponse), nil
+}
+
+// TODO: Decouple Comet Types
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) PreBlock(
 	ctx sdk.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) error {
 	return c.Middleware.PreBlock(ctx, req)
 }
 
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) EndBlock(
+func (c *ConsensusEngine[_, _, _, _, _, _, ValidatorUpdateT]) EndBlock(
 	ctx context.Context,
 ) ([]ValidatorUpdateT, error) {
 	updates, err := c.Middleware.EndBlock(ctx)
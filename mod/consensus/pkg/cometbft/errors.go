@@ -28,4 +28,31 @@ var (
 	ErrUndefinedValidatorUpdate = errors.New(
 		"undefined validator update",
 	)
+	// ErrMissingExtendedCommit is returned when a proposal does not carry
+	// the extended commit of the previous height.
+	ErrMissingExtendedCommit = errors.New("missing extended commit")
+	// ErrExtendedCommitMismatch is returned when the extended commit carried
+	// by a proposal does not match the commit proposed by CometBFT.
+	ErrExtendedCommitMismatch = errors.New(
+		"extended commit does not match the proposed last commit",
+	)
+	// ErrUnexpectedVoteExtension is returned when a vote that is not for
+	// the committed block carries a vote extension.
+	ErrUnexpectedVoteExtension = errors.New(
+		"unexpected vote extension",
+	)
+	// ErrInvalidVoteExtensionSignature is returned when the signature of a
+	// vote extension is invalid.
+	ErrInvalidVoteExtensionSignature = errors.New(
+		"invalid vote extension signature",
+	)
+	// ErrUnknownSigner is returned when a vote of a commit was cast by a
+	// validator that is not in the validator set that signed it.
+	ErrUnknownSigner = errors.New("vote cast by an unknown validator")
+	// ErrBlockNotFound is returned when a block is not in the CometBFT block
+	// store.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrStoresNotAttached is returned when the stores of the CometBFT node
+	// are accessed before they are attached.
+	ErrStoresNotAttached = errors.New("cometbft stores not attached")
 )
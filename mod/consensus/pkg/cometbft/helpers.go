@@ -55,7 +55,7 @@ func convertValidatorUpdate[ValidatorUpdateT any](
 // convertPrepareProposalToSlotData converts a prepare proposal request to
 // a slot data.
 func (c *ConsensusEngine[
-	_, _, _, SlotDataT, _, _,
+	_, _, _, SlotDataT, _, _, _,
 ]) convertPrepareProposalToSlotData(
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
@@ -93,7 +93,7 @@ func (c *ConsensusEngine[
 
 // attestationsFromVotes returns a list of attestation data from the votes.
 func (c *ConsensusEngine[
-	AttestationDataT, _, _, _, _, _,
+	AttestationDataT, _, _, _, _, _, _,
 ]) attestationsFromVotes(
 	ctx sdk.Context,
 	votes []v1.ExtendedVoteInfo,
@@ -129,7 +129,7 @@ func (c *ConsensusEngine[
 // slashingInfoFromMisbehaviors returns a list of slashing info from the
 // comet misbehaviors.
 func (c *ConsensusEngine[
-	_, _, SlashingInfoT, _, _, _,
+	_, _, SlashingInfoT, _, _, _, _,
 ]) slashingInfoFromMisbehaviors(
 	ctx sdk.Context,
 	misbehaviors []v1.Misbehavior,
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmthttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// maxValidatorsPerPage is the largest page of validators served by the
+// CometBFT RPC server.
+const maxValidatorsPerPage = 100
+
+// ProposerSchedule estimates the proposers of upcoming slots from the
+// proposer priorities of the validator set of a CometBFT node, read through
+// its RPC server.
+type ProposerSchedule struct {
+	client *cmthttp.HTTP
+}
+
+// NewProposerSchedule returns a new ProposerSchedule connected to the
+// CometBFT RPC server listening at the given address.
+func NewProposerSchedule(remote string) (*ProposerSchedule, error) {
+	client, err := cmthttp.New(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &ProposerSchedule{client: client}, nil
+}
+
+// GenesisTime returns the time the chain started at.
+func (s *ProposerSchedule) GenesisTime(
+	ctx context.Context,
+) (time.Time, error) {
+	res, err := s.client.Genesis(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return res.Genesis.GenesisTime, nil
+}
+
+// Head returns the latest slot committed by the CometBFT node.
+func (s *ProposerSchedule) Head(ctx context.Context) (math.Slot, error) {
+	status, err := s.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	//#nosec:G701 // heights are never negative.
+	return math.Slot(status.SyncInfo.LatestBlockHeight), nil
+}
+
+// IsProposer returns whether the validator with the given public key is
+// the proposer of the first round of the given slot. The proposer is the
+// validator with the highest proposer priority, hence the estimate only
+// holds as long as the slot is decided in its first round.
+func (s *ProposerSchedule) IsProposer(
+	ctx context.Context,
+	slot math.Slot,
+	pubkey crypto.BLSPubkey,
+) (bool, error) {
+	vals, err := s.validators(ctx, slot)
+	if err != nil {
+		return false, err
+	}
+
+	proposer := (&cmttypes.ValidatorSet{Validators: vals}).GetProposer()
+	if proposer == nil {
+		return false, nil
+	}
+	return bytes.Equal(
+		proposer.Address, cmtcrypto.AddressHash(pubkey[:]),
+	), nil
+}
+
+// validators returns the validator set, with its proposer priorities, of
+// the given slot.
+func (s *ProposerSchedule) validators(
+	ctx context.Context,
+	slot math.Slot,
+) ([]*cmttypes.Validator, error) {
+	var (
+		//#nosec:G701 // slots never exceed the maximum height.
+		height  = int64(slot.Unwrap())
+		perPage = maxValidatorsPerPage
+		vals    []*cmttypes.Validator
+	)
+	for page := 1; ; page++ {
+		res, err := s.client.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, res.Validators...)
+		if res.Count == 0 || len(vals) >= res.Total {
+			return vals, nil
+		}
+	}
+}
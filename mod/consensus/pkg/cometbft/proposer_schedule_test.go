@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testGenesisTime is the genesis time served by the fake CometBFT node.
+var testGenesisTime = time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeNode serves the validator sets of a CometBFT node by height, paged
+// like the CometBFT RPC server does.
+type fakeNode struct {
+	head int64
+	sets map[int64][]*cmttypes.Validator
+}
+
+func (n *fakeNode) genesis(*rpctypes.Context) (*ctypes.ResultGenesis, error) {
+	return &ctypes.ResultGenesis{
+		Genesis: &cmttypes.GenesisDoc{GenesisTime: testGenesisTime},
+	}, nil
+}
+
+func (n *fakeNode) status(*rpctypes.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{LatestBlockHeight: n.head},
+	}, nil
+}
+
+func (n *fakeNode) validators(
+	_ *rpctypes.Context, height *int64, page, perPage *int,
+) (*ctypes.ResultValidators, error) {
+	vals := n.sets[*height]
+	start := min((*page-1)**perPage, len(vals))
+	end := min(start+*perPage, len(vals))
+	return &ctypes.ResultValidators{
+		BlockHeight: *height,
+		Validators:  vals[start:end],
+		Count:       end - start,
+		Total:       len(vals),
+	}, nil
+}
+
+// serve serves the RPC server of the node and returns a proposer schedule
+// connected to it.
+func (n *fakeNode) serve(t *testing.T) *cometbft.ProposerSchedule {
+	t.Helper()
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, map[string]*rpcserver.RPCFunc{
+		"genesis":    rpcserver.NewRPCFunc(n.genesis, ""),
+		"status":     rpcserver.NewRPCFunc(n.status, ""),
+		"validators": rpcserver.NewRPCFunc(n.validators, "height,page,per_page"),
+	}, cmtlog.NewNopLogger())
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	schedule, err := cometbft.NewProposerSchedule(server.URL)
+	require.NoError(t, err)
+	return schedule
+}
+
+// testValidators returns a validator set with a validator for each of the
+// given proposer priorities, whose address is derived from the BLS public
+// key returned by pubkeyOf.
+func testValidators(priorities ...int64) []*cmttypes.Validator {
+	vals := make([]*cmttypes.Validator, len(priorities))
+	for i, priority := range priorities {
+		pubkey := pubkeyOf(i)
+		vals[i] = &cmttypes.Validator{
+			Address:          cmtcrypto.AddressHash(pubkey[:]),
+			PubKey:           ed25519.GenPrivKey().PubKey(),
+			VotingPower:      10,
+			ProposerPriority: priority,
+		}
+	}
+	return vals
+}
+
+// pubkeyOf returns the BLS public key of the i-th test validator.
+func pubkeyOf(i int) crypto.BLSPubkey {
+	return crypto.BLSPubkey{byte(i >> 8), byte(i)}
+}
+
+func TestProposerScheduleIsProposer(t *testing.T) {
+	node := &fakeNode{sets: map[int64][]*cmttypes.Validator{
+		5: testValidators(-10, 25, 5),
+		6: testValidators(-5, -25, 30),
+	}}
+	schedule := node.serve(t)
+	ctx := context.Background()
+
+	// The validator with the highest proposer priority at the height of
+	// the slot proposes it.
+	for slot, proposer := range map[math.Slot]int{5: 1, 6: 2} {
+		for i := range 3 {
+			isProposer, err := schedule.IsProposer(ctx, slot, pubkeyOf(i))
+			require.NoError(t, err)
+			require.Equal(t, i == proposer, isProposer, "slot %d", slot)
+		}
+	}
+
+	// Nobody proposes a slot without validators.
+	isProposer, err := schedule.IsProposer(ctx, 7, pubkeyOf(0))
+	require.NoError(t, err)
+	require.False(t, isProposer)
+}
+
+func TestProposerScheduleIsProposerPaged(t *testing.T) {
+	// The proposer is only served on the last page of the validator set.
+	priorities := make([]int64, 250)
+	priorities[230] = 100
+	node := &fakeNode{sets: map[int64][]*cmttypes.Validator{
+		5: testValidators(priorities...),
+	}}
+	schedule := node.serve(t)
+
+	isProposer, err := schedule.IsProposer(
+		context.Background(), 5, pubkeyOf(230),
+	)
+	require.NoError(t, err)
+	require.True(t, isProposer)
+}
+
+func TestProposerScheduleHeadAndGenesisTime(t *testing.T) {
+	schedule := (&fakeNode{head: 42}).serve(t)
+
+	genesisTime, err := schedule.GenesisTime(context.Background())
+	require.NoError(t, err)
+	require.True(t, testGenesisTime.Equal(genesisTime))
+
+	head, err := schedule.Head(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(42), head)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package cometbft
+
+import (
+	"sync"
+
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// Stores gives access to the block and state stores of the CometBFT node the
+// application runs in. They are attached once the node is created, and are
+// never attached if CometBFT runs out of process.
+type Stores struct {
+	mu         sync.RWMutex
+	blockStore *store.BlockStore
+	stateStore sm.Store
+	// attached is closed once the stores are attached.
+	attached chan struct{}
+	once     sync.Once
+}
+
+// NewStores returns stores that are not attached yet.
+func NewStores() *Stores {
+	return &Stores{attached: make(chan struct{})}
+}
+
+// Attach sets the block and state stores of the CometBFT node.
+func (s *Stores) Attach(blockStore *store.BlockStore, stateStore sm.Store) {
+	s.mu.Lock()
+	s.blockStore, s.stateStore = blockStore, stateStore
+	s.mu.Unlock()
+	s.once.Do(func() { close(s.attached) })
+}
+
+// Attached returns a channel that is closed once the stores are attached.
+func (s *Stores) Attached() <-chan struct{} {
+	return s.attached
+}
+
+// BlockStore returns the block store of the CometBFT node.
+func (s *Stores) BlockStore() (*store.BlockStore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.blockStore == nil {
+		return nil, ErrStoresNotAttached
+	}
+	return s.blockStore, nil
+}
+
+// Validators returns the validator set of the given height, i.e. the set
+// that signed the commit of the block at that height.
+func (s *Stores) Validators(height int64) (*cmttypes.ValidatorSet, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.stateStore == nil {
+		return nil, ErrStoresNotAttached
+	}
+	return s.stateStore.LoadValidators(height)
+}
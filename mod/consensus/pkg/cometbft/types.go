@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/cosmos/gogoproto/proto"
@@ -38,15 +39,24 @@ type AttestationData[AttestationDataT any] interface {
 }
 
 // BeaconState is an interface for accessing the beacon state.
-type BeaconState interface {
+type BeaconState[ValidatorT any] interface {
 	// GetValidatorIndexByCometBFTAddress returns the validator index by the
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
+	// ValidatorByIndex returns the validator at the given index.
+	ValidatorByIndex(math.ValidatorIndex) (ValidatorT, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() common.Root
 }
 
+// ChainSpec is an interface for accessing the chain specification.
+type ChainSpec interface {
+	// VoteExtensionsEnableHeight returns the height from which validators
+	// extend their votes, or 0 if vote extensions are disabled.
+	VoteExtensionsEnableHeight() uint64
+}
+
 // Middleware is the interface for the CometBFT middleware.
 type Middleware[
 	AttestationDataT,
@@ -60,6 +70,12 @@ type Middleware[
 	ProcessProposal(
 		ctx context.Context, req proto.Message,
 	) (proto.Message, error)
+	ExtendVote(
+		ctx context.Context, req proto.Message,
+	) (proto.Message, error)
+	VerifyVoteExtension(
+		ctx context.Context, req proto.Message,
+	) (proto.Message, error)
 	PreBlock(_ context.Context, req proto.Message) error
 	EndBlock(ctx context.Context) (transition.ValidatorUpdates, error)
 }
@@ -78,7 +94,13 @@ type SlotData[AttestationDataT, SlashingInfoT, SlotDataT any] interface {
 
 // StorageBackend defines an interface for accessing various storage components
 // required by the beacon node.
-type StorageBackend[BeaconStateT BeaconState] interface {
+type StorageBackend[BeaconStateT any] interface {
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
 }
+
+// Validator is an interface for accessing a validator.
+type Validator interface {
+	// GetPubkey returns the BLS public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+}
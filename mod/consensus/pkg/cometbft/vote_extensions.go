@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// extendedCommitTxIndex is the index of the extended commit of the previous
+// height in a proposal, following the beacon block and the blob sidecars.
+const extendedCommitTxIndex = 2
+
+// voteExtensionsEnabled returns whether validators extend their votes at the
+// given height.
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) voteExtensionsEnabled(
+	height int64,
+) bool {
+	enableHeight := c.cs.VoteExtensionsEnableHeight()
+	//#nosec:G701 // heights never overflow an int64.
+	return enableHeight > 0 && height >= int64(enableHeight)
+}
+
+// verifyExtendedCommit verifies that the extended commit of the previous
+// height carried by the proposal matches the commit proposed by CometBFT, and
+// that its vote extensions were signed by the validators that cast the votes.
+// The validators are resolved against the validator set that signed the
+// commit, or against the beacon state if CometBFT runs out of process.
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) verifyExtendedCommit(
+	ctx sdk.Context,
+	req *cmtabci.ProcessProposalRequest,
+) error {
+	if len(req.Txs) <= extendedCommitTxIndex {
+		return ErrMissingExtendedCommit
+	}
+	var commit cmtabci.ExtendedCommitInfo
+	if err := commit.Unmarshal(req.Txs[extendedCommitTxIndex]); err != nil {
+		return err
+	}
+
+	proposed := req.ProposedLastCommit
+	if commit.Round != proposed.Round ||
+		len(commit.Votes) != len(proposed.Votes) {
+		return ErrExtendedCommitMismatch
+	}
+
+	signers, err := c.stores.Validators(req.Height - 1)
+	if err != nil && !errors.Is(err, ErrStoresNotAttached) {
+		return err
+	}
+
+	st := c.sb.StateFromContext(ctx)
+	for i, vote := range commit.Votes {
+		if !bytes.Equal(
+			vote.Validator.Address, proposed.Votes[i].Validator.Address,
+		) ||
+			vote.Validator.Power != proposed.Votes[i].Validator.Power ||
+			vote.BlockIdFlag != proposed.Votes[i].BlockIdFlag {
+			return ErrExtendedCommitMismatch
+		}
+
+		// Only the votes for the committed block are extended.
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			if len(vote.VoteExtension) > 0 ||
+				len(vote.ExtensionSignature) > 0 {
+				return ErrUnexpectedVoteExtension
+			}
+			continue
+		}
+
+		pk, err := c.signerPubKey(signers, st, vote.Validator.Address)
+		if err != nil {
+			return err
+		}
+		if err = verifyVoteExtensionSignature(
+			pk, ctx.HeaderInfo().ChainID, req.Height-1, commit.Round, vote,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signerPubKey returns the public key of the validator with the given
+// address, looked up in the given validator set if any, or in the beacon
+// state otherwise.
+func (c *ConsensusEngine[
+	_, BeaconStateT, _, _, _, _, _,
+]) signerPubKey(
+	signers *cmttypes.ValidatorSet,
+	st BeaconStateT,
+	address []byte,
+) (cmtcrypto.PubKey, error) {
+	if signers != nil {
+		_, val := signers.GetByAddress(address)
+		if val == nil {
+			return nil, ErrUnknownSigner
+		}
+		return val.PubKey, nil
+	}
+
+	index, err := st.ValidatorIndexByCometBFTAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	val, err := st.ValidatorByIndex(index)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := val.GetPubkey()
+	return cryptoenc.PubKeyFromTypeAndBytes(crypto.CometBLSType, pubkey[:])
+}
+
+// verifyVoteExtensionSignature verifies the signature of a vote extension
+// against the public key of the validator that cast the vote.
+func verifyVoteExtensionSignature(
+	pk cmtcrypto.PubKey,
+	chainID string,
+	height int64,
+	round int32,
+	vote v1.ExtendedVoteInfo,
+) error {
+	signBytes := cmttypes.VoteExtensionSignBytes(chainID, &cmtproto.Vote{
+		Extension: vote.VoteExtension,
+		Height:    height,
+		Round:     round,
+	})
+	if !pk.VerifySignature(signBytes, vote.ExtensionSignature) {
+		return ErrInvalidVoteExtensionSignature
+	}
+	return nil
+}
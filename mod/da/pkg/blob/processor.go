@@ -27,8 +27,13 @@ import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	lru "github.com/hashicorp/golang-lru/v2/expirable"
 )
 
+// verifiedSidecarsCacheSize is the maximum number of verified sidecars kept
+// around to be processed.
+const verifiedSidecarsCacheSize = 8
+
 // Processor is the blob processor that handles the processing and verification
 // of blob sidecars.
 type Processor[
@@ -48,6 +53,10 @@ type Processor[
 	blockBodyOffsetFn func(math.Slot, common.ChainSpec) uint64
 	// metrics is used to collect and report processor metrics.
 	metrics *processorMetrics
+	// verified holds the roots of the block headers whose sidecars were
+	// verified, which lets processing report whether they were verified
+	// beforehand.
+	verified *lru.LRU[common.Root, struct{}]
 }
 
 // NewProcessor creates a new blob processor.
@@ -69,6 +78,9 @@ func NewProcessor[
 		verifier:          verifier,
 		blockBodyOffsetFn: blockBodyOffsetFn,
 		metrics:           newProcessorMetrics(telemetrySink),
+		verified: lru.NewLRU[common.Root, struct{}](
+			verifiedSidecarsCacheSize, nil, 0,
+		),
 	}
 }
 
@@ -87,16 +99,23 @@ func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) VerifySidecars(
 	}
 
 	// Verify the blobs and ensure they match the local state.
-	return sp.verifier.VerifySidecars(
+	if err := sp.verifier.VerifySidecars(
 		sidecars,
 		sp.blockBodyOffsetFn(
 			sidecars.Sidecars[0].BeaconBlockHeader.Slot,
 			sp.chainSpec,
 		),
+	); err != nil {
+		return err
+	}
+
+	sp.verified.Add(
+		sidecars.Sidecars[0].BeaconBlockHeader.HashTreeRoot(), struct{}{},
 	)
+	return nil
 }
 
-// slot :=  processes the blobs and ensures they match the local state.
+// ProcessSidecars processes the blobs and ensures they match the local state.
 func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) ProcessSidecars(
 	avs AvailabilityStoreT,
 	sidecars *types.BlobSidecars,
@@ -111,6 +130,17 @@ func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) ProcessSidecars(
 		return nil
 	}
 
+	// Sidecars for this height are of no use past this point.
+	defer sp.verified.Purge()
+
+	if sp.verified.Contains(
+		sidecars.Sidecars[0].BeaconBlockHeader.HashTreeRoot(),
+	) {
+		sp.metrics.markVerifiedSidecarsCacheHit()
+	} else {
+		sp.metrics.markVerifiedSidecarsCacheMiss()
+	}
+
 	// If we have reached this point, we can safely assume that the blobs are
 	// valid and can be persisted, as well as that index 0 is filled.
 	return avs.Persist(
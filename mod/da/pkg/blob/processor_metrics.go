@@ -66,3 +66,19 @@ func (pm *processorMetrics) measureProcessSidecarsDuration(
 		numSidecars.Base10(),
 	)
 }
+
+// markVerifiedSidecarsCacheHit increments the counter for the number of
+// processed sidecars that had already been verified.
+func (pm *processorMetrics) markVerifiedSidecarsCacheHit() {
+	pm.sink.IncrementCounter(
+		"beacon_kit.da.blob.processor.verified_sidecars_cache.hit",
+	)
+}
+
+// markVerifiedSidecarsCacheMiss increments the counter for the number of
+// processed sidecars that had not been verified beforehand.
+func (pm *processorMetrics) markVerifiedSidecarsCacheMiss() {
+	pm.sink.IncrementCounter(
+		"beacon_kit.da.blob.processor.verified_sidecars_cache.miss",
+	)
+}
@@ -23,7 +23,7 @@ package da
 import (
 	"context"
 
-	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 )
@@ -32,8 +32,7 @@ type Service[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
 	BeaconBlockBodyT any,
 	BlobSidecarsT BlobSidecar,
-	//nolint:lll // formatter.
-	EventPublisherSubscriberT EventPublisherSubscriber[*asynctypes.Event[BlobSidecarsT]],
+	RequestHandlerT RequestHandler[BlobSidecarsT, struct{}],
 	ExecutionPayloadT any,
 ] struct {
 	avs AvailabilityStoreT
@@ -41,8 +40,8 @@ type Service[
 		AvailabilityStoreT, BeaconBlockBodyT,
 		BlobSidecarsT, ExecutionPayloadT,
 	]
-	sidecarsBroker EventPublisherSubscriberT
-	logger         log.Logger[any]
+	sidecarsRequests RequestHandlerT
+	logger           log.Logger[any]
 }
 
 // NewService returns a new DA service.
@@ -52,8 +51,7 @@ func NewService[
 	],
 	BeaconBlockBodyT any,
 	BlobSidecarsT BlobSidecar,
-	//nolint:lll // formatter.
-	EventPublisherSubscriberT EventPublisherSubscriber[*asynctypes.Event[BlobSidecarsT]],
+	RequestHandlerT RequestHandler[BlobSidecarsT, struct{}],
 	ExecutionPayloadT any,
 ](
 	avs AvailabilityStoreT,
@@ -61,20 +59,20 @@ func NewService[
 		AvailabilityStoreT, BeaconBlockBodyT,
 		BlobSidecarsT, ExecutionPayloadT,
 	],
-	sidecarsBroker EventPublisherSubscriberT,
+	sidecarsRequests RequestHandlerT,
 	logger log.Logger[any],
 ) *Service[
 	AvailabilityStoreT, BeaconBlockBodyT,
-	BlobSidecarsT, EventPublisherSubscriberT, ExecutionPayloadT,
+	BlobSidecarsT, RequestHandlerT, ExecutionPayloadT,
 ] {
 	return &Service[
 		AvailabilityStoreT, BeaconBlockBodyT,
-		BlobSidecarsT, EventPublisherSubscriberT, ExecutionPayloadT,
+		BlobSidecarsT, RequestHandlerT, ExecutionPayloadT,
 	]{
-		avs:            avs,
-		bp:             bp,
-		sidecarsBroker: sidecarsBroker,
-		logger:         logger,
+		avs:              avs,
+		bp:               bp,
+		sidecarsRequests: sidecarsRequests,
+		logger:           logger,
 	}
 }
 
@@ -85,41 +83,42 @@ func (s *Service[_, _, _, _, _]) Name() string {
 
 // Start starts the service.
 func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
-	subSidecarsCh, err := s.sidecarsBroker.Subscribe()
+	sidecarsReqs, err := s.sidecarsRequests.Serve()
 	if err != nil {
 		return err
 	}
-	go s.start(ctx, subSidecarsCh)
+	go s.start(ctx, sidecarsReqs)
 	return nil
 }
 
 // start starts the service.
 func (s *Service[_, _, BlobSidecarsT, _, _]) start(
 	ctx context.Context,
-	sidecarsCh chan *asynctypes.Event[BlobSidecarsT],
+	sidecarsReqs <-chan *dispatcher.Request[BlobSidecarsT, struct{}],
 ) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case msg := <-sidecarsCh:
-			switch msg.Type() {
+		case req := <-sidecarsReqs:
+			switch req.Type() {
 			case events.BlobSidecarsProcessRequest:
-				s.handleBlobSidecarsProcessRequest(msg)
+				s.handleBlobSidecarsProcessRequest(req)
 			case events.BlobSidecarsReceived:
-				s.handleBlobSidecarsReceived(msg)
+				s.handleBlobSidecarsReceived(req)
+			default:
+				s.respond(req, dispatcher.ErrUnhandledRequest)
 			}
 		}
 	}
 }
 
 // handleBlobSidecarsProcessRequest handles the BlobSidecarsProcessRequest
-// event.
-// It processes the sidecars and publishes a BlobSidecarsProcessed event.
+// request by processing the sidecars.
 func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsProcessRequest(
-	msg *asynctypes.Event[BlobSidecarsT],
+	req *dispatcher.Request[BlobSidecarsT, struct{}],
 ) {
-	err := s.processSidecars(msg.Context(), msg.Data())
+	err := s.processSidecars(req.Context(), req.Data())
 	if err != nil {
 		s.logger.Error(
 			"Failed to process blob sidecars",
@@ -127,26 +126,15 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsProcessRequest(
 			err,
 		)
 	}
-
-	if err = s.sidecarsBroker.Publish(
-		msg.Context(),
-		asynctypes.NewEvent(
-			msg.Context(), events.BlobSidecarsProcessed, msg.Data(), err,
-		)); err != nil {
-		s.logger.Error(
-			"Failed to publish blob sidecars processed event",
-			"error",
-			err,
-		)
-	}
+	s.respond(req, err)
 }
 
-// handleBlobSidecarsReceived handles the BlobSidecarsReceived event.
-// It receives the sidecars and publishes a BlobSidecarsProcessed event.
+// handleBlobSidecarsReceived handles the BlobSidecarsReceived request by
+// verifying the sidecars.
 func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsReceived(
-	msg *asynctypes.Event[BlobSidecarsT],
+	req *dispatcher.Request[BlobSidecarsT, struct{}],
 ) {
-	err := s.receiveSidecars(msg.Data())
+	err := s.receiveSidecars(req.Data())
 	if err != nil {
 		s.logger.Error(
 			"Failed to receive blob sidecars",
@@ -154,14 +142,17 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsReceived(
 			err,
 		)
 	}
+	s.respond(req, err)
+}
 
-	if err = s.sidecarsBroker.Publish(
-		msg.Context(),
-		asynctypes.NewEvent(
-			msg.Context(), events.BlobSidecarsProcessed, msg.Data(), err,
-		)); err != nil {
+// respond sends the response to a request, logging if it fails.
+func (s *Service[_, _, BlobSidecarsT, _, _]) respond(
+	req *dispatcher.Request[BlobSidecarsT, struct{}],
+	err error,
+) {
+	if err = req.Respond(struct{}{}, err); err != nil {
 		s.logger.Error(
-			"Failed to publish blob sidecars processed event",
+			"Failed to respond to blob sidecars request",
 			"error",
 			err,
 		)
@@ -21,8 +21,7 @@
 package da
 
 import (
-	"context"
-
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -62,12 +61,10 @@ type BlobSidecar interface {
 	IsNil() bool
 }
 
-// EventPublisher represents the event publisher interface.
-type EventPublisherSubscriber[T any] interface {
-	// PublishEvent publishes an event.
-	Publish(context.Context, T) error
-	// Subscribe subscribes to the event system.
-	Subscribe() (chan T, error)
+// RequestHandler is the interface for serving the requests of a dispatcher.
+type RequestHandler[ReqT, RespT any] interface {
+	// Serve returns the channel requests are delivered on.
+	Serve() (<-chan *dispatcher.Request[ReqT, RespT], error)
 }
 
 // StorageBackend defines an interface for accessing various storage components
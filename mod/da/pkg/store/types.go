@@ -40,6 +40,7 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 type IndexDB interface {
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	GetByIndex(index uint64) ([][]byte, error)
 }
 
 // BeaconBlockBody is the body of a beacon block.
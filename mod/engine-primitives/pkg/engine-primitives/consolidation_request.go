@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/karalabe/ssz"
+)
+
+// ConsolidationRequestSize is the size of the ConsolidationRequest in bytes.
+const ConsolidationRequestSize = 116 // 20 + 48 + 48
+
+var (
+	_ ssz.StaticObject                    = (*ConsolidationRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*ConsolidationRequest)(nil)
+)
+
+// ConsolidationRequest as defined by EIP-7251, which lets the owner of a
+// validator's withdrawal credentials merge its balance into another
+// validator.
+type ConsolidationRequest struct {
+	// SourceAddress is the address that sent the request, which must match
+	// the source validator's withdrawal credentials.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"`
+	// SourcePubkey is the public key of the validator that is consolidated.
+	SourcePubkey crypto.BLSPubkey `json:"sourcePubkey"`
+	// TargetPubkey is the public key of the validator that receives the
+	// balance of the source validator.
+	TargetPubkey crypto.BLSPubkey `json:"targetPubkey"`
+}
+
+// SizeSSZ returns the size of the ConsolidationRequest in bytes when SSZ
+// encoded.
+func (*ConsolidationRequest) SizeSSZ() uint32 {
+	return ConsolidationRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ConsolidationRequest object.
+func (r *ConsolidationRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &r.SourceAddress)
+	ssz.DefineStaticBytes(c, &r.SourcePubkey)
+	ssz.DefineStaticBytes(c, &r.TargetPubkey)
+}
+
+// HashTreeRoot computes the Merkleization of the ConsolidationRequest object.
+func (r *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// MarshalSSZ marshals the ConsolidationRequest object to SSZ format.
+func (r *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, r.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ unmarshals the SSZ encoded data to a ConsolidationRequest
+// object.
+func (r *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
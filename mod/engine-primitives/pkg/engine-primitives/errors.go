@@ -52,4 +52,8 @@ var (
 	ErrPayloadBlockHashMismatch = errors.New(
 		"block hash in payload does not match assembled block",
 	)
+
+	// ErrInvalidExecutionRequest indicates that an execution request list is
+	// not encoded as per EIP-7685.
+	ErrInvalidExecutionRequest = errors.New("invalid execution request")
 )
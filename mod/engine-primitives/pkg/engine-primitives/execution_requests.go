@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+)
+
+// Request types as defined by EIP-7685 and the Electra execution requests:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#execution-layer-triggered-requests
+//
+//nolint:lll // link.
+const (
+	// DepositRequestType is the EIP-6110 deposit request type.
+	DepositRequestType byte = 0x00
+	// WithdrawalRequestType is the EIP-7002 withdrawal request type.
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the EIP-7251 consolidation request type.
+	ConsolidationRequestType byte = 0x02
+)
+
+// GetExecutionRequestData returns the request data of the given type from a
+// list of EIP-7685 encoded execution requests. Each entry is a request type
+// byte followed by the SSZ encoded requests of that type. Entries must be
+// non-empty and ordered by strictly ascending type. A nil slice is returned
+// if no entry of the given type is present.
+func GetExecutionRequestData(
+	requests []bytes.Bytes,
+	requestType byte,
+) ([]byte, error) {
+	var data []byte
+	for i, request := range requests {
+		if len(request) <= 1 {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequest, "empty request at index %d", i,
+			)
+		}
+		if i > 0 && request[0] <= requests[i-1][0] {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequest,
+				"request type %d at index %d is out of order", request[0], i,
+			)
+		}
+		if request[0] == requestType {
+			data = request[1:]
+		}
+	}
+	return data, nil
+}
+
+// DecodeWithdrawalRequests decodes the request data of the withdrawal
+// requests type into a list of withdrawal requests.
+func DecodeWithdrawalRequests(data []byte) ([]*WithdrawalRequest, error) {
+	return decodeRequests[WithdrawalRequest](data, WithdrawalRequestSize)
+}
+
+// DecodeConsolidationRequests decodes the request data of the consolidation
+// requests type into a list of consolidation requests.
+func DecodeConsolidationRequests(
+	data []byte,
+) ([]*ConsolidationRequest, error) {
+	return decodeRequests[ConsolidationRequest](data, ConsolidationRequestSize)
+}
+
+// decodeRequests decodes a concatenation of fixed size SSZ encoded requests.
+func decodeRequests[
+	T any,
+	PT interface {
+		*T
+		UnmarshalSSZ([]byte) error
+	},
+](data []byte, size int) ([]*T, error) {
+	if len(data)%size != 0 {
+		return nil, errors.Wrapf(
+			ErrInvalidExecutionRequest,
+			"length %d is not a multiple of %d", len(data), size,
+		)
+	}
+	requests := make([]*T, 0, len(data)/size)
+	for i := 0; i < len(data); i += size {
+		request := new(T)
+		if err := PT(request).UnmarshalSSZ(data[i : i+size]); err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExecutionRequestData(t *testing.T) {
+	tests := []struct {
+		name        string
+		requests    []bytes.Bytes
+		requestType byte
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name:        "no requests",
+			requests:    nil,
+			requestType: engineprimitives.DepositRequestType,
+			expected:    nil,
+		},
+		{
+			name: "deposit requests",
+			requests: []bytes.Bytes{
+				{engineprimitives.DepositRequestType, 0x01, 0x02},
+				{engineprimitives.WithdrawalRequestType, 0x03},
+			},
+			requestType: engineprimitives.DepositRequestType,
+			expected:    []byte{0x01, 0x02},
+		},
+		{
+			name: "missing type",
+			requests: []bytes.Bytes{
+				{engineprimitives.WithdrawalRequestType, 0x03},
+			},
+			requestType: engineprimitives.DepositRequestType,
+			expected:    nil,
+		},
+		{
+			name: "empty request",
+			requests: []bytes.Bytes{
+				{engineprimitives.DepositRequestType},
+			},
+			requestType: engineprimitives.DepositRequestType,
+			expectedErr: engineprimitives.ErrInvalidExecutionRequest,
+		},
+		{
+			name: "out of order",
+			requests: []bytes.Bytes{
+				{engineprimitives.WithdrawalRequestType, 0x03},
+				{engineprimitives.DepositRequestType, 0x01},
+			},
+			requestType: engineprimitives.DepositRequestType,
+			expectedErr: engineprimitives.ErrInvalidExecutionRequest,
+		},
+		{
+			name: "duplicate type",
+			requests: []bytes.Bytes{
+				{engineprimitives.DepositRequestType, 0x01},
+				{engineprimitives.DepositRequestType, 0x02},
+			},
+			requestType: engineprimitives.DepositRequestType,
+			expectedErr: engineprimitives.ErrInvalidExecutionRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := engineprimitives.GetExecutionRequestData(
+				tt.requests, tt.requestType,
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, data)
+		})
+	}
+}
+
+func TestDecodeWithdrawalRequests(t *testing.T) {
+	requests := []*engineprimitives.WithdrawalRequest{
+		{
+			SourceAddress:   common.ExecutionAddress{0x01},
+			ValidatorPubkey: crypto.BLSPubkey{0x02},
+			Amount:          math.Gwei(1e9),
+		},
+		{
+			SourceAddress:   common.ExecutionAddress{0x03},
+			ValidatorPubkey: crypto.BLSPubkey{0x04},
+			Amount:          engineprimitives.FullExitRequestAmount,
+		},
+	}
+	var data []byte
+	for _, request := range requests {
+		bz, err := request.MarshalSSZ()
+		require.NoError(t, err)
+		data = append(data, bz...)
+	}
+
+	decoded, err := engineprimitives.DecodeWithdrawalRequests(data)
+	require.NoError(t, err)
+	require.Equal(t, requests, decoded)
+	require.False(t, decoded[0].IsFullExit())
+	require.True(t, decoded[1].IsFullExit())
+
+	_, err = engineprimitives.DecodeWithdrawalRequests(data[1:])
+	require.ErrorIs(t, err, engineprimitives.ErrInvalidExecutionRequest)
+}
+
+func TestDecodeConsolidationRequests(t *testing.T) {
+	request := &engineprimitives.ConsolidationRequest{
+		SourceAddress: common.ExecutionAddress{0x01},
+		SourcePubkey:  crypto.BLSPubkey{0x02},
+		TargetPubkey:  crypto.BLSPubkey{0x03},
+	}
+	data, err := request.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, engineprimitives.ConsolidationRequestSize)
+
+	decoded, err := engineprimitives.DecodeConsolidationRequests(data)
+	require.NoError(t, err)
+	require.Equal(t, []*engineprimitives.ConsolidationRequest{request}, decoded)
+
+	decoded, err = engineprimitives.DecodeConsolidationRequests(nil)
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	_, err = engineprimitives.DecodeConsolidationRequests(data[:100])
+	require.ErrorIs(t, err, engineprimitives.ErrInvalidExecutionRequest)
+}
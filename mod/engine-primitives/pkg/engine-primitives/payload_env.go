@@ -21,6 +21,7 @@
 package engineprimitives
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -36,6 +37,9 @@ type BuiltExecutionPayloadEnv[ExecutionPayloadT any] interface {
 	GetBlobsBundle() BlobsBundle
 	// ShouldOverrideBuilder indicates if the builder should be overridden.
 	ShouldOverrideBuilder() bool
+	// GetExecutionRequests returns the EIP-7685 requests of the payload,
+	// which are only returned from Electra onwards.
+	GetExecutionRequests() []bytes.Bytes
 }
 
 // BlobsBundle is an interface for the blobs bundle.
@@ -56,10 +60,11 @@ type ExecutionPayloadEnvelope[
 	ExecutionPayloadT constraints.JSONMarshallable,
 	BlobsBundleT BlobsBundle,
 ] struct {
-	ExecutionPayload ExecutionPayloadT `json:"executionPayload"`
-	BlockValue       *math.U256        `json:"blockValue"`
-	BlobsBundle      BlobsBundleT      `json:"blobsBundle"`
-	Override         bool              `json:"shouldOverrideBuilder"`
+	ExecutionPayload  ExecutionPayloadT `json:"executionPayload"`
+	BlockValue        *math.U256        `json:"blockValue"`
+	BlobsBundle       BlobsBundleT      `json:"blobsBundle"`
+	Override          bool              `json:"shouldOverrideBuilder"`
+	ExecutionRequests []bytes.Bytes     `json:"executionRequests,omitempty"`
 }
 
 // GetExecutionPayload returns the execution payload of the
@@ -90,3 +95,11 @@ func (e *ExecutionPayloadEnvelope[
 ]) ShouldOverrideBuilder() bool {
 	return e.Override
 }
+
+// GetExecutionRequests returns the execution requests of the
+// ExecutionPayloadEnvelope.
+func (e *ExecutionPayloadEnvelope[
+	ExecutionPayloadT, BlobsBundleT,
+]) GetExecutionRequests() []bytes.Bytes {
+	return e.ExecutionRequests
+}
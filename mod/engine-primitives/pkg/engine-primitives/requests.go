@@ -69,6 +69,12 @@ type NewPayloadRequest[
 	VersionedHashes []common.ExecutionHash
 	// ParentBeaconBlockRoot is the root of the parent beacon block.
 	ParentBeaconBlockRoot *common.Root
+	// ExecutionRequests are the EIP-7685 requests of the block, which are
+	// only sent to the execution client from Electra onwards.
+	ExecutionRequests []bytes.Bytes
+	// ForkVersion is the fork version of the block, which determines the
+	// version of the engine API method the payload is sent with.
+	ForkVersion uint32
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
@@ -111,12 +117,14 @@ func BuildNewPayloadRequest[
 	executionPayload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	forkVersion uint32,
 	optimistic bool,
 ) *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT] {
 	return &NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]{
 		ExecutionPayload:      executionPayload,
 		VersionedHashes:       versionedHashes,
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
+		ForkVersion:           forkVersion,
 		Optimistic:            optimistic,
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -101,12 +102,14 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	executionPayload := MockExecutionPayload{}
 	var versionedHashes []common.ExecutionHash
 	parentBeaconBlockRoot := common.Root{}
+	forkVersion := uint32(1)
 	optimistic := false
 
 	request := engineprimitives.BuildNewPayloadRequest(
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		forkVersion,
 		optimistic,
 	)
 
@@ -114,6 +117,7 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	require.Equal(t, executionPayload, request.ExecutionPayload)
 	require.Equal(t, versionedHashes, request.VersionedHashes)
 	require.Equal(t, &parentBeaconBlockRoot, request.ParentBeaconBlockRoot)
+	require.Equal(t, forkVersion, request.ForkVersion)
 	require.Equal(t, optimistic, request.Optimistic)
 }
 
@@ -155,6 +159,7 @@ func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		version.Deneb,
 		optimistic,
 	)
 
@@ -174,6 +179,7 @@ func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		version.Deneb,
 		optimistic,
 	)
 
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// WithdrawalRequestSize is the size of the WithdrawalRequest in bytes.
+	WithdrawalRequestSize = 76 // 20 + 48 + 8
+
+	// FullExitRequestAmount is the amount of a withdrawal request that
+	// requests a full exit of the validator.
+	FullExitRequestAmount = 0
+)
+
+var (
+	_ ssz.StaticObject                    = (*WithdrawalRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*WithdrawalRequest)(nil)
+)
+
+// WithdrawalRequest as defined by EIP-7002, which lets the owner of a
+// validator's withdrawal credentials trigger an exit or a partial withdrawal
+// from the execution layer.
+type WithdrawalRequest struct {
+	// SourceAddress is the address that sent the request, which must match
+	// the validator's withdrawal credentials.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"`
+	// ValidatorPubkey is the public key of the validator.
+	ValidatorPubkey crypto.BLSPubkey `json:"validatorPubkey"`
+	// Amount is the amount of Gwei to withdraw, or FullExitRequestAmount to
+	// exit the validator.
+	Amount math.Gwei `json:"amount"`
+}
+
+// SizeSSZ returns the size of the WithdrawalRequest in bytes when SSZ
+// encoded.
+func (*WithdrawalRequest) SizeSSZ() uint32 {
+	return WithdrawalRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequest object.
+func (r *WithdrawalRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &r.SourceAddress)
+	ssz.DefineStaticBytes(c, &r.ValidatorPubkey)
+	ssz.DefineUint64(c, &r.Amount)
+}
+
+// HashTreeRoot computes the Merkleization of the WithdrawalRequest object.
+func (r *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// MarshalSSZ marshals the WithdrawalRequest object to SSZ format.
+func (r *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, r.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ unmarshals the SSZ encoded data to a WithdrawalRequest object.
+func (r *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+// IsFullExit returns true if the request asks for a full exit of the
+// validator.
+func (r *WithdrawalRequest) IsFullExit() bool {
+	return r.Amount == FullExitRequestAmount
+}
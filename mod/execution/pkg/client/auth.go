@@ -24,14 +24,27 @@ import (
 	"context"
 	"net/http"
 	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 )
 
-// jwtRefreshLoop refreshes the JWT token for the execution client.
+// jwtRefreshLoop refreshes the JWT token for the execution client
+// endpoints dialed with HTTP(S).
 func (s *EngineClient[
-	_, _,
+	ExecutionPayloadT, _,
 ]) jwtRefreshLoop(
 	ctx context.Context,
 ) {
+	endpoints := make([]*endpoint[ExecutionPayloadT], 0)
+	for _, ep := range s.endpoints {
+		if ep.isHTTP() && ep.JWTSecret != nil {
+			endpoints = append(endpoints, ep)
+		}
+	}
+	if len(endpoints) == 0 {
+		return
+	}
+
 	s.logger.Info("Starting JWT refresh loop 🔄")
 	ticker := time.NewTicker(s.cfg.RPCJWTRefreshInterval)
 	for {
@@ -40,26 +53,49 @@ func (s *EngineClient[
 			ticker.Stop()
 			return
 		case <-ticker.C:
-			if err := s.dialExecutionRPCClient(ctx); err != nil {
-				s.logger.Error(
-					"Failed to refresh engine auth token",
-					"err",
-					err,
-				)
+			for _, ep := range endpoints {
+				s.refreshJWT(ctx, ep)
 			}
 		}
 	}
 }
 
+// refreshJWT re-dials a connected endpoint with a fresh JWT token.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) refreshJWT(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+) {
+	s.mu.RLock()
+	connected := ep.client != nil
+	s.mu.RUnlock()
+	if !connected {
+		// The endpoint is re-dialed by the next health probe.
+		return
+	}
+
+	client, err := s.dialExecutionRPCClient(ctx, ep)
+	if err != nil {
+		s.logger.Error(
+			"Failed to refresh engine auth token",
+			"dial_url", ep.DialURL.String(),
+			"err", err,
+		)
+		return
+	}
+	s.setClient(ep, client)
+}
+
 // buildJWTHeader builds an http.Header that has the JWT token
 // attached for authorization.
 func (s *EngineClient[
 	_, _,
-]) buildJWTHeader() (http.Header, error) {
+]) buildJWTHeader(secret *jwt.Secret) (http.Header, error) {
 	header := make(http.Header)
 
 	// Build the JWT token.
-	token, err := buildSignedJWT(s.jwtSecret)
+	token, err := buildSignedJWT(secret)
 	if err != nil {
 		s.logger.Error("Failed to build JWT token", "err", err)
 		return header, err
@@ -25,6 +25,7 @@ import (
 	"math/big"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -36,34 +37,51 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 )
 
-// EngineClient is a struct that holds a pointer to an Eth1Client.
+// EngineClient is a client of the execution client, which routes every
+// call to the client of the active endpoint.
 type EngineClient[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
 ] struct {
-	// Eth1Client is a struct that holds the Ethereum 1 client and
-	// its configuration.
-	*ethclient.Eth1Client[ExecutionPayloadT]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
 	logger log.Logger[any]
-	// jwtSecret is the JWT secret for the execution client.
-	jwtSecret *jwt.Secret
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
+
+	// switchMu serializes switching between endpoints.
+	switchMu sync.Mutex
+	// mu protects the endpoints, the active endpoint, its capabilities and
+	// the last forkchoice.
+	mu sync.RWMutex
+	// endpoints are the execution client endpoints, in order of priority.
+	endpoints []*endpoint[ExecutionPayloadT]
+	// active is the index of the endpoint in use, or -1 if there is none.
+	active int
+	// capabilities is a map of capabilities that the execution client of
+	// the active endpoint has.
+	capabilities map[string]struct{}
+	// lastForkchoice is the last forkchoice accepted by the active
+	// endpoint, replayed to an endpoint after failing over to it.
+	lastForkchoice *forkchoice
+	// shadows are the shadow execution clients.
+	shadows []*shadow[ExecutionPayloadT]
+	// forkVersions are the fork versions whose engine API methods the
+	// execution client must serve.
+	forkVersions []uint32
+	// forkVersionsFn returns further fork versions whose engine API methods
+	// the execution client must serve, or is nil.
+	forkVersionsFn func() []uint32
 }
 
 // New creates a new engine client EngineClient.
-// It takes an Eth1Client as an argument and returns a pointer  to an
-// EngineClient.
+// It dials the configured RPCDialURL using the given JWT secret.
 func New[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
@@ -73,18 +91,41 @@ func New[
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
+	opts ...Option,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	endpoints := make(
+		[]*endpoint[ExecutionPayloadT], 0, len(o.fallbacks)+1,
+	)
+	endpoints = append(endpoints, &endpoint[ExecutionPayloadT]{
+		Endpoint: Endpoint{DialURL: cfg.RPCDialURL, JWTSecret: jwtSecret},
+		err:      ErrEndpointNotProbed,
+	})
+	for _, fallback := range o.fallbacks {
+		endpoints = append(endpoints, &endpoint[ExecutionPayloadT]{
+			Endpoint: fallback,
+			err:      ErrEndpointNotProbed,
+		})
+	}
+
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
-		cfg:          cfg,
-		logger:       logger,
-		jwtSecret:    jwtSecret,
-		Eth1Client:   new(ethclient.Eth1Client[ExecutionPayloadT]),
-		capabilities: make(map[string]struct{}),
-		engineCache:  cache.NewEngineCacheWithDefaultConfig(),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
+		cfg:            cfg,
+		logger:         logger,
+		capabilities:   make(map[string]struct{}),
+		engineCache:    cache.NewEngineCacheWithDefaultConfig(),
+		eth1ChainID:    eth1ChainID,
+		metrics:        newClientMetrics(telemetrySink, logger),
+		endpoints:      endpoints,
+		active:         -1,
+		shadows:        newShadows[ExecutionPayloadT](o.shadows),
+		forkVersions:   o.forkVersions,
+		forkVersionsFn: o.forkVersionsFn,
 	}
 }
 
@@ -101,31 +142,65 @@ func (s *EngineClient[
 ]) Start(
 	ctx context.Context,
 ) error {
-	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
-		// If we are dialing with HTTP(S), start the JWT refresh loop.
-		defer func() {
-			if s.jwtSecret == nil {
-				s.logger.Warn(
-					"JWT secret not provided for http(s) connection" +
-						" - please verify your configuration settings",
-				)
-				return
-			}
-			go s.jwtRefreshLoop(ctx)
-		}()
+	for _, ep := range s.endpoints {
+		if ep.isHTTP() && ep.JWTSecret == nil {
+			s.logger.Warn(
+				"JWT secret not provided for http(s) connection"+
+					" - please verify your configuration settings",
+				"dial_url", ep.DialURL.String(),
+			)
+		}
 	}
 
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
+		"num_fallbacks", len(s.endpoints)-1,
 	)
 
-	// If the connection connection succeeds, we can skip the
-	// connection initialization loop.
-	if err := s.initializeConnection(ctx); err == nil {
-		return nil
+	// If the connection succeeds, we can skip the connection
+	// initialization loop. An execution client that does not serve the
+	// forks of the chain will not, so we fail right away.
+	if err := s.initializeConnection(ctx); err != nil {
+		if errors.Is(err, ErrMissingCapabilities) {
+			return err
+		}
+		if err = s.waitForConnection(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Keep refreshing the JWT tokens of the HTTP(S) endpoints, probing the
+	// health of all endpoints and mirroring calls to the shadows.
+	go s.jwtRefreshLoop(ctx)
+	go s.healthCheckLoop(ctx)
+	for _, sh := range s.shadows {
+		go s.runShadow(ctx, sh)
 	}
+	return nil
+}
 
+// Status returns an error if the engine client has no usable endpoint.
+func (s *EngineClient[
+	_, _,
+]) Status() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.active < 0 {
+		return ErrNoAvailableEndpoint
+	}
+	if err := s.endpoints[s.active].err; err != nil &&
+		!errors.Is(err, ErrEndpointSyncing) {
+		return err
+	}
+	return nil
+}
+
+// waitForConnection blocks until an endpoint is available, retrying every
+// RPCStartupCheckInterval.
+func (s *EngineClient[
+	_, _,
+]) waitForConnection(ctx context.Context) error {
 	// Attempt to initialize the connection to the execution client.
 	ticker := time.NewTicker(s.cfg.RPCStartupCheckInterval)
 	defer ticker.Stop()
@@ -139,6 +214,9 @@ func (s *EngineClient[
 				"dial_url", s.cfg.RPCDialURL,
 			)
 			if err := s.initializeConnection(ctx); err != nil {
+				if errors.Is(err, ErrMissingCapabilities) {
+					return err
+				}
 				if errors.Is(err, ErrMismatchedEth1ChainID) {
 					s.logger.Error(err.Error())
 				}
@@ -153,31 +231,61 @@ func (s *EngineClient[
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// setupConnection dials the execution client and
-// ensures the chain ID is correct.
+// initializeConnection probes all endpoints and activates the most
+// preferred available one.
 func (s *EngineClient[
 	_, _,
 ]) initializeConnection(
 	ctx context.Context,
+) error {
+	errs := s.probeEndpoints(ctx)
+	s.mu.RLock()
+	next := s.selectEndpoint()
+	s.mu.RUnlock()
+	if next < 0 {
+		return errors.Join(append(errs, ErrNoAvailableEndpoint)...)
+	}
+	return s.activate(ctx, next)
+}
+
+// probe checks that the endpoint is reachable, authenticated, on the
+// expected chain and done syncing, dialing it first if needed.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) probe(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
 ) error {
 	var (
 		err     error
 		chainID *big.Int
 	)
 
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	s.mu.RLock()
+	client := ep.client
+	s.mu.RUnlock()
+
+	// Dial the execution client if it is not connected.
+	if client == nil {
+		if client, err = s.dialExecutionRPCClient(cctx, ep); err != nil {
+			return err
+		}
+		s.setClient(ep, client)
+	}
+
 	defer func() {
-		if err != nil {
-			s.Client.Close()
+		// Drop the connection, such that the next probe re-dials.
+		if err != nil && !errors.Is(err, ErrEndpointSyncing) {
+			s.setClient(ep, nil)
+			client.Close()
 		}
 	}()
 
-	// Dial the execution client.
-	if err = s.dialExecutionRPCClient(ctx); err != nil {
-		return err
-	}
-
-	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	// Check to make sure the chain ID is correct.
+	chainID, err = client.ChainID(cctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
@@ -196,35 +304,40 @@ func (s *EngineClient[
 		return err
 	}
 
-	// Log the chain ID.
-	s.logger.Info(
-		"Connected to execution client 🔌",
-		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Uint64(),
-		"required_chain_id",
-		s.eth1ChainID,
+	// Check that the engine API is served, accepts our credentials and
+	// supports the forks of the chain.
+	capabilities, err := client.ExchangeCapabilities(
+		cctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
+	if err != nil {
+		return err
+	}
+	if err = s.checkCapabilities(capabilities); err != nil {
+		return err
+	}
 
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
+	// Check that the execution client is in sync.
+	progress, err := client.SyncProgress(cctx)
+	if err != nil {
 		return err
 	}
-	return nil
+	if progress != nil {
+		err = ErrEndpointSyncing
+	}
+	return err
 }
 
 /* -------------------------------------------------------------------------- */
 /*                                   Dialing                                  */
 /* -------------------------------------------------------------------------- */
 
-// dialExecutionRPCClient dials the execution client's RPC endpoint.
+// dialExecutionRPCClient dials the given endpoint.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) dialExecutionRPCClient(
 	ctx context.Context,
-) error {
+	ep *endpoint[ExecutionPayloadT],
+) (*ethclient.Eth1Client[ExecutionPayloadT], error) {
 	var (
 		client *rpc.Client
 		err    error
@@ -232,40 +345,36 @@ func (s *EngineClient[
 
 	// Dial the execution client based on the URL scheme.
 	switch {
-	case s.cfg.RPCDialURL.IsHTTP(), s.cfg.RPCDialURL.IsHTTPS():
+	case ep.isHTTP():
 		// Build an http.Header with the JWT token attached.
-		if s.jwtSecret != nil {
+		if ep.JWTSecret != nil {
 			var header http.Header
-			if header, err = s.buildJWTHeader(); err != nil {
-				return err
+			if header, err = s.buildJWTHeader(ep.JWTSecret); err != nil {
+				return nil, err
 			}
 			if client, err = rpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), rpc.WithHeaders(header),
+				ctx, ep.DialURL.String(), rpc.WithHeaders(header),
 			); err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			if client, err = rpc.DialContext(
-				ctx, s.cfg.RPCDialURL.String()); err != nil {
-				return err
+				ctx, ep.DialURL.String()); err != nil {
+				return nil, err
 			}
 		}
-	case s.cfg.RPCDialURL.IsIPC():
+	case ep.DialURL.IsIPC():
 		if client, err = rpc.DialIPC(
-			ctx, s.cfg.RPCDialURL.Path); err != nil {
+			ctx, ep.DialURL.Path); err != nil {
 			s.logger.Error("failed to dial IPC", "err", err)
-			return err
+			return nil, err
 		}
 	default:
-		return errors.Newf(
+		return nil, errors.Newf(
 			"no known transport for URL scheme %q",
-			s.cfg.RPCDialURL.Scheme,
+			ep.DialURL.Scheme,
 		)
 	}
 
-	// Refresh the execution client with the new client.
-	s.Eth1Client, err = ethclient.NewFromRPCClient[ExecutionPayloadT](
-		client,
-	)
-	return err
+	return ethclient.NewFromRPCClient[ExecutionPayloadT](client)
 }
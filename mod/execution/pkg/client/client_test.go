@@ -0,0 +1,452 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+func TestEngineClientFailsOverToStandby(t *testing.T) {
+	primary := newFakeEL(t, false)
+	standby := newFakeEL(t, false)
+	ec, _ := startEngineClient(
+		t, primary, client.WithFallbacks(endpointsOf(t, standby)...),
+	)
+
+	first := forkchoiceState(0x01)
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(), first, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []common.ExecutionHash{first.HeadBlockHash},
+		primary.forkchoiceHeads())
+	require.Empty(t, standby.forkchoiceHeads())
+
+	// Take the primary down, the update is served by the standby after
+	// it caught up with the last forkchoice.
+	primary.Close()
+	second := forkchoiceState(0x02)
+	_, _, err = ec.ForkchoiceUpdated(
+		context.Background(), second, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]common.ExecutionHash{first.HeadBlockHash, second.HeadBlockHash},
+		standby.forkchoiceHeads(),
+	)
+	require.NoError(t, ec.Status())
+}
+
+func TestEngineClientReadsActiveEndpointDuringFailover(t *testing.T) {
+	primary := newFakeEL(t, false)
+	standby := newFakeEL(t, false)
+	ec, _ := startEngineClient(
+		t, primary, client.WithFallbacks(endpointsOf(t, standby)...),
+	)
+
+	// Keep reading through the engine client while it fails over.
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for ctx.Err() == nil {
+			//nolint:errcheck // the primary goes down meanwhile.
+			ec.BlockNumber(ctx)
+		}
+	}()
+
+	primary.Close()
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(), forkchoiceState(0x01), nil, version.Deneb,
+	)
+	cancel()
+	wg.Wait()
+	require.NoError(t, err)
+
+	number, err := ec.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+}
+
+func TestEngineClientSkipsSyncingEndpoint(t *testing.T) {
+	primary := newFakeEL(t, true)
+	standby := newFakeEL(t, false)
+	ec, _ := startEngineClient(
+		t, primary, client.WithFallbacks(endpointsOf(t, standby)...),
+	)
+
+	state := forkchoiceState(0x01)
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(), state, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Empty(t, primary.forkchoiceHeads())
+	require.Equal(t, []common.ExecutionHash{state.HeadBlockHash},
+		standby.forkchoiceHeads())
+}
+
+func TestEngineClientNoAvailableEndpoint(t *testing.T) {
+	primary := newFakeEL(t, false)
+	standby := newFakeEL(t, false)
+	ec, _ := startEngineClient(
+		t, primary, client.WithFallbacks(endpointsOf(t, standby)...),
+	)
+
+	primary.Close()
+	standby.Close()
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(), forkchoiceState(0x01), nil, version.Deneb,
+	)
+	require.Error(t, err)
+	require.Error(t, ec.Status())
+}
+
+func TestEngineClientReportsShadowDivergence(t *testing.T) {
+	primary := newFakeEL(t, false)
+	shadow := newFakeEL(t, false)
+	shadow.setStatus(engineprimitives.PayloadStatusSyncing)
+	ec, sink := startEngineClient(
+		t, primary, client.WithShadows(endpointsOf(t, shadow)...),
+	)
+
+	state := forkchoiceState(0x01)
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(), state, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+
+	// The shadow is sent the update, but its response is never used.
+	require.Eventually(t, func() bool {
+		return sink.count(
+			"beacon_kit.execution.client.shadow_divergence",
+			"method", "forkchoiceUpdated", "field", "status",
+		) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []common.ExecutionHash{state.HeadBlockHash},
+		shadow.forkchoiceHeads())
+	require.Equal(t, []common.ExecutionHash{state.HeadBlockHash},
+		primary.forkchoiceHeads())
+}
+
+// startEngineClient starts an engine client with the given execution client
+// as primary endpoint.
+func TestEngineClientDispatchesByForkVersion(t *testing.T) {
+	el := newFakeEL(t, false)
+	ec, _ := startEngineClient(
+		t, el, client.WithForkVersions(version.Deneb, version.Electra),
+	)
+
+	for _, forkVersion := range []uint32{version.Deneb, version.Electra} {
+		_, err := ec.NewPayload(
+			context.Background(), &payload{}, []common.ExecutionHash{},
+			&common.Root{}, nil, forkVersion,
+		)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{
+		"engine_newPayloadV3", "engine_newPayloadV4",
+	}, el.newPayloadCalls())
+}
+
+func TestEngineClientRequiresForkCapabilities(t *testing.T) {
+	el := newFakeEL(t, false)
+	el.setCapabilities(
+		"engine_newPayloadV3",
+		"engine_forkchoiceUpdatedV3",
+		"engine_getPayloadV3",
+	)
+	ec, _ := newEngineClient(
+		t, el, client.WithForkVersions(version.Deneb, version.Electra),
+	)
+
+	err := ec.Start(context.Background())
+	require.ErrorIs(t, err, client.ErrMissingCapabilities)
+	require.ErrorContains(t, err, "engine_newPayloadV4")
+	require.ErrorContains(t, err, "engine_getPayloadV4")
+}
+
+func TestEngineClientRequiresForkCapabilitiesOfHead(t *testing.T) {
+	el := newFakeEL(t, false)
+	el.setCapabilities(
+		"engine_newPayloadV3",
+		"engine_forkchoiceUpdatedV3",
+		"engine_getPayloadV3",
+	)
+	forkVersions := []uint32{version.Deneb, version.DenebPlus}
+	ec, _ := newEngineClient(
+		t, el, client.WithForkVersionsFunc(func() []uint32 {
+			return forkVersions
+		}),
+	)
+	require.NoError(t, ec.Start(context.Background()))
+
+	forkVersions = []uint32{version.DenebPlus, version.Electra}
+	ec, _ = newEngineClient(
+		t, el, client.WithForkVersionsFunc(func() []uint32 {
+			return forkVersions
+		}),
+	)
+	require.ErrorIs(
+		t, ec.Start(context.Background()), client.ErrMissingCapabilities,
+	)
+}
+
+func startEngineClient(
+	t *testing.T,
+	primary *fakeEL,
+	opts ...client.Option,
+) (*client.EngineClient[*payload, *attributes], *recordingSink) {
+	t.Helper()
+	ec, sink := newEngineClient(t, primary, opts...)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	require.NoError(t, ec.Start(ctx))
+	return ec, sink
+}
+
+func newEngineClient(
+	t *testing.T,
+	primary *fakeEL,
+	opts ...client.Option,
+) (*client.EngineClient[*payload, *attributes], *recordingSink) {
+	t.Helper()
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = primary.dialURL(t)
+	cfg.RPCHealthCheckInterval = time.Hour
+	sink := &recordingSink{counters: make(map[string]int)}
+	ec := client.New[*payload, *attributes](
+		&cfg,
+		noop.NewLogger[any](),
+		nil,
+		sink,
+		big.NewInt(testChainID),
+		opts...,
+	)
+	return ec, sink
+}
+
+// endpointsOf returns the endpoints of the given execution clients.
+func endpointsOf(t *testing.T, els ...*fakeEL) []client.Endpoint {
+	t.Helper()
+	endpoints := make([]client.Endpoint, len(els))
+	for i, el := range els {
+		endpoints[i] = client.Endpoint{DialURL: el.dialURL(t)}
+	}
+	return endpoints
+}
+
+func forkchoiceState(b byte) *engineprimitives.ForkchoiceStateV1 {
+	return &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      common.ExecutionHash{b},
+		SafeBlockHash:      common.ExecutionHash{b},
+		FinalizedBlockHash: common.ExecutionHash{b},
+	}
+}
+
+// fakeEL is an in-process execution client serving the subset of the
+// JSON-RPC API used by the engine client.
+type fakeEL struct {
+	*httptest.Server
+	syncing bool
+
+	mu           sync.Mutex
+	status       engineprimitives.PayloadStatusStr
+	heads        []common.ExecutionHash
+	capabilities []string
+	newPayloads  []string
+}
+
+func newFakeEL(t *testing.T, syncing bool) *fakeEL {
+	t.Helper()
+	el := &fakeEL{
+		syncing: syncing,
+		status:  engineprimitives.PayloadStatusValid,
+	}
+	el.Server = httptest.NewServer(http.HandlerFunc(el.serve))
+	t.Cleanup(el.Close)
+	return el
+}
+
+func (el *fakeEL) dialURL(t *testing.T) *url.ConnectionURL {
+	t.Helper()
+	dialURL, err := url.NewFromRaw(el.URL)
+	require.NoError(t, err)
+	return dialURL
+}
+
+func (el *fakeEL) setStatus(status engineprimitives.PayloadStatusStr) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.status = status
+}
+
+// setCapabilities restricts the capabilities of the execution client, which
+// otherwise supports every capability of the engine client.
+func (el *fakeEL) setCapabilities(capabilities ...string) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.capabilities = capabilities
+}
+
+func (el *fakeEL) newPayloadCalls() []string {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return append([]string{}, el.newPayloads...)
+}
+
+func (el *fakeEL) forkchoiceHeads() []common.ExecutionHash {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return append([]common.ExecutionHash{}, el.heads...)
+}
+
+func (el *fakeEL) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var result any
+	switch req.Method {
+	case "eth_chainId":
+		result = "0x138d7"
+	case "eth_blockNumber":
+		result = "0x1"
+	case "eth_syncing":
+		result = false
+		if el.syncing {
+			result = map[string]string{
+				"startingBlock": "0x0",
+				"currentBlock":  "0x1",
+				"highestBlock":  "0x2",
+			}
+		}
+	case "engine_exchangeCapabilities":
+		el.mu.Lock()
+		result = req.Params[0]
+		if el.capabilities != nil {
+			result = el.capabilities
+		}
+		el.mu.Unlock()
+	case "engine_newPayloadV3", "engine_newPayloadV4":
+		// Execution requests are only, and always, sent from Electra on.
+		if (req.Method == "engine_newPayloadV4") != (len(req.Params) == 4) {
+			http.Error(w, "invalid params", http.StatusBadRequest)
+			return
+		}
+		el.mu.Lock()
+		el.newPayloads = append(el.newPayloads, req.Method)
+		result = map[string]any{
+			"status":          el.status,
+			"latestValidHash": common.ExecutionHash{},
+		}
+		el.mu.Unlock()
+	case "engine_forkchoiceUpdatedV3":
+		var state engineprimitives.ForkchoiceStateV1
+		if err := json.Unmarshal(req.Params[0], &state); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		el.mu.Lock()
+		el.heads = append(el.heads, state.HeadBlockHash)
+		result = map[string]any{
+			"payloadStatus": map[string]any{
+				"status":          el.status,
+				"latestValidHash": state.HeadBlockHash,
+			},
+		}
+		el.mu.Unlock()
+	default:
+		http.Error(w, "unsupported method", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	//nolint:errcheck // the test fails on the client side.
+	json.NewEncoder(w).Encode(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+		"result":  result,
+	})
+}
+
+// payload is a stub execution payload.
+type payload struct{}
+
+func (*payload) Empty(uint32) *payload        { return &payload{} }
+func (*payload) Version() uint32              { return version.Deneb }
+func (p *payload) IsNil() bool                { return p == nil }
+func (*payload) MarshalJSON() ([]byte, error) { return []byte("{}"), nil }
+func (*payload) UnmarshalJSON([]byte) error   { return nil }
+
+// attributes is a stub set of payload attributes.
+type attributes struct{}
+
+func (a *attributes) IsNil() bool { return a == nil }
+func (*attributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+// recordingSink is a telemetry sink recording counters.
+type recordingSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (rs *recordingSink) IncrementCounter(key string, args ...string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.counters[strings.Join(append([]string{key}, args...), ",")]++
+}
+
+func (rs *recordingSink) MeasureSince(string, time.Time, ...string) {}
+
+func (rs *recordingSink) count(key string, args ...string) int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.counters[strings.Join(append([]string{key}, args...), ",")]
+}
@@ -32,6 +32,7 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -46,7 +47,12 @@ func DefaultConfig() Config {
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		RPCFallbackDialURLs:     []string{},
+		FallbackJWTSecretPaths:  []string{},
+		ShadowDialURLs:          []string{},
+		ShadowJWTSecretPaths:    []string{},
 	}
 }
 
@@ -65,6 +71,24 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the interval for probing the health of the
+	// execution client endpoints.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// RPCFallbackDialURLs are the urls of the execution client JSON-RPC
+	// endpoints to fail over to, in order of preference.
+	RPCFallbackDialURLs []string `mapstructure:"rpc-fallback-dial-urls"`
+	// FallbackJWTSecretPaths are the paths to the JWT secrets of the fallback
+	// endpoints. Endpoints without a path use the JWT secret at
+	// JWTSecretPath.
+	FallbackJWTSecretPaths []string `mapstructure:"fallback-jwt-secret-paths"`
+	// ShadowDialURLs are the urls of shadow execution client JSON-RPC
+	// endpoints, which are sent the newPayload and forkchoiceUpdated calls
+	// of the primary to compare their responses.
+	ShadowDialURLs []string `mapstructure:"shadow-dial-urls"`
+	// ShadowJWTSecretPaths are the paths to the JWT secrets of the shadow
+	// endpoints. Endpoints without a path use the JWT secret at
+	// JWTSecretPath.
+	ShadowJWTSecretPaths []string `mapstructure:"shadow-jwt-secret-paths"`
 }
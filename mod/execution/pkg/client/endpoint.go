@@ -0,0 +1,348 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+)
+
+// Endpoint is an execution client JSON-RPC endpoint, along with the JWT
+// secret used to authenticate against it.
+type Endpoint struct {
+	// DialURL is the url of the execution client JSON-RPC endpoint.
+	DialURL *url.ConnectionURL
+	// JWTSecret is the JWT secret of the endpoint.
+	JWTSecret *jwt.Secret
+}
+
+// endpoint tracks the connection to, and the health of, an Endpoint.
+type endpoint[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	Endpoint
+	// client is the client connected to the endpoint, nil if the endpoint
+	// is not connected.
+	client *ethclient.Eth1Client[ExecutionPayloadT]
+	// err is the outcome of the latest probe of the endpoint.
+	err error
+}
+
+// isHTTP returns true if the endpoint is dialed over HTTP(S).
+func (ep *endpoint[_]) isHTTP() bool {
+	return ep.DialURL.IsHTTP() || ep.DialURL.IsHTTPS()
+}
+
+// forkchoice is a forkchoice update sent to the execution client.
+type forkchoice struct {
+	state       *engineprimitives.ForkchoiceStateV1
+	forkVersion uint32
+}
+
+// healthCheckLoop periodically probes all endpoints and fails over if the
+// active endpoint becomes unavailable.
+func (s *EngineClient[
+	_, _,
+]) healthCheckLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.probeEndpoints(ctx)
+			s.mu.RLock()
+			active, next := s.active, s.selectEndpoint()
+			s.mu.RUnlock()
+			if next < 0 || next == active {
+				continue
+			}
+			if err := s.activate(ctx, next); err != nil {
+				s.logger.Error(
+					"Failed to switch execution client endpoint",
+					"dial_url", s.endpoints[next].DialURL.String(),
+					"err", err,
+				)
+			}
+		}
+	}
+}
+
+// probeEndpoints probes all endpoints, recording and returning the
+// errors of the unavailable ones.
+func (s *EngineClient[
+	_, _,
+]) probeEndpoints(ctx context.Context) []error {
+	errs := make([]error, len(s.endpoints))
+	var wg sync.WaitGroup
+	for i, ep := range s.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.probe(ctx, ep)
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, ep := range s.endpoints {
+		if errs[i] != nil && ep.err == nil {
+			s.logger.Warn(
+				"Execution client endpoint is unavailable",
+				"dial_url", ep.DialURL.String(),
+				"err", errs[i],
+			)
+		}
+		ep.err = errs[i]
+	}
+	return errs
+}
+
+// selectEndpoint returns the index of the endpoint to use, or -1 if no
+// endpoint is available. The active endpoint is kept while it is healthy,
+// otherwise the most preferred healthy endpoint is chosen. Endpoints that
+// are still syncing are only chosen if no endpoint is healthy.
+//
+// NOTE: must be called with s.mu held.
+func (s *EngineClient[
+	_, _,
+]) selectEndpoint() int {
+	if s.active >= 0 && s.endpoints[s.active].err == nil {
+		return s.active
+	}
+	for i, ep := range s.endpoints {
+		if ep.err == nil {
+			return i
+		}
+	}
+	if s.active >= 0 &&
+		errors.Is(s.endpoints[s.active].err, ErrEndpointSyncing) {
+		return s.active
+	}
+	for i, ep := range s.endpoints {
+		if errors.Is(ep.err, ErrEndpointSyncing) {
+			return i
+		}
+	}
+	return -1
+}
+
+// activate switches to the endpoint at the given index, replaying the last
+// forkchoice to it such that it catches up with the chain.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) activate(ctx context.Context, idx int) error {
+	s.switchMu.Lock()
+	defer s.switchMu.Unlock()
+
+	s.mu.Lock()
+	ep := s.endpoints[idx]
+	if ep.client == nil {
+		s.mu.Unlock()
+		return ErrEndpointNotConnected
+	}
+	prev := s.active
+	if prev == idx {
+		s.mu.Unlock()
+		return nil
+	}
+	s.active = idx
+	client, fc := ep.client, s.lastForkchoice
+	s.mu.Unlock()
+
+	if prev < 0 {
+		s.logger.Info(
+			"Connected to execution client 🔌",
+			"dial_url", ep.DialURL.String(),
+			"required_chain_id", s.eth1ChainID,
+		)
+	} else {
+		s.metrics.incrementFailoverCounter()
+		s.logger.Warn(
+			"Switched execution client endpoint 🔀",
+			"from", s.endpoints[prev].DialURL.String(),
+			"to", ep.DialURL.String(),
+		)
+	}
+
+	// Exchange capabilities with the execution client.
+	if _, err := s.exchangeCapabilities(ctx, client); err != nil {
+		s.logger.Error("failed to exchange capabilities", "err", err)
+		s.markUnavailable(ctx, ep, err)
+		return err
+	}
+
+	// Replay the last forkchoice, such that the endpoint syncs to the head
+	// of the chain.
+	if fc == nil {
+		return nil
+	}
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+	if _, err := client.ForkchoiceUpdated(
+		cctx, fc.state, nil, fc.forkVersion,
+	); err != nil {
+		s.logger.Error(
+			"Failed to replay forkchoice to execution client",
+			"dial_url", ep.DialURL.String(),
+			"head_block_hash", fc.state.HeadBlockHash,
+			"err", err,
+		)
+		s.markUnavailable(ctx, ep, err)
+		return s.handleRPCError(err)
+	}
+	return nil
+}
+
+// markUnavailable records the error of a request to the endpoint if it
+// indicates that the endpoint is unavailable.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) markUnavailable(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+	err error,
+) {
+	if !isEndpointFailure(ctx, err) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ep.err = err
+}
+
+// callWithFailover calls fn with the client of the active endpoint. If the
+// endpoint cannot be reached, it fails over to the next available endpoint
+// and calls fn again.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) callWithFailover(
+	ctx context.Context,
+	fn func(
+		context.Context, *ethclient.Eth1Client[ExecutionPayloadT],
+	) error,
+) error {
+	for range len(s.endpoints) {
+		s.mu.RLock()
+		idx := s.active
+		var client *ethclient.Eth1Client[ExecutionPayloadT]
+		if idx >= 0 {
+			client = s.endpoints[idx].client
+		}
+		s.mu.RUnlock()
+		if client == nil {
+			return ErrNoAvailableEndpoint
+		}
+
+		cctx, cancel := s.createContextWithTimeout(ctx)
+		err := fn(cctx, client)
+		cancel()
+		if err == nil || !isEndpointFailure(ctx, err) {
+			return err
+		}
+
+		if !s.failover(ctx, idx, err) {
+			return err
+		}
+	}
+	return ErrNoAvailableEndpoint
+}
+
+// failover marks the endpoint at the given index as unavailable and
+// switches to the next available endpoint, returning false if there is
+// none.
+func (s *EngineClient[
+	_, _,
+]) failover(ctx context.Context, failed int, cause error) bool {
+	s.mu.Lock()
+	if s.active != failed {
+		// Another caller already switched endpoints.
+		s.mu.Unlock()
+		return true
+	}
+	failedEndpoint := s.endpoints[failed]
+	failedEndpoint.err = cause
+	next := s.selectEndpoint()
+	s.mu.Unlock()
+
+	s.logger.Warn(
+		"Execution client endpoint is unavailable",
+		"dial_url", failedEndpoint.DialURL.String(),
+		"err", cause,
+	)
+	if next < 0 || next == failed {
+		return false
+	}
+	return s.activate(ctx, next) == nil
+}
+
+// activeClient returns the client of the active endpoint.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) activeClient() (*ethclient.Eth1Client[ExecutionPayloadT], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.active < 0 || s.endpoints[s.active].client == nil {
+		return nil, ErrNoAvailableEndpoint
+	}
+	return s.endpoints[s.active].client, nil
+}
+
+// setClient sets the client connected to the endpoint.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) setClient(
+	ep *endpoint[ExecutionPayloadT],
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ep.client = client
+}
+
+// isEndpointFailure returns true if the error indicates that the endpoint
+// could not serve the request, as opposed to the execution client
+// rejecting it.
+func isEndpointFailure(ctx context.Context, err error) bool {
+	var rpcErr jsonrpc.Error
+	switch {
+	case ctx.Err() != nil:
+		// The caller gave up on the request.
+		return false
+	case errors.As(err, &rpcErr),
+		errors.Is(err, ethclient.ErrInvalidVersion),
+		errors.Is(err, ethclient.ErrNilResponse):
+		return false
+	default:
+		return true
+	}
+}
@@ -22,12 +22,15 @@ package client
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
@@ -36,7 +39,8 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadVX method via JSON-RPC.
+// NewPayload calls the engine_newPayloadVX method of the given fork version
+// via JSON-RPC.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) NewPayload(
@@ -44,18 +48,40 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 ) (*common.ExecutionHash, error) {
 	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		startTime = time.Now()
+		result    *engineprimitives.PayloadStatusV1
 	)
 	defer s.metrics.measureNewPayloadDuration(startTime)
-	defer cancel()
 
 	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Eth1Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
-	)
+	err := s.callWithFailover(ctx, func(
+		cctx context.Context, client *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = client.NewPayload(
+			cctx, payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests, forkVersion,
+		)
+		return err
+	})
+	s.mirror(&shadowCall[ExecutionPayloadT]{
+		method: "newPayload",
+		send: func(
+			cctx context.Context,
+			client *ethclient.Eth1Client[ExecutionPayloadT],
+		) (*shadowResponse, error) {
+			status, sErr := client.NewPayload(
+				cctx, payload, versionedHashes, parentBeaconBlockRoot,
+				executionRequests, forkVersion,
+			)
+			return newPayloadStatusResponse(status), sErr
+		},
+		expected: newPayloadStatusResponse(result),
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
@@ -84,7 +110,7 @@ func (s *EngineClient[
 
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
 func (s *EngineClient[
-	_, PayloadAttributesT,
+	ExecutionPayloadT, PayloadAttributesT,
 ]) ForkchoiceUpdated(
 	ctx context.Context,
 	state *engineprimitives.ForkchoiceStateV1,
@@ -92,11 +118,10 @@ func (s *EngineClient[
 	forkVersion uint32,
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
 	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		startTime = time.Now()
+		result    *engineprimitives.ForkchoiceResponseV1
 	)
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
-	defer cancel()
 
 	// If the suggested fee recipient is not set, log a warning.
 	if !attrs.IsNil() &&
@@ -107,10 +132,26 @@ func (s *EngineClient[
 		)
 	}
 
-	result, err := s.Eth1Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
-	)
-
+	err := s.callWithFailover(ctx, func(
+		cctx context.Context, client *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = client.ForkchoiceUpdated(cctx, state, attrs, forkVersion)
+		return err
+	})
+	s.mirror(&shadowCall[ExecutionPayloadT]{
+		method: "forkchoiceUpdated",
+		send: func(
+			cctx context.Context,
+			client *ethclient.Eth1Client[ExecutionPayloadT],
+		) (*shadowResponse, error) {
+			resp, sErr := client.ForkchoiceUpdated(
+				cctx, state, attrs, forkVersion,
+			)
+			return newForkchoiceResponse(resp), sErr
+		},
+		expected: newForkchoiceResponse(result),
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementForkchoiceUpdateTimeout()
@@ -120,6 +161,12 @@ func (s *EngineClient[
 		return nil, nil, engineerrors.ErrNilForkchoiceResponse
 	}
 
+	// Remember the forkchoice, such that it can be replayed to another
+	// endpoint after failing over.
+	s.mu.Lock()
+	s.lastForkchoice = &forkchoice{state: state, forkVersion: forkVersion}
+	s.mu.Unlock()
+
 	latestValidHash, err := processPayloadStatusResult((&result.PayloadStatus))
 	if err != nil {
 		return nil, latestValidHash, err
@@ -141,14 +188,19 @@ func (s *EngineClient[
 	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+		startTime = time.Now()
+		result    engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
 	)
 	defer s.metrics.measureGetPayloadDuration(startTime)
-	defer cancel()
 
 	// Call and check for errors.
-	result, err := s.Eth1Client.GetPayload(cctx, payloadID, forkVersion)
+	err := s.callWithFailover(ctx, func(
+		cctx context.Context, client *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = client.GetPayload(cctx, payloadID, forkVersion)
+		return err
+	})
 	switch {
 	case err != nil:
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -165,14 +217,29 @@ func (s *EngineClient[
 	return result, nil
 }
 
-// ExchangeCapabilities calls the engine_exchangeCapabilities method via
-// JSON-RPC.
+// ExchangeCapabilities calls the engine_exchangeCapabilities method of the
+// active endpoint via JSON-RPC.
 func (s *EngineClient[
 	_, _,
 ]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Eth1Client.ExchangeCapabilities(
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return s.exchangeCapabilities(ctx, client)
+}
+
+// exchangeCapabilities exchanges capabilities with the given client of the
+// active endpoint, recording the capabilities it has.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) exchangeCapabilities(
+	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) ([]string, error) {
+	result, err := client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
@@ -180,14 +247,18 @@ func (s *EngineClient[
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	capabilities := make(map[string]struct{}, len(result))
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		capabilities[capability] = struct{}{}
 	}
+	s.mu.Lock()
+	s.capabilities = capabilities
+	s.mu.Unlock()
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if _, exists := capabilities[capability]; !exists {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
 				"unsupported_capability", capability,
@@ -197,3 +268,35 @@ func (s *EngineClient[
 
 	return result, nil
 }
+
+// checkCapabilities returns an error if the given capabilities of an
+// execution client lack an engine API method required by the fork versions
+// of the engine client.
+func (s *EngineClient[
+	_, _,
+]) checkCapabilities(capabilities []string) error {
+	forkVersions := s.forkVersions
+	if s.forkVersionsFn != nil {
+		forkVersions = append(slices.Clone(forkVersions), s.forkVersionsFn()...)
+	}
+	for _, forkVersion := range forkVersions {
+		required, err := ethclient.RequiredCapabilities(forkVersion)
+		if err != nil {
+			return errors.Wrapf(err, "fork version %d", forkVersion)
+		}
+		missing := make([]string, 0)
+		for _, method := range required {
+			if !slices.Contains(capabilities, method) {
+				missing = append(missing, method)
+			}
+		}
+		if len(missing) > 0 {
+			return errors.Wrapf(
+				ErrMissingCapabilities,
+				"fork version %d requires %s",
+				forkVersion, strings.Join(missing, ", "),
+			)
+		}
+	}
+	return nil
+}
@@ -54,6 +54,30 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrEndpointNotProbed indicates that an endpoint has not been probed
+	// yet.
+	ErrEndpointNotProbed = errors.New("endpoint not probed")
+
+	// ErrEndpointNotConnected indicates that an endpoint is not connected.
+	ErrEndpointNotConnected = errors.New("endpoint not connected")
+
+	// ErrEndpointSyncing indicates that the execution client behind an
+	// endpoint is syncing.
+	ErrEndpointSyncing = errors.New("execution client is syncing")
+
+	// ErrMissingCapabilities indicates that the execution client does not
+	// advertise the engine API methods required by a fork of the chain.
+	ErrMissingCapabilities = errors.New(
+		"execution client does not support the engine API methods " +
+			"required by the chain",
+	)
+
+	// ErrNoAvailableEndpoint indicates that none of the execution client
+	// endpoints is available.
+	ErrNoAvailableEndpoint = errors.New(
+		"no execution client endpoint available",
+	)
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -28,6 +28,18 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
+// BlockNumber returns the number of the latest block of the execution
+// client.
+func (s *EngineClient[
+	_, _,
+]) BlockNumber(ctx context.Context) (uint64, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return 0, err
+	}
+	return client.BlockNumber(ctx)
+}
+
 // HeaderByNumber retrieves the block header by its number.
 func (s *EngineClient[
 	_, _,
@@ -35,10 +47,15 @@ func (s *EngineClient[
 	ctx context.Context,
 	number *big.Int,
 ) (*gethprimitives.Header, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+
 	// Infer the latest height if the number is nil.
 	if number == nil {
-		latest, err := s.BlockNumber(ctx)
-		if err != nil {
+		var latest uint64
+		if latest, err = client.BlockNumber(ctx); err != nil {
 			return nil, err
 		}
 		number = new(big.Int).SetUint64(latest)
@@ -49,7 +66,7 @@ func (s *EngineClient[
 		return header, nil
 	}
 
-	header, err := s.Client.HeaderByNumber(ctx, number)
+	header, err := client.HeaderByNumber(ctx, number)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +89,11 @@ func (s *EngineClient[
 	if ok {
 		return header, nil
 	}
-	header, err := s.Client.HeaderByHash(
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	header, err = client.HeaderByHash(
 		ctx,
 		gethprimitives.ExecutionHash(hash),
 	)
@@ -82,3 +103,144 @@ func (s *EngineClient[
 	s.engineCache.AddHeader(header)
 	return header, nil
 }
+
+// CodeAt returns the code of the given account at the given block.
+func (s *EngineClient[
+	_, _,
+]) CodeAt(
+	ctx context.Context,
+	account gethprimitives.ExecutionAddress,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.CodeAt(ctx, account, blockNumber)
+}
+
+// CallContract executes a contract call at the given block.
+func (s *EngineClient[
+	_, _,
+]) CallContract(
+	ctx context.Context,
+	call gethprimitives.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.CallContract(ctx, call, blockNumber)
+}
+
+// PendingCodeAt returns the code of the given account in the pending
+// state.
+func (s *EngineClient[
+	_, _,
+]) PendingCodeAt(
+	ctx context.Context,
+	account gethprimitives.ExecutionAddress,
+) ([]byte, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.PendingCodeAt(ctx, account)
+}
+
+// PendingNonceAt returns the nonce of the given account in the pending
+// state.
+func (s *EngineClient[
+	_, _,
+]) PendingNonceAt(
+	ctx context.Context,
+	account gethprimitives.ExecutionAddress,
+) (uint64, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return 0, err
+	}
+	return client.PendingNonceAt(ctx, account)
+}
+
+// SuggestGasPrice returns the gas price suggested by the execution client.
+func (s *EngineClient[
+	_, _,
+]) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.SuggestGasPrice(ctx)
+}
+
+// SuggestGasTipCap returns the gas tip cap suggested by the execution
+// client.
+func (s *EngineClient[
+	_, _,
+]) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.SuggestGasTipCap(ctx)
+}
+
+// EstimateGas estimates the gas needed to execute the given call.
+func (s *EngineClient[
+	_, _,
+]) EstimateGas(
+	ctx context.Context,
+	call gethprimitives.CallMsg,
+) (uint64, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return 0, err
+	}
+	return client.EstimateGas(ctx, call)
+}
+
+// SendTransaction submits the given signed transaction.
+func (s *EngineClient[
+	_, _,
+]) SendTransaction(
+	ctx context.Context,
+	tx *gethprimitives.Transaction,
+) error {
+	client, err := s.activeClient()
+	if err != nil {
+		return err
+	}
+	return client.SendTransaction(ctx, tx)
+}
+
+// FilterLogs returns the logs matching the given query.
+func (s *EngineClient[
+	_, _,
+]) FilterLogs(
+	ctx context.Context,
+	query gethprimitives.FilterQuery,
+) ([]gethprimitives.Log, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.FilterLogs(ctx, query)
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the given query. The
+// subscription stays on the endpoint that is active when subscribing.
+func (s *EngineClient[
+	_, _,
+]) SubscribeFilterLogs(
+	ctx context.Context,
+	query gethprimitives.FilterQuery,
+	ch chan<- gethprimitives.Log,
+) (gethprimitives.Subscription, error) {
+	client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.SubscribeFilterLogs(ctx, query, ch)
+}
@@ -20,25 +20,54 @@
 
 package ethclient
 
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+
 // BeaconKitSupportedCapabilities returns the full list of capabilities
 // of the beacon kit client.
 func BeaconKitSupportedCapabilities() []string {
 	return []string{
 		NewPayloadMethodV3,
+		NewPayloadMethodV4,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
+		GetPayloadMethodV4,
 		GetClientVersionV1,
 	}
 }
 
+// RequiredCapabilities returns the engine API methods the execution client
+// must serve for the given fork version.
+func RequiredCapabilities(forkVersion uint32) ([]string, error) {
+	switch forkVersion {
+	case version.Deneb, version.DenebPlus:
+		return []string{
+			NewPayloadMethodV3,
+			ForkchoiceUpdatedMethodV3,
+			GetPayloadMethodV3,
+		}, nil
+	case version.Electra:
+		return []string{
+			NewPayloadMethodV4,
+			ForkchoiceUpdatedMethodV3,
+			GetPayloadMethodV4,
+		}, nil
+	default:
+		return nil, ErrInvalidVersion
+	}
+}
+
 // Constants for JSON-RPC method names.
 const (
 	// NewPayloadMethodV3 for creating a new payload in Deneb.
 	NewPayloadMethodV3 = "engine_newPayloadV3"
+	// NewPayloadMethodV4 for creating a new payload in Electra.
+	NewPayloadMethodV4 = "engine_newPayloadV4"
 	// ForkchoiceUpdatedMethodV3 for updating fork choice in Deneb.
 	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethodV3 for retrieving a payload in Deneb.
 	GetPayloadMethodV3 = "engine_getPayloadV3"
+	// GetPayloadMethodV4 for retrieving a payload in Electra.
+	GetPayloadMethodV4 = "engine_getPayloadV4"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -33,18 +34,26 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadV3 method via JSON-RPC.
+// NewPayload is a helper function to call the appropriate version of the
+// engine_newPayload method.
 func (s *Eth1Client[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 ) (*engineprimitives.PayloadStatusV1, error) {
-	switch payload.Version() {
+	switch forkVersion {
 	case version.Deneb, version.DenebPlus:
 		return s.NewPayloadV3(
 			ctx, payload, versionedHashes, parentBlockRoot,
 		)
+	case version.Electra:
+		return s.NewPayloadV4(
+			ctx, payload, versionedHashes, parentBlockRoot,
+			executionRequests,
+		)
 	default:
 		return nil, ErrInvalidVersion
 	}
@@ -67,6 +76,29 @@ func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV3(
 	return result, nil
 }
 
+// NewPayloadV4 calls the engine_newPayloadV4 method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+) (*engineprimitives.PayloadStatusV1, error) {
+	// The execution requests must be sent as a list, even when empty.
+	if executionRequests == nil {
+		executionRequests = make([]bytes.Bytes, 0)
+	}
+
+	result := &engineprimitives.PayloadStatusV1{}
+	if err := s.Client.Client().CallContext(
+		ctx, result, NewPayloadMethodV4, payload, versionedHashes,
+		(*common.ExecutionHash)(parentBlockRoot), executionRequests,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                              ForkchoiceUpdated                             */
 /* -------------------------------------------------------------------------- */
@@ -80,7 +112,7 @@ func (s *Eth1Client[ExecutionPayloadT]) ForkchoiceUpdated(
 	forkVersion uint32,
 ) (*engineprimitives.ForkchoiceResponseV1, error) {
 	switch forkVersion {
-	case version.Deneb, version.DenebPlus:
+	case version.Deneb, version.DenebPlus, version.Electra:
 		return s.ForkchoiceUpdatedV3(ctx, state, attrs)
 	default:
 		return nil, ErrInvalidVersion
@@ -133,6 +165,8 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayload(
 	switch forkVersion {
 	case version.Deneb, version.DenebPlus:
 		return s.GetPayloadV3(ctx, payloadID)
+	case version.Electra:
+		return s.GetPayloadV4(ctx, payloadID)
 	default:
 		return nil, ErrInvalidVersion
 	}
@@ -141,6 +175,24 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayload(
 // GetPayloadV3 calls the engine_getPayloadV3 method via JSON-RPC.
 func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.getPayload(ctx, GetPayloadMethodV3, payloadID, version.Deneb)
+}
+
+// GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV4(
+	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.getPayload(ctx, GetPayloadMethodV4, payloadID, version.Electra)
+}
+
+// getPayload is a helper function to call to any version of the getPayload
+// method.
+func (s *Eth1Client[ExecutionPayloadT]) getPayload(
+	ctx context.Context,
+	method string,
+	payloadID engineprimitives.PayloadID,
+	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	var t ExecutionPayloadT
 	result := &engineprimitives.ExecutionPayloadEnvelope[
@@ -149,11 +201,11 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
 		],
 	]{
-		ExecutionPayload: t.Empty(version.Deneb),
+		ExecutionPayload: t.Empty(forkVersion),
 	}
 
 	if err := s.Client.Client().CallContext(
-		ctx, result, GetPayloadMethodV3, payloadID,
+		ctx, result, method, payloadID,
 	); err != nil {
 		return nil, err
 	}
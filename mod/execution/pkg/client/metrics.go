@@ -99,6 +99,37 @@ func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
 }
 
+// incrementFailoverCounter increments the counter for switches between
+// execution client endpoints.
+func (cm *clientMetrics) incrementFailoverCounter() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.failover")
+}
+
+// incrementShadowDivergence increments the counter for responses of a
+// shadow execution client that differ from those of the primary.
+func (cm *clientMetrics) incrementShadowDivergence(method, field string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.shadow_divergence",
+		"method", method, "field", field,
+	)
+}
+
+// incrementShadowError increments the counter for calls a shadow execution
+// client failed to serve.
+func (cm *clientMetrics) incrementShadowError(method string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.shadow_error", "method", method,
+	)
+}
+
+// incrementShadowDropped increments the counter for calls dropped because a
+// shadow execution client is lagging behind.
+func (cm *clientMetrics) incrementShadowDropped(method string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.shadow_dropped", "method", method,
+	)
+}
+
 // incrementTimeoutCounter increments the timeout counter for
 // the given metric.
 func (cm *clientMetrics) incrementTimeoutCounter(metricName string) {
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+// Option is a functional option for the engine client.
+type Option func(*options)
+
+// options holds the optional configuration of an engine client.
+type options struct {
+	fallbacks    []Endpoint
+	shadows      []Endpoint
+	forkVersions []uint32
+	// forkVersionsFn returns further fork versions each time the
+	// capabilities of an execution client are checked.
+	forkVersionsFn func() []uint32
+}
+
+// WithFallbacks adds endpoints to fail over to, in order of preference,
+// whenever the endpoint in use becomes unavailable.
+func WithFallbacks(endpoints ...Endpoint) Option {
+	return func(o *options) {
+		o.fallbacks = append(o.fallbacks, endpoints...)
+	}
+}
+
+// WithShadows adds shadow endpoints, which are sent every newPayload and
+// forkchoiceUpdated call of the engine client such that their responses can
+// be compared with those of the endpoint in use. The responses of shadow
+// endpoints are never used.
+func WithShadows(endpoints ...Endpoint) Option {
+	return func(o *options) {
+		o.shadows = append(o.shadows, endpoints...)
+	}
+}
+
+// WithForkVersions requires the execution client to serve the engine API
+// methods of the given fork versions. Endpoints that do not advertise them
+// are deemed unavailable, and the engine client fails to start if none of
+// its endpoints does.
+func WithForkVersions(forkVersions ...uint32) Option {
+	return func(o *options) {
+		o.forkVersions = append(o.forkVersions, forkVersions...)
+	}
+}
+
+// WithForkVersionsFunc is like WithForkVersions, but the fork versions are
+// returned by fn each time the capabilities of an execution client are
+// checked, so they can follow the head of the chain.
+func WithForkVersionsFunc(fn func() []uint32) Option {
+	return func(o *options) {
+		o.forkVersionsFn = fn
+	}
+}
@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+// shadowQueueSize is the number of calls queued for a shadow execution
+// client before further calls are dropped.
+const shadowQueueSize = 64
+
+// shadow is an execution client that is sent the engine API calls of the
+// engine client, such that its responses can be compared with those of the
+// active endpoint.
+type shadow[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	*endpoint[ExecutionPayloadT]
+	// calls is the queue of calls to send to the shadow.
+	calls chan *shadowCall[ExecutionPayloadT]
+	// dialedAt is the time the shadow was last dialed.
+	dialedAt time.Time
+}
+
+// newShadows creates the shadows for the given endpoints.
+func newShadows[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+](endpoints []Endpoint) []*shadow[ExecutionPayloadT] {
+	shadows := make([]*shadow[ExecutionPayloadT], len(endpoints))
+	for i, ep := range endpoints {
+		shadows[i] = &shadow[ExecutionPayloadT]{
+			endpoint: &endpoint[ExecutionPayloadT]{Endpoint: ep},
+			calls:    make(chan *shadowCall[ExecutionPayloadT], shadowQueueSize),
+		}
+	}
+	return shadows
+}
+
+// shadowCall is an engine API call mirrored to the shadows.
+type shadowCall[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	// method is the name of the engine API method.
+	method string
+	// send sends the call to a shadow and returns its response.
+	send func(
+		context.Context, *ethclient.Eth1Client[ExecutionPayloadT],
+	) (*shadowResponse, error)
+	// expected is the response of the active endpoint, nil if the active
+	// endpoint did not respond.
+	expected *shadowResponse
+}
+
+// shadowResponse holds the fields of an engine API response that are
+// compared between the active endpoint and a shadow.
+type shadowResponse struct {
+	status          engineprimitives.PayloadStatusStr
+	latestValidHash *common.ExecutionHash
+	payloadID       *engineprimitives.PayloadID
+}
+
+// newPayloadStatusResponse returns the compared fields of a payload status.
+func newPayloadStatusResponse(
+	status *engineprimitives.PayloadStatusV1,
+) *shadowResponse {
+	if status == nil {
+		return nil
+	}
+	return &shadowResponse{
+		status:          status.Status,
+		latestValidHash: status.LatestValidHash,
+	}
+}
+
+// newForkchoiceResponse returns the compared fields of a forkchoice
+// response.
+func newForkchoiceResponse(
+	resp *engineprimitives.ForkchoiceResponseV1,
+) *shadowResponse {
+	if resp == nil {
+		return nil
+	}
+	return &shadowResponse{
+		status:          resp.PayloadStatus.Status,
+		latestValidHash: resp.PayloadStatus.LatestValidHash,
+		payloadID:       resp.PayloadID,
+	}
+}
+
+// mirror queues the call for all shadows without blocking, dropping it for
+// shadows that are lagging behind.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) mirror(call *shadowCall[ExecutionPayloadT]) {
+	for _, sh := range s.shadows {
+		select {
+		case sh.calls <- call:
+		default:
+			s.metrics.incrementShadowDropped(call.method)
+			s.logger.Warn(
+				"Shadow execution client is lagging, dropping call",
+				"dial_url", sh.DialURL.String(),
+				"method", call.method,
+			)
+		}
+	}
+}
+
+// runShadow sends the queued calls to the shadow and compares its responses
+// with those of the active endpoint.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) runShadow(ctx context.Context, sh *shadow[ExecutionPayloadT]) {
+	for {
+		select {
+		case <-ctx.Done():
+			if sh.client != nil {
+				sh.client.Close()
+			}
+			return
+		case call := <-sh.calls:
+			s.sendToShadow(ctx, sh, call)
+		}
+	}
+}
+
+// sendToShadow sends a call to the shadow, re-dialing it if it is not
+// connected or its JWT token is due for a refresh.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) sendToShadow(
+	ctx context.Context,
+	sh *shadow[ExecutionPayloadT],
+	call *shadowCall[ExecutionPayloadT],
+) {
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	if sh.client == nil ||
+		time.Since(sh.dialedAt) > s.cfg.RPCJWTRefreshInterval {
+		client, err := s.dialExecutionRPCClient(cctx, sh.endpoint)
+		if err != nil {
+			s.reportShadowError(sh, call, err)
+			return
+		}
+		if sh.client != nil {
+			sh.client.Close()
+		}
+		sh.client, sh.dialedAt = client, time.Now()
+	}
+
+	resp, err := call.send(cctx, sh.client)
+	if err != nil {
+		if isEndpointFailure(ctx, err) {
+			sh.client.Close()
+			sh.client = nil
+		}
+		s.reportShadowError(sh, call, err)
+		return
+	}
+	if call.expected == nil || resp == nil {
+		return
+	}
+
+	if resp.status != call.expected.status {
+		s.reportShadowDivergence(
+			sh, call, "status", call.expected.status, resp.status,
+		)
+	}
+	if !equalPtr(resp.latestValidHash, call.expected.latestValidHash) {
+		s.reportShadowDivergence(
+			sh, call, "latest_valid_hash",
+			call.expected.latestValidHash, resp.latestValidHash,
+		)
+	}
+	if !equalPtr(resp.payloadID, call.expected.payloadID) {
+		s.reportShadowDivergence(
+			sh, call, "payload_id",
+			call.expected.payloadID, resp.payloadID,
+		)
+	}
+}
+
+// reportShadowError reports a call that the shadow failed to serve.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) reportShadowError(
+	sh *shadow[ExecutionPayloadT],
+	call *shadowCall[ExecutionPayloadT],
+	err error,
+) {
+	s.metrics.incrementShadowError(call.method)
+	s.logger.Warn(
+		"Shadow execution client failed to serve call",
+		"dial_url", sh.DialURL.String(),
+		"method", call.method,
+		"err", err,
+	)
+}
+
+// reportShadowDivergence reports a field of a response that differs between
+// the active endpoint and the shadow.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) reportShadowDivergence(
+	sh *shadow[ExecutionPayloadT],
+	call *shadowCall[ExecutionPayloadT],
+	field string,
+	expected, actual any,
+) {
+	s.metrics.incrementShadowDivergence(call.method, field)
+	s.logger.Warn(
+		"Shadow execution client diverged from primary ⚠️",
+		"dial_url", sh.DialURL.String(),
+		"method", call.method,
+		"field", field,
+		"primary", expected,
+		"shadow", actual,
+	)
+}
+
+// equalPtr returns true if both pointers are nil or point to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "time"
+
+const (
+	// defaultBatchSize is the default number of execution blocks whose
+	// deposit logs are fetched in a single query.
+	defaultBatchSize = 1000
+	// defaultRetryInterval is the default interval at which the service
+	// catches up with the execution blocks it has not processed yet.
+	defaultRetryInterval = 20 * time.Second
+)
+
+// Config is the configuration for the deposit service.
+type Config struct {
+	// BatchSize is the number of execution blocks whose deposit logs are
+	// fetched in a single eth_getLogs query.
+	BatchSize uint64 `mapstructure:"batch-size"`
+	// RetryInterval is the interval at which the service catches up with
+	// the execution blocks it failed to process.
+	RetryInterval time.Duration `mapstructure:"retry-interval"`
+}
+
+// DefaultConfig returns the default configuration for the deposit service.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:     defaultBatchSize,
+		RetryInterval: defaultRetryInterval,
+	}
+}
@@ -70,24 +70,27 @@ func NewWrappedBeaconDepositContract[
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads the deposits of the [fromBlock, toBlock] range of
+// execution blocks from the deposit contract with a single log query.
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
+	fromBlock, toBlock math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   uint64(blkNum),
-			End:     (*uint64)(&blkNum),
+			Start:   uint64(fromBlock),
+			End:     (*uint64)(&toBlock),
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
+	//nolint:errcheck // the error of the iterator is checked below.
+	defer logs.Close()
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
@@ -102,5 +105,5 @@ func (dc *WrappedBeaconDepositContract[
 		))
 	}
 
-	return deposits, nil
+	return deposits, logs.Error()
 }
@@ -22,9 +22,12 @@ package deposit
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BuildPruneRangeFn builds a function that returns the range of deposits to
+// prune from the deposit store upon finalization of a beacon block. Every
+// deposit up to and including the last one in the block has been processed,
+// so the range is [0, lastIndex+1); the store skips what is already pruned.
 func BuildPruneRangeFn[
 	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
 	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
@@ -40,13 +43,6 @@ func BuildPruneRangeFn[
 		if len(deposits) == 0 || cs.MaxDepositsPerBlock() == 0 {
 			return 0, 0
 		}
-		index := deposits[len(deposits)-1].GetIndex()
-
-		end := min(index, math.U64(cs.MaxDepositsPerBlock()))
-		if index < math.U64(cs.MaxDepositsPerBlock()) {
-			return 0, end.Unwrap()
-		}
-
-		return uint64(index - math.U64(cs.MaxDepositsPerBlock())), end.Unwrap()
+		return 0, deposits[len(deposits)-1].GetIndex().Unwrap() + 1
 	}
 }
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testDeposit struct{ index math.U64 }
+	testPayload struct{}
+	testBody    struct{ deposits []*testDeposit }
+	testBlock   struct{ body *testBody }
+	testEvent   struct{ block *testBlock }
+)
+
+func (*testDeposit) New(
+	crypto.BLSPubkey, any, math.U64, crypto.BLSSignature, uint64,
+) *testDeposit {
+	return &testDeposit{}
+}
+
+func (d *testDeposit) GetIndex() math.U64 { return d.index }
+
+func (testPayload) GetNumber() math.U64 { return 0 }
+
+func (b *testBody) GetDeposits() []*testDeposit { return b.deposits }
+
+func (*testBody) GetExecutionPayload() *testPayload { return &testPayload{} }
+
+func (*testBlock) GetSlot() math.U64 { return 0 }
+
+func (b *testBlock) GetBody() *testBody { return b.body }
+
+func (*testEvent) Type() asynctypes.EventID {
+	return events.BeaconBlockFinalized
+}
+
+func (e *testEvent) Is(id asynctypes.EventID) bool { return id == e.Type() }
+
+func (e *testEvent) Data() *testBlock { return e.block }
+
+// FuzzPruneRangeFn drives the deposit prune range function with a sequence
+// of finalized blocks and applies the returned [start, end) ranges to a
+// model of the deposit store. Every deposit included in a finalized block
+// must end up pruned, and no deposit that has not yet been included may be.
+func FuzzPruneRangeFn(f *testing.F) {
+	f.Add(uint8(16), []byte{16, 16, 3, 0, 16})
+	f.Add(uint8(1), []byte{1, 0, 1, 1})
+	f.Add(uint8(0), []byte{0, 0})
+	f.Fuzz(func(t *testing.T, maxDeposits uint8, perBlock []byte) {
+		cs := chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			MaxDepositsPerBlock: uint64(maxDeposits),
+		})
+		rangeFn := deposit.BuildPruneRangeFn[
+			*testBody, *testBlock, *testEvent, *testDeposit, *testPayload, any,
+		](cs)
+
+		var (
+			nextIndex uint64
+			pruned    = make(map[uint64]struct{})
+		)
+		for _, n := range perBlock {
+			count := uint64(n) % (uint64(maxDeposits) + 1)
+			body := &testBody{}
+			for range count {
+				body.deposits = append(
+					body.deposits, &testDeposit{index: math.U64(nextIndex)},
+				)
+				nextIndex++
+			}
+
+			start, end := rangeFn(&testEvent{block: &testBlock{body: body}})
+			require.LessOrEqual(t, start, end)
+			for i := start; i < end; i++ {
+				pruned[i] = struct{}{}
+			}
+
+			for i := range nextIndex {
+				require.Contains(t, pruned, i, "included deposit %d", i)
+			}
+			for i := range pruned {
+				require.Less(t, i, nextIndex, "pending deposit %d", i)
+			}
+		}
+	})
+}
@@ -22,6 +22,7 @@ package deposit
 
 import (
 	"context"
+	"sync"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -50,9 +51,13 @@ type Service[
 	feed chan BlockEventT
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// failedBlocks is a map of blocks that failed to be processed to be
-	// retried.
-	failedBlocks map[math.U64]struct{}
+	// cfg is the configuration for the deposit service.
+	cfg Config
+	// mu serializes the syncs of the deposit store.
+	mu sync.Mutex
+	// targetBlock is the highest execution block whose deposits are final
+	// and may be enqueued.
+	targetBlock math.U64
 }
 
 // NewService creates a new instance of the Service struct.
@@ -68,6 +73,7 @@ func NewService[
 	WithdrawalCredentialsT any,
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 ](
+	cfg Config,
 	logger log.Logger[any],
 	eth1FollowDistance math.U64,
 	telemetrySink TelemetrySink,
@@ -89,7 +95,7 @@ func NewService[
 		metrics:            newMetrics(telemetrySink),
 		dc:                 dc,
 		ds:                 ds,
-		failedBlocks:       make(map[math.Slot]struct{}),
+		cfg:                cfg,
 	}
 }
 
@@ -21,17 +21,18 @@
 package deposit
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
-
-// depositFetcher processes a deposit event.
+// depositFetcher advances the target block of the deposit sync as beacon
+// blocks are finalized, and syncs the deposit store up to it.
 func (s *Service[
 	_, _, _, _, _, _,
 ]) depositFetcher(ctx context.Context) {
@@ -43,63 +44,154 @@ func (s *Service[
 			if msg.Is(events.BeaconBlockFinalized) {
 				blockNum := msg.Data().
 					GetBody().GetExecutionPayload().GetNumber()
-				s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+				if blockNum <= s.eth1FollowDistance {
+					continue
+				}
+				s.setTargetBlock(blockNum - s.eth1FollowDistance)
+				s.syncDeposits(ctx)
 			}
 		}
 	}
 }
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
+// depositCatchupFetcher retries syncing the deposit store for the blocks
+// that failed to be processed.
 func (s *Service[
 	_, _, _, _, _, _,
 ]) depositCatchupFetcher(ctx context.Context) {
-	ticker := time.NewTicker(defaultRetryInterval)
+	ticker := time.NewTicker(s.retryInterval())
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
-				continue
-			}
-			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				s.failedBlocks,
-			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
-			}
+			s.syncDeposits(ctx)
 		}
 	}
 }
 
+// setTargetBlock raises the target block of the deposit sync. The target
+// never moves backwards, as blocks behind the follow distance of a finalized
+// beacon block cannot be reorged.
 func (s *Service[
 	_, _, _, _, _, _,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+]) setTargetBlock(blockNum math.U64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.targetBlock = max(s.targetBlock, blockNum)
+}
+
+// syncDeposits enqueues the deposits of the execution blocks following the
+// last processed block up to the target block, in batched ranges. The last
+// processed block is persisted after every range, so that a failed range is
+// retried from the same block, including after a restart.
+func (s *Service[
+	_, _, _, _, _, _,
+]) syncDeposits(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lastBlock, err := s.ds.GetLastProcessedBlock()
 	if err != nil {
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
+		s.logger.Error("Failed to get last processed block", "error", err)
 		return
 	}
 
+	batchSize := math.U64(max(s.cfg.BatchSize, 1))
+	for fromBlock := math.U64(lastBlock) + 1; fromBlock <= s.targetBlock; {
+		if ctx.Err() != nil {
+			return
+		}
+
+		toBlock := min(fromBlock+batchSize-1, s.targetBlock)
+		if err = s.fetchAndStoreDeposits(ctx, fromBlock, toBlock); err != nil {
+			s.metrics.markFailedToGetBlockLogs(fromBlock)
+			s.logger.Warn(
+				"Failed to get deposits from blocks, retrying later...",
+				"from_block", fromBlock, "to_block", toBlock, "error", err,
+			)
+			return
+		}
+		fromBlock = toBlock + 1
+	}
+}
+
+// fetchAndStoreDeposits reads the deposits of the [fromBlock, toBlock] range
+// of execution blocks, enqueues the ones that are not in the store yet and
+// marks the range as processed.
+func (s *Service[
+	_, _, _, DepositT, _, _,
+]) fetchAndStoreDeposits(
+	ctx context.Context,
+	fromBlock, toBlock math.U64,
+) error {
+	deposits, err := s.dc.ReadDeposits(ctx, fromBlock, toBlock)
+	if err != nil {
+		return err
+	}
+
+	nextIndex, err := s.ds.GetNextDepositIndex()
+	if err != nil {
+		return err
+	}
+	deposits, err = newDeposits(deposits, nextIndex)
+	if err != nil {
+		return err
+	}
+
 	if len(deposits) > 0 {
 		s.logger.Info(
 			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+			"from_block", fromBlock, "to_block", toBlock,
+			"deposits", len(deposits),
 		)
+		if err = s.ds.EnqueueDeposits(deposits); err != nil {
+			return err
+		}
 	}
 
-	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+	return s.ds.SetLastProcessedBlock(toBlock.Unwrap())
+}
+
+// retryInterval returns the interval at which the deposit sync is retried.
+func (s *Service[
+	_, _, _, _, _, _,
+]) retryInterval() time.Duration {
+	if s.cfg.RetryInterval <= 0 {
+		return defaultRetryInterval
 	}
+	return s.cfg.RetryInterval
+}
+
+// newDeposits sorts the deposits by index, drops the ones below the next
+// index of the store and the duplicates, and ensures the remaining ones
+// follow the store without a gap. A next index of 0 means the store holds no
+// deposit yet, in which case the deposits may start at any index.
+func newDeposits[DepositT interface{ GetIndex() math.U64 }](
+	deposits []DepositT,
+	nextIndex uint64,
+) ([]DepositT, error) {
+	slices.SortStableFunc(deposits, func(a, b DepositT) int {
+		return cmp.Compare(a.GetIndex(), b.GetIndex())
+	})
 
-	delete(s.failedBlocks, blockNum)
+	filtered := make([]DepositT, 0, len(deposits))
+	for _, deposit := range deposits {
+		index := deposit.GetIndex().Unwrap()
+		switch {
+		case index < nextIndex:
+			continue
+		case nextIndex != 0 || len(filtered) != 0:
+			if index != nextIndex {
+				return nil, errors.Wrapf(
+					ErrDepositIndexGap,
+					"expected deposit index %d, got %d", nextIndex, index,
+				)
+			}
+		}
+		filtered = append(filtered, deposit)
+		nextIndex = index + 1
+	}
+	return filtered, nil
 }
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"testing"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	syncDeposit struct{ index math.U64 }
+	syncPayload struct{}
+	syncBody    struct{}
+	syncBlock   struct{}
+	syncEvent   struct{}
+)
+
+func (*syncDeposit) New(
+	crypto.BLSPubkey, any, math.U64, crypto.BLSSignature, uint64,
+) *syncDeposit {
+	return &syncDeposit{}
+}
+
+func (d *syncDeposit) GetIndex() math.U64 { return d.index }
+
+func (syncPayload) GetNumber() math.U64 { return 0 }
+
+func (*syncBody) GetDeposits() []*syncDeposit { return nil }
+
+func (*syncBody) GetExecutionPayload() *syncPayload { return &syncPayload{} }
+
+func (*syncBlock) GetSlot() math.U64 { return 0 }
+
+func (*syncBlock) GetBody() *syncBody { return &syncBody{} }
+
+func (*syncEvent) Type() asynctypes.EventID { return "" }
+
+func (*syncEvent) Is(asynctypes.EventID) bool { return false }
+
+func (*syncEvent) Data() *syncBlock { return &syncBlock{} }
+
+// fakeContract is a deposit contract backend serving the deposit logs of a
+// fixed set of execution blocks.
+type fakeContract struct {
+	logs map[math.U64][]uint64
+	// failFrom makes the queries of ranges including the block fail.
+	failFrom math.U64
+	queries  [][2]math.U64
+}
+
+func (c *fakeContract) ReadDeposits(
+	_ context.Context,
+	fromBlock, toBlock math.U64,
+) ([]*syncDeposit, error) {
+	c.queries = append(c.queries, [2]math.U64{fromBlock, toBlock})
+	if c.failFrom != 0 && c.failFrom >= fromBlock && c.failFrom <= toBlock {
+		return nil, errors.New("eth_getLogs failed")
+	}
+
+	deposits := make([]*syncDeposit, 0)
+	for blockNum := fromBlock; blockNum <= toBlock; blockNum++ {
+		for _, index := range c.logs[blockNum] {
+			deposits = append(deposits, &syncDeposit{index: math.U64(index)})
+		}
+	}
+	return deposits, nil
+}
+
+// fakeStore is an in-memory deposit store.
+type fakeStore struct {
+	deposits  map[uint64]*syncDeposit
+	nextIndex uint64
+	lastBlock uint64
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{deposits: make(map[uint64]*syncDeposit)}
+}
+
+func (*fakeStore) Prune(uint64, uint64) error { return nil }
+
+func (s *fakeStore) EnqueueDeposits(deposits []*syncDeposit) error {
+	for _, deposit := range deposits {
+		s.deposits[deposit.index.Unwrap()] = deposit
+		s.nextIndex = max(s.nextIndex, deposit.index.Unwrap()+1)
+	}
+	return nil
+}
+
+func (s *fakeStore) GetNextDepositIndex() (uint64, error) {
+	return s.nextIndex, nil
+}
+
+func (s *fakeStore) GetLastProcessedBlock() (uint64, error) {
+	return s.lastBlock, nil
+}
+
+func (s *fakeStore) SetLastProcessedBlock(blockNum uint64) error {
+	s.lastBlock = blockNum
+	return nil
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func newSyncService(
+	batchSize uint64,
+	dc Contract[*syncDeposit],
+	ds Store[*syncDeposit],
+) *Service[
+	*syncBlock, *syncBody, *syncEvent, *syncDeposit, *syncPayload, any,
+] {
+	return NewService[
+		*syncBody, *syncBlock, *syncEvent, Store[*syncDeposit], *syncPayload,
+		any, *syncDeposit,
+	](
+		Config{BatchSize: batchSize},
+		noop.NewLogger[any](),
+		0,
+		noopSink{},
+		ds,
+		dc,
+		nil,
+	)
+}
+
+func TestSyncDepositsBatchedRanges(t *testing.T) {
+	dc := &fakeContract{logs: map[math.U64][]uint64{
+		3:  {0, 1},
+		7:  {2},
+		10: {3},
+	}}
+	ds := newFakeStore()
+	s := newSyncService(4, dc, ds)
+
+	s.setTargetBlock(10)
+	s.syncDeposits(context.Background())
+	require.Equal(t, [][2]math.U64{{1, 4}, {5, 8}, {9, 10}}, dc.queries)
+	require.Len(t, ds.deposits, 4)
+	require.Equal(t, uint64(4), ds.nextIndex)
+	require.Equal(t, uint64(10), ds.lastBlock)
+
+	// The target never moves backwards, so nothing is queried again.
+	s.setTargetBlock(6)
+	s.syncDeposits(context.Background())
+	require.Len(t, dc.queries, 3)
+}
+
+func TestSyncDepositsRetryAfterFailure(t *testing.T) {
+	dc := &fakeContract{
+		logs:     map[math.U64][]uint64{2: {0}, 6: {1}},
+		failFrom: 6,
+	}
+	ds := newFakeStore()
+	s := newSyncService(4, dc, ds)
+
+	s.setTargetBlock(8)
+	s.syncDeposits(context.Background())
+	require.Equal(t, uint64(4), ds.lastBlock)
+	require.Len(t, ds.deposits, 1)
+
+	// A restarted service resumes from the persisted last processed block.
+	dc.failFrom = 0
+	dc.queries = nil
+	s = newSyncService(4, dc, ds)
+	s.setTargetBlock(8)
+	s.syncDeposits(context.Background())
+	require.Equal(t, [][2]math.U64{{5, 8}}, dc.queries)
+	require.Len(t, ds.deposits, 2)
+	require.Equal(t, uint64(8), ds.lastBlock)
+}
+
+func TestSyncDepositsGap(t *testing.T) {
+	dc := &fakeContract{logs: map[math.U64][]uint64{1: {0}, 2: {2}}}
+	ds := newFakeStore()
+	s := newSyncService(1, dc, ds)
+
+	s.setTargetBlock(2)
+	s.syncDeposits(context.Background())
+	require.Equal(t, uint64(1), ds.lastBlock)
+	require.Len(t, ds.deposits, 1)
+}
+
+func TestNewDeposits(t *testing.T) {
+	deposits := func(indexes ...uint64) []*syncDeposit {
+		ds := make([]*syncDeposit, 0, len(indexes))
+		for _, index := range indexes {
+			ds = append(ds, &syncDeposit{index: math.U64(index)})
+		}
+		return ds
+	}
+
+	tests := []struct {
+		name      string
+		deposits  []*syncDeposit
+		nextIndex uint64
+		expected  []*syncDeposit
+		err       error
+	}{
+		{
+			name:     "empty store starts anywhere",
+			deposits: deposits(5, 6),
+			expected: deposits(5, 6),
+		},
+		{
+			name:      "sorted and deduplicated",
+			deposits:  deposits(4, 3, 2, 3, 4),
+			nextIndex: 3,
+			expected:  deposits(3, 4),
+		},
+		{
+			name:      "already stored",
+			deposits:  deposits(0, 1),
+			nextIndex: 2,
+			expected:  deposits(),
+		},
+		{
+			name:      "gap after the store",
+			deposits:  deposits(3),
+			nextIndex: 2,
+			err:       ErrDepositIndexGap,
+		},
+		{
+			name:     "gap within the deposits",
+			deposits: deposits(0, 2),
+			err:      ErrDepositIndexGap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := newDeposits(tt.deposits, tt.nextIndex)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, filtered)
+		})
+	}
+}
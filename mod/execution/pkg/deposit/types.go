@@ -65,10 +65,11 @@ type ExecutionPayload interface {
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads the deposits of the [fromBlock, toBlock] range of
+	// execution blocks from the deposit contract.
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
+		fromBlock, toBlock math.U64,
 	) ([]DepositT, error)
 }
 
@@ -89,9 +90,18 @@ type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 // Store defines the interface for managing deposit operations.
 type Store[DepositT any] interface {
 	// Prune prunes the deposit store of [start, end)
-	Prune(index uint64, numPrune uint64) error
+	Prune(start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetNextDepositIndex returns the index following the highest deposit
+	// ever enqueued, or 0 if none has been enqueued.
+	GetNextDepositIndex() (uint64, error)
+	// GetLastProcessedBlock returns the last execution block whose deposits
+	// have been enqueued.
+	GetLastProcessedBlock() (uint64, error)
+	// SetLastProcessedBlock records the last execution block whose deposits
+	// have been enqueued.
+	SetLastProcessedBlock(blockNum uint64) error
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
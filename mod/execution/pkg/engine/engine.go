@@ -87,17 +87,12 @@ func New[
 	}
 }
 
-// Start spawns any goroutines required by the service.
+// Start starts the engine client, returning the error if it fails to
+// connect to the execution client.
 func (ee *Engine[_, _, _, _]) Start(
 	ctx context.Context,
 ) error {
-	go func() {
-		// TODO: handle better
-		if err := ee.ec.Start(ctx); err != nil {
-			panic(err)
-		}
-	}()
-	return nil
+	return ee.ec.Start(ctx)
 }
 
 // GetPayload returns the payload and blobs bundle for the given slot.
@@ -217,6 +212,8 @@ func (ee *Engine[
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		req.ExecutionRequests,
+		req.ForkVersion,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
@@ -0,0 +1,447 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+)
+
+// Payload statuses returned by the engine.
+const (
+	statusValid   = "VALID"
+	statusInvalid = "INVALID"
+	statusSyncing = "SYNCING"
+)
+
+// supportedCapabilities are the Engine API methods served by the engine.
+//
+//nolint:gochecknoglobals // read-only.
+var supportedCapabilities = []string{
+	"engine_newPayloadV3",
+	"engine_newPayloadV4",
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV3",
+	"engine_getPayloadV4",
+}
+
+// engineAPI serves the engine namespace.
+type engineAPI struct {
+	e *Engine
+}
+
+// ExchangeCapabilities serves engine_exchangeCapabilities.
+func (api *engineAPI) ExchangeCapabilities(
+	ctx context.Context,
+	_ []string,
+) ([]string, error) {
+	if _, err := api.e.nextFault(
+		ctx, "engine_exchangeCapabilities",
+	); err != nil {
+		return nil, err
+	}
+	return supportedCapabilities, nil
+}
+
+// NewPayloadV3 serves engine_newPayloadV3.
+func (api *engineAPI) NewPayloadV3(
+	ctx context.Context,
+	params gethprimitives.ExecutableData,
+	versionedHashes []gethprimitives.ExecutionHash,
+	beaconRoot *gethprimitives.ExecutionHash,
+) (*gethprimitives.PayloadStatusV1, error) {
+	return api.newPayload(
+		ctx, "engine_newPayloadV3", params, versionedHashes, beaconRoot,
+	)
+}
+
+// NewPayloadV4 serves engine_newPayloadV4. As the engine builds empty
+// blocks, the execution requests are only checked to be present.
+func (api *engineAPI) NewPayloadV4(
+	ctx context.Context,
+	params gethprimitives.ExecutableData,
+	versionedHashes []gethprimitives.ExecutionHash,
+	beaconRoot *gethprimitives.ExecutionHash,
+	executionRequests []bytes.Bytes,
+) (*gethprimitives.PayloadStatusV1, error) {
+	if executionRequests == nil {
+		return nil, errInvalidParams
+	}
+	return api.newPayload(
+		ctx, "engine_newPayloadV4", params, versionedHashes, beaconRoot,
+	)
+}
+
+// newPayload serves any version of engine_newPayload.
+func (api *engineAPI) newPayload(
+	ctx context.Context,
+	method string,
+	params gethprimitives.ExecutableData,
+	versionedHashes []gethprimitives.ExecutionHash,
+	beaconRoot *gethprimitives.ExecutionHash,
+) (*gethprimitives.PayloadStatusV1, error) {
+	fault, err := api.e.nextFault(ctx, method)
+	switch {
+	case err != nil:
+		return nil, err
+	case fault == FaultSyncing:
+		return &gethprimitives.PayloadStatusV1{Status: statusSyncing}, nil
+	case params.Withdrawals == nil || versionedHashes == nil ||
+		beaconRoot == nil ||
+		params.BlobGasUsed == nil || params.ExcessBlobGas == nil:
+		return nil, errInvalidParams
+	}
+
+	block, err := gethprimitives.ExecutableDataToBlock(
+		params, versionedHashes, beaconRoot,
+	)
+	if err != nil {
+		return invalidStatus(nil, err), nil
+	}
+
+	api.e.mu.Lock()
+	defer api.e.mu.Unlock()
+	parent, ok := api.e.blocks[block.ParentHash()]
+	switch {
+	case !ok:
+		return &gethprimitives.PayloadStatusV1{Status: statusSyncing}, nil
+	case fault == FaultInvalid:
+		return invalidStatus(parent, errInjectedFault), nil
+	case block.NumberU64() != parent.NumberU64()+1:
+		return invalidStatus(parent, errInvalidNumber), nil
+	case block.Time() <= parent.Time():
+		return invalidStatus(parent, errInvalidTimestamp), nil
+	}
+
+	api.e.blocks[block.Hash()] = block
+	hash := block.Hash()
+	return &gethprimitives.PayloadStatusV1{
+		Status:          statusValid,
+		LatestValidHash: &hash,
+	}, nil
+}
+
+// ForkchoiceUpdatedV3 serves engine_forkchoiceUpdatedV3.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	ctx context.Context,
+	state gethprimitives.ForkchoiceStateV1,
+	attrs *gethprimitives.PayloadAttributes,
+) (*gethprimitives.ForkChoiceResponse, error) {
+	fault, err := api.e.nextFault(ctx, "engine_forkchoiceUpdatedV3")
+	if err != nil {
+		return nil, err
+	}
+
+	api.e.mu.Lock()
+	defer api.e.mu.Unlock()
+	head, ok := api.e.blocks[state.HeadBlockHash]
+	switch {
+	case !ok, fault == FaultSyncing:
+		return &gethprimitives.ForkChoiceResponse{
+			PayloadStatus: gethprimitives.PayloadStatusV1{
+				Status: statusSyncing,
+			},
+		}, nil
+	case fault == FaultInvalid:
+		return &gethprimitives.ForkChoiceResponse{
+			PayloadStatus: *invalidStatus(
+				api.e.blocks[head.ParentHash()], errInjectedFault,
+			),
+		}, nil
+	}
+
+	for _, hash := range []gethprimitives.ExecutionHash{
+		state.SafeBlockHash, state.FinalizedBlockHash,
+	} {
+		if _, ok = api.e.blocks[hash]; hash != (gethprimitives.ExecutionHash{}) &&
+			!ok {
+			return nil, errInvalidForkchoiceState
+		}
+	}
+	api.e.setHead(head)
+	if state.SafeBlockHash != (gethprimitives.ExecutionHash{}) {
+		api.e.safe = state.SafeBlockHash
+	}
+	if state.FinalizedBlockHash != (gethprimitives.ExecutionHash{}) {
+		api.e.finalized = state.FinalizedBlockHash
+	}
+
+	hash := head.Hash()
+	resp := &gethprimitives.ForkChoiceResponse{
+		PayloadStatus: gethprimitives.PayloadStatusV1{
+			Status:          statusValid,
+			LatestValidHash: &hash,
+		},
+	}
+	if attrs == nil {
+		return resp, nil
+	}
+
+	if attrs.Withdrawals == nil || attrs.BeaconRoot == nil ||
+		attrs.Timestamp <= head.Time() {
+		return nil, errInvalidPayloadAttributes
+	}
+	id, err := payloadID(head.Hash(), attrs)
+	if err != nil {
+		return nil, err
+	}
+	api.e.payloads[id] = gethprimitives.BlockToExecutableData(
+		buildBlock(head, attrs), new(big.Int), nil,
+	)
+	resp.PayloadID = &id
+	return resp, nil
+}
+
+// GetPayloadV3 serves engine_getPayloadV3.
+func (api *engineAPI) GetPayloadV3(
+	ctx context.Context,
+	id gethprimitives.PayloadID,
+) (*gethprimitives.ExecutionPayloadEnvelope, error) {
+	return api.getPayload(ctx, "engine_getPayloadV3", id)
+}
+
+// GetPayloadV4 serves engine_getPayloadV4, adding the execution requests of
+// the payload, of which empty blocks have none, to the envelope.
+func (api *engineAPI) GetPayloadV4(
+	ctx context.Context,
+	id gethprimitives.PayloadID,
+) (map[string]json.RawMessage, error) {
+	envelope, err := api.getPayload(ctx, "engine_getPayloadV4", id)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	fields["executionRequests"] = json.RawMessage("[]")
+	return fields, nil
+}
+
+// getPayload serves any version of engine_getPayload.
+func (api *engineAPI) getPayload(
+	ctx context.Context,
+	method string,
+	id gethprimitives.PayloadID,
+) (*gethprimitives.ExecutionPayloadEnvelope, error) {
+	if _, err := api.e.nextFault(ctx, method); err != nil {
+		return nil, err
+	}
+
+	api.e.mu.Lock()
+	defer api.e.mu.Unlock()
+	envelope, ok := api.e.payloads[id]
+	if !ok {
+		return nil, errUnknownPayload
+	}
+	return envelope, nil
+}
+
+// buildBlock builds an empty block on top of the parent.
+func buildBlock(
+	parent *gethprimitives.Block,
+	attrs *gethprimitives.PayloadAttributes,
+) *gethprimitives.Block {
+	var (
+		blobGasUsed, excessBlobGas uint64
+		withdrawals                = gethprimitives.Withdrawals(
+			attrs.Withdrawals,
+		)
+		withdrawalsHash = gethprimitives.DeriveSha(
+			withdrawals, gethprimitives.NewStackTrie(nil),
+		)
+	)
+	return gethprimitives.NewBlockWithHeader(&gethprimitives.Header{
+		ParentHash:       parent.Hash(),
+		UncleHash:        gethprimitives.EmptyUncleHash,
+		Coinbase:         attrs.SuggestedFeeRecipient,
+		Root:             parent.Root(),
+		TxHash:           gethprimitives.EmptyTxsHash,
+		ReceiptHash:      gethprimitives.EmptyReceiptsHash,
+		Difficulty:       new(big.Int),
+		Number:           new(big.Int).Add(parent.Number(), big.NewInt(1)),
+		GasLimit:         parent.GasLimit(),
+		Time:             attrs.Timestamp,
+		BaseFee:          parent.BaseFee(),
+		MixDigest:        attrs.Random,
+		WithdrawalsHash:  &withdrawalsHash,
+		BlobGasUsed:      &blobGasUsed,
+		ExcessBlobGas:    &excessBlobGas,
+		ParentBeaconRoot: attrs.BeaconRoot,
+	}).WithBody(gethprimitives.Body{Withdrawals: withdrawals})
+}
+
+// payloadID derives the ID of the payload built on top of the parent with
+// the given attributes.
+func payloadID(
+	parent gethprimitives.ExecutionHash,
+	attrs *gethprimitives.PayloadAttributes,
+) (gethprimitives.PayloadID, error) {
+	var id gethprimitives.PayloadID
+	bz, err := json.Marshal(attrs)
+	if err != nil {
+		return id, err
+	}
+	hash := crypto.Keccak256Hash(parent[:], bz)
+	copy(id[:], hash[:len(id)])
+	return id, nil
+}
+
+// invalidStatus returns an INVALID payload status, with the latest valid
+// hash set to the given ancestor if any.
+func invalidStatus(
+	ancestor *gethprimitives.Block,
+	err error,
+) *gethprimitives.PayloadStatusV1 {
+	msg := err.Error()
+	status := &gethprimitives.PayloadStatusV1{
+		Status:          statusInvalid,
+		ValidationError: &msg,
+	}
+	if ancestor != nil {
+		hash := ancestor.Hash()
+		status.LatestValidHash = &hash
+	}
+	return status
+}
+
+// ethAPI serves the eth namespace.
+type ethAPI struct {
+	e *Engine
+}
+
+// ChainId serves eth_chainId.
+//
+//nolint:revive,stylecheck // must match the JSON-RPC method name.
+func (api *ethAPI) ChainId() string {
+	return toHex(api.e.chainID.Uint64())
+}
+
+// Syncing serves eth_syncing.
+func (api *ethAPI) Syncing(ctx context.Context) (any, error) {
+	fault, err := api.e.nextFault(ctx, "eth_syncing")
+	if err != nil || fault != FaultSyncing {
+		return false, err
+	}
+	head := api.e.Head().NumberU64()
+	return map[string]string{
+		"startingBlock": toHex(0),
+		"currentBlock":  toHex(head),
+		"highestBlock":  toHex(head + 1),
+	}, nil
+}
+
+// BlockNumber serves eth_blockNumber.
+func (api *ethAPI) BlockNumber() string {
+	return toHex(api.e.Head().NumberU64())
+}
+
+// GetBlockByNumber serves eth_getBlockByNumber.
+func (api *ethAPI) GetBlockByNumber(
+	ctx context.Context,
+	number rpc.BlockNumber,
+	fullTx bool,
+) (map[string]any, error) {
+	if _, err := api.e.nextFault(ctx, "eth_getBlockByNumber"); err != nil {
+		return nil, err
+	}
+	api.e.mu.Lock()
+	block := api.e.blockByNumber(number)
+	api.e.mu.Unlock()
+	return marshalBlock(block, fullTx)
+}
+
+// GetBlockByHash serves eth_getBlockByHash.
+func (api *ethAPI) GetBlockByHash(
+	ctx context.Context,
+	hash gethprimitives.ExecutionHash,
+	fullTx bool,
+) (map[string]any, error) {
+	if _, err := api.e.nextFault(ctx, "eth_getBlockByHash"); err != nil {
+		return nil, err
+	}
+	api.e.mu.Lock()
+	block := api.e.blocks[hash]
+	api.e.mu.Unlock()
+	return marshalBlock(block, fullTx)
+}
+
+// GetLogs serves eth_getLogs.
+func (api *ethAPI) GetLogs(
+	ctx context.Context,
+	query filterQuery,
+) ([]*gethprimitives.Log, error) {
+	if _, err := api.e.nextFault(ctx, "eth_getLogs"); err != nil {
+		return nil, err
+	}
+	api.e.mu.Lock()
+	defer api.e.mu.Unlock()
+	return api.e.filterLogs(&query)
+}
+
+// marshalBlock returns the JSON-RPC representation of the block, or nil if
+// there is no block.
+func marshalBlock(
+	block *gethprimitives.Block,
+	fullTx bool,
+) (map[string]any, error) {
+	if block == nil {
+		//nolint:nilnil // a missing block is not an error.
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]any)
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	txs := make([]any, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		txs[i] = tx.Hash()
+		if fullTx {
+			txs[i] = tx
+		}
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []gethprimitives.ExecutionHash{}
+	fields["withdrawals"] = block.Withdrawals()
+	fields["size"] = toHex(block.Size())
+	return fields, nil
+}
+
+// toHex returns the hex representation of the number.
+func toHex(n uint64) string {
+	return "0x" + new(big.Int).SetUint64(n).Text(16)
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package mockengine implements a deterministic, in-process execution client
+// serving the subset of the Engine and Eth JSON-RPC APIs used by the engine
+// client. It is meant for tests and local devnets, where running a real
+// execution client is impractical.
+package mockengine
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"sync"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+)
+
+// Fault is a response injected in place of the response of the engine.
+type Fault uint8
+
+const (
+	// FaultInvalid makes the call return an INVALID payload status.
+	FaultInvalid Fault = iota + 1
+	// FaultSyncing makes the call return a SYNCING payload status, or a
+	// sync progress for eth_syncing.
+	FaultSyncing
+	// FaultTimeout makes the call hang until the caller gives up.
+	FaultTimeout
+)
+
+// Engine is an in-process execution client. It keeps every block it is
+// sent or builds in memory and builds empty blocks on request, such that the
+// payloads it returns carry valid block hashes.
+//
+// Engine does not authenticate requests.
+type Engine struct {
+	// chainID is the chain ID of the execution chain.
+	chainID *big.Int
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// blocks are the known blocks by hash.
+	blocks map[gethprimitives.ExecutionHash]*gethprimitives.Block
+	// canonical are the hashes of the canonical blocks by number.
+	canonical []gethprimitives.ExecutionHash
+	// safe and finalized are the hashes of the safe and finalized blocks.
+	safe, finalized gethprimitives.ExecutionHash
+	// payloads are the built payloads by payload ID.
+	payloads map[gethprimitives.PayloadID]*gethprimitives.ExecutionPayloadEnvelope
+	// logs are the logs of the chain by block number.
+	logs map[uint64][]*gethprimitives.Log
+	// faults are the injected faults by JSON-RPC method.
+	faults map[string][]Fault
+}
+
+// New creates an engine whose chain starts at the given genesis block.
+func New(chainID *big.Int, genesis *gethprimitives.Block) *Engine {
+	return &Engine{
+		chainID: chainID,
+		blocks: map[gethprimitives.ExecutionHash]*gethprimitives.Block{
+			genesis.Hash(): genesis,
+		},
+		canonical: []gethprimitives.ExecutionHash{genesis.Hash()},
+		safe:      genesis.Hash(),
+		finalized: genesis.Hash(),
+		payloads: make(
+			map[gethprimitives.PayloadID]*gethprimitives.ExecutionPayloadEnvelope,
+		),
+		logs:   make(map[uint64][]*gethprimitives.Log),
+		faults: make(map[string][]Fault),
+	}
+}
+
+// NewFromGenesis creates an engine for the chain of the given genesis.
+func NewFromGenesis(genesis *gethprimitives.Genesis) *Engine {
+	return New(genesis.Config.ChainID, genesis.ToBlock())
+}
+
+// Handler returns an http.Handler serving the JSON-RPC APIs of the engine.
+func (e *Engine) Handler() (http.Handler, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("engine", &engineAPI{e: e}); err != nil {
+		return nil, err
+	}
+	if err := server.RegisterName("eth", &ethAPI{e: e}); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// InjectFault makes the next call of the given JSON-RPC method, e.g.
+// engine_newPayloadV3, respond with the given fault. Faults injected for the
+// same method apply to consecutive calls, in order.
+func (e *Engine) InjectFault(method string, fault Fault) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.faults[method] = append(e.faults[method], fault)
+}
+
+// Head returns the head of the canonical chain.
+func (e *Engine) Head() *gethprimitives.Block {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.head()
+}
+
+// nextFault pops the next fault injected for the method. It returns
+// ctx.Err() once the caller gives up if the fault is a timeout.
+func (e *Engine) nextFault(ctx context.Context, method string) (Fault, error) {
+	e.mu.Lock()
+	faults := e.faults[method]
+	if len(faults) == 0 {
+		e.mu.Unlock()
+		return 0, nil
+	}
+	fault := faults[0]
+	e.faults[method] = faults[1:]
+	e.mu.Unlock()
+
+	if fault == FaultTimeout {
+		<-ctx.Done()
+		return fault, ctx.Err()
+	}
+	return fault, nil
+}
+
+// head returns the head of the canonical chain.
+//
+// NOTE: must be called with e.mu held.
+func (e *Engine) head() *gethprimitives.Block {
+	return e.blocks[e.canonical[len(e.canonical)-1]]
+}
+
+// blockByNumber returns the canonical block at the given number, resolving
+// the special block numbers, or nil if there is none.
+//
+// NOTE: must be called with e.mu held.
+func (e *Engine) blockByNumber(number rpc.BlockNumber) *gethprimitives.Block {
+	switch number {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return e.head()
+	case rpc.SafeBlockNumber:
+		return e.blocks[e.safe]
+	case rpc.FinalizedBlockNumber:
+		return e.blocks[e.finalized]
+	default:
+		if number < 0 || int64(number) >= int64(len(e.canonical)) {
+			return nil
+		}
+		return e.blocks[e.canonical[number]]
+	}
+}
+
+// setHead makes the block with the given hash the head of the canonical
+// chain.
+//
+// NOTE: must be called with e.mu held.
+func (e *Engine) setHead(head *gethprimitives.Block) {
+	// Walk back from the head until the canonical chain is reached.
+	branch := []*gethprimitives.Block{head}
+	for block := head; block.NumberU64() > 0; {
+		parent := e.blocks[block.ParentHash()]
+		if e.isCanonical(parent) {
+			break
+		}
+		branch = append(branch, parent)
+		block = parent
+	}
+
+	fork := branch[len(branch)-1].NumberU64()
+	e.canonical = e.canonical[:fork]
+	for i := len(branch) - 1; i >= 0; i-- {
+		e.canonical = append(e.canonical, branch[i].Hash())
+	}
+}
+
+// isCanonical returns true if the block is part of the canonical chain.
+//
+// NOTE: must be called with e.mu held.
+func (e *Engine) isCanonical(block *gethprimitives.Block) bool {
+	number := block.NumberU64()
+	return number < uint64(len(e.canonical)) &&
+		e.canonical[number] == block.Hash()
+}
@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine_test
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/mockengine"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/ethclient"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineBuildsAndImportsPayloads(t *testing.T) {
+	engine, client := startEngine(t)
+	ctx := context.Background()
+	genesis := engine.Head()
+
+	beaconRoot := gethprimitives.ExecutionHash{0x0b}
+	attrs := &gethprimitives.PayloadAttributes{
+		Timestamp:             genesis.Time() + 2,
+		Random:                gethprimitives.ExecutionHash{0x0a},
+		SuggestedFeeRecipient: gethprimitives.ExecutionAddress{0x0f},
+		Withdrawals: []*gethprimitives.Withdrawal{
+			{
+				Index:     0,
+				Validator: 1,
+				Address:   gethprimitives.ExecutionAddress{0x01},
+				Amount:    10,
+			},
+		},
+		BeaconRoot: &beaconRoot,
+	}
+	fcResp := forkchoiceUpdated(t, client, genesis.Hash(), attrs)
+	require.Equal(t, "VALID", fcResp.PayloadStatus.Status)
+	require.NotNil(t, fcResp.PayloadID)
+
+	var envelope gethprimitives.ExecutionPayloadEnvelope
+	require.NoError(t, client.CallContext(
+		ctx, &envelope, "engine_getPayloadV3", fcResp.PayloadID,
+	))
+	payload := envelope.ExecutionPayload
+	block, err := gethprimitives.ExecutableDataToBlock(
+		*payload, []gethprimitives.ExecutionHash{}, &beaconRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, genesis.Hash(), block.ParentHash())
+	require.Equal(t, attrs.Random, block.MixDigest())
+	require.Equal(t, attrs.SuggestedFeeRecipient, block.Coinbase())
+	require.Equal(t, attrs.Withdrawals[0].Amount, block.Withdrawals()[0].Amount)
+	require.Equal(t, beaconRoot, *block.BeaconRoot())
+
+	status := newPayload(t, client, payload, &beaconRoot)
+	require.Equal(t, "VALID", status.Status)
+	require.Equal(t, payload.BlockHash, *status.LatestValidHash)
+
+	// The payload becomes the head once forkchoice is updated to it.
+	forkchoiceUpdated(t, client, payload.BlockHash, nil)
+	header, err := ethclient.NewClient(client).HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, header.Hash())
+}
+
+func TestEngineServesElectraMethods(t *testing.T) {
+	engine, client := startEngine(t)
+	beaconRoot := gethprimitives.ExecutionHash{0x0b}
+	head := engine.Head()
+	fcResp := forkchoiceUpdated(t, client, head.Hash(),
+		&gethprimitives.PayloadAttributes{
+			Timestamp:   head.Time() + 1,
+			Withdrawals: []*gethprimitives.Withdrawal{},
+			BeaconRoot:  &beaconRoot,
+		},
+	)
+
+	var envelope struct {
+		ExecutionPayload  *gethprimitives.ExecutableData `json:"executionPayload"`
+		ExecutionRequests []string                       `json:"executionRequests"`
+	}
+	require.NoError(t, client.CallContext(
+		context.Background(), &envelope, "engine_getPayloadV4",
+		fcResp.PayloadID,
+	))
+	require.NotNil(t, envelope.ExecutionRequests)
+	require.Empty(t, envelope.ExecutionRequests)
+
+	var status gethprimitives.PayloadStatusV1
+	require.NoError(t, client.CallContext(
+		context.Background(), &status, "engine_newPayloadV4",
+		envelope.ExecutionPayload, []gethprimitives.ExecutionHash{},
+		&beaconRoot, []string{},
+	))
+	require.Equal(t, "VALID", status.Status)
+}
+
+func TestEngineRejectsInvalidBlockHash(t *testing.T) {
+	engine, client := startEngine(t)
+	beaconRoot := gethprimitives.ExecutionHash{0x0b}
+	payload := buildPayload(t, engine, client, &beaconRoot)
+
+	payload.BlockHash = gethprimitives.ExecutionHash{0x01}
+	status := newPayload(t, client, payload, &beaconRoot)
+	require.Equal(t, "INVALID", status.Status)
+}
+
+func TestEngineInjectsFaults(t *testing.T) {
+	engine, client := startEngine(t)
+	beaconRoot := gethprimitives.ExecutionHash{0x0b}
+	payload := buildPayload(t, engine, client, &beaconRoot)
+
+	engine.InjectFault("engine_newPayloadV3", mockengine.FaultInvalid)
+	engine.InjectFault("engine_newPayloadV3", mockengine.FaultSyncing)
+	engine.InjectFault("engine_newPayloadV3", mockengine.FaultTimeout)
+
+	status := newPayload(t, client, payload, &beaconRoot)
+	require.Equal(t, "INVALID", status.Status)
+	require.Equal(t, engine.Head().Hash(), *status.LatestValidHash)
+	status = newPayload(t, client, payload, &beaconRoot)
+	require.Equal(t, "SYNCING", status.Status)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 100*time.Millisecond,
+	)
+	defer cancel()
+	err := client.CallContext(
+		ctx, new(gethprimitives.PayloadStatusV1), "engine_newPayloadV3",
+		payload, []gethprimitives.ExecutionHash{}, &beaconRoot,
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Faults apply once, in order.
+	status = newPayload(t, client, payload, &beaconRoot)
+	require.Equal(t, "VALID", status.Status)
+}
+
+func TestEngineServesDepositLogs(t *testing.T) {
+	engine, client := startEngine(t)
+	contract := gethprimitives.ExecutionAddress{0x42}
+	log, err := mockengine.DepositLog(
+		contract, 0, make([]byte, 48), make([]byte, 32), 32e9,
+		make([]byte, 96), 7,
+	)
+	require.NoError(t, err)
+	engine.AddLogs(log)
+
+	binding, err := deposit.NewBeaconDepositContract(
+		contract, ethclient.NewClient(client),
+	)
+	require.NoError(t, err)
+	end := uint64(0)
+	it, err := binding.FilterDeposit(&bind.FilterOpts{
+		Context: context.Background(), Start: 0, End: &end,
+	})
+	require.NoError(t, err)
+	require.True(t, it.Next())
+	require.Equal(t, uint64(32e9), it.Event.Amount)
+	require.Equal(t, uint64(7), it.Event.Index)
+	require.Equal(t, engine.Head().Hash(), it.Event.Raw.BlockHash)
+	require.False(t, it.Next())
+}
+
+// startEngine serves an engine over HTTP and dials it.
+func startEngine(t *testing.T) (*mockengine.Engine, *rpc.Client) {
+	t.Helper()
+	genesis := gethprimitives.NewBlockWithHeader(&gethprimitives.Header{
+		UncleHash:   gethprimitives.EmptyUncleHash,
+		TxHash:      gethprimitives.EmptyTxsHash,
+		ReceiptHash: gethprimitives.EmptyReceiptsHash,
+		Difficulty:  new(big.Int),
+		Number:      new(big.Int),
+		GasLimit:    30_000_000,
+		BaseFee:     big.NewInt(1e9),
+	})
+	engine := mockengine.New(big.NewInt(80087), genesis)
+	handler, err := engine.Handler()
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := rpc.DialContext(context.Background(), server.URL)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return engine, client
+}
+
+// buildPayload builds a payload on top of the head of the engine.
+func buildPayload(
+	t *testing.T,
+	engine *mockengine.Engine,
+	client *rpc.Client,
+	beaconRoot *gethprimitives.ExecutionHash,
+) *gethprimitives.ExecutableData {
+	t.Helper()
+	head := engine.Head()
+	fcResp := forkchoiceUpdated(t, client, head.Hash(),
+		&gethprimitives.PayloadAttributes{
+			Timestamp:   head.Time() + 1,
+			Withdrawals: []*gethprimitives.Withdrawal{},
+			BeaconRoot:  beaconRoot,
+		},
+	)
+	var envelope gethprimitives.ExecutionPayloadEnvelope
+	require.NoError(t, client.CallContext(
+		context.Background(), &envelope, "engine_getPayloadV3",
+		fcResp.PayloadID,
+	))
+	return envelope.ExecutionPayload
+}
+
+func forkchoiceUpdated(
+	t *testing.T,
+	client *rpc.Client,
+	head gethprimitives.ExecutionHash,
+	attrs *gethprimitives.PayloadAttributes,
+) *gethprimitives.ForkChoiceResponse {
+	t.Helper()
+	var resp gethprimitives.ForkChoiceResponse
+	require.NoError(t, client.CallContext(
+		context.Background(), &resp, "engine_forkchoiceUpdatedV3",
+		gethprimitives.ForkchoiceStateV1{HeadBlockHash: head}, attrs,
+	))
+	return &resp
+}
+
+func newPayload(
+	t *testing.T,
+	client *rpc.Client,
+	payload *gethprimitives.ExecutableData,
+	beaconRoot *gethprimitives.ExecutionHash,
+) *gethprimitives.PayloadStatusV1 {
+	t.Helper()
+	var status gethprimitives.PayloadStatusV1
+	require.NoError(t, client.CallContext(
+		context.Background(), &status, "engine_newPayloadV3",
+		payload, []gethprimitives.ExecutionHash{}, beaconRoot,
+	))
+	return &status
+}
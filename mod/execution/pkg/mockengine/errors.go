@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// errInjectedFault is the validation error of injected INVALID
+	// statuses.
+	errInjectedFault = errors.New("injected fault")
+	// errInvalidNumber is returned when a payload does not extend its
+	// parent by one block.
+	errInvalidNumber = errors.New("invalid block number")
+	// errInvalidTimestamp is returned when a payload is not newer than its
+	// parent.
+	errInvalidTimestamp = errors.New("invalid timestamp")
+
+	// errInvalidParams is the JSON-RPC error for invalid parameters.
+	errInvalidParams = &rpcError{
+		code: -32602, msg: "Invalid parameters",
+	}
+	// errUnknownPayload is the Engine API error for unknown payload IDs.
+	errUnknownPayload = &rpcError{
+		code: -38001, msg: "Unknown payload",
+	}
+	// errInvalidForkchoiceState is the Engine API error for forkchoice states
+	// referencing unknown blocks.
+	errInvalidForkchoiceState = &rpcError{
+		code: -38002, msg: "Invalid forkchoice state",
+	}
+	// errInvalidPayloadAttributes is the Engine API error for invalid payload
+	// attributes.
+	errInvalidPayloadAttributes = &rpcError{
+		code: -38003, msg: "Invalid payload attributes",
+	}
+)
+
+// rpcError is a JSON-RPC error with a code.
+type rpcError struct {
+	code int
+	msg  string
+}
+
+// Error implements error.
+func (e *rpcError) Error() string {
+	return e.msg
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *rpcError) ErrorCode() int {
+	return e.code
+}
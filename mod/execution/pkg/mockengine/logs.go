@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockengine
+
+import (
+	"encoding/json"
+	"slices"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+)
+
+// AddLogs adds logs to the chain, at the block numbers they are set to.
+func (e *Engine) AddLogs(logs ...*gethprimitives.Log) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, log := range logs {
+		e.logs[log.BlockNumber] = append(e.logs[log.BlockNumber], log)
+	}
+}
+
+// DepositLog returns the log emitted by the deposit contract at the given
+// address for a deposit in the given block.
+func DepositLog(
+	contract gethprimitives.ExecutionAddress,
+	blockNumber uint64,
+	pubkey, credentials []byte,
+	amount uint64,
+	signature []byte,
+	index uint64,
+) (*gethprimitives.Log, error) {
+	contractABI, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	event := contractABI.Events["Deposit"]
+	data, err := event.Inputs.NonIndexed().Pack(
+		pubkey, credentials, amount, signature, index,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &gethprimitives.Log{
+		Address:     contract,
+		Topics:      []gethprimitives.ExecutionHash{event.ID},
+		Data:        data,
+		BlockNumber: blockNumber,
+	}, nil
+}
+
+// filterQuery is the filter of an eth_getLogs request.
+type filterQuery struct {
+	BlockHash *gethprimitives.ExecutionHash     `json:"blockHash"`
+	FromBlock *rpc.BlockNumber                  `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber                  `json:"toBlock"`
+	Addresses []gethprimitives.ExecutionAddress `json:"-"`
+	Topics    [][]gethprimitives.ExecutionHash  `json:"-"`
+}
+
+// UnmarshalJSON unmarshals a filter, whose address and topics may be single
+// values or lists.
+func (q *filterQuery) UnmarshalJSON(bz []byte) error {
+	type query filterQuery
+	var raw struct {
+		query
+		Address json.RawMessage   `json:"address"`
+		Topics  []json.RawMessage `json:"topics"`
+	}
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+	*q = filterQuery(raw.query)
+
+	var err error
+	if q.Addresses, err = unmarshalOneOrMany[gethprimitives.ExecutionAddress](
+		raw.Address,
+	); err != nil {
+		return err
+	}
+	q.Topics = make([][]gethprimitives.ExecutionHash, len(raw.Topics))
+	for i, topic := range raw.Topics {
+		if q.Topics[i], err = unmarshalOneOrMany[gethprimitives.ExecutionHash](
+			topic,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalOneOrMany unmarshals null, a single value or a list of values.
+func unmarshalOneOrMany[T any](bz json.RawMessage) ([]T, error) {
+	if len(bz) == 0 || string(bz) == "null" {
+		return nil, nil
+	}
+	var many []T
+	if err := json.Unmarshal(bz, &many); err == nil {
+		return many, nil
+	}
+	var one T
+	if err := json.Unmarshal(bz, &one); err != nil {
+		return nil, err
+	}
+	return []T{one}, nil
+}
+
+// filterLogs returns the logs of the canonical chain matching the query.
+//
+// NOTE: must be called with e.mu held.
+func (e *Engine) filterLogs(q *filterQuery) ([]*gethprimitives.Log, error) {
+	var from, to *gethprimitives.Block
+	if q.BlockHash != nil {
+		from = e.blocks[*q.BlockHash]
+		to = from
+	} else {
+		from, to = e.head(), e.head()
+		if q.FromBlock != nil {
+			from = e.blockByNumber(*q.FromBlock)
+		}
+		if q.ToBlock != nil {
+			to = e.blockByNumber(*q.ToBlock)
+		}
+	}
+	if from == nil || to == nil {
+		return nil, errInvalidParams
+	}
+
+	logs := make([]*gethprimitives.Log, 0)
+	for number := from.NumberU64(); number <= to.NumberU64(); number++ {
+		hash := e.canonical[number]
+		for i, log := range e.logs[number] {
+			if !q.matches(log) {
+				continue
+			}
+			matched := *log
+			matched.BlockHash = hash
+			matched.Index = uint(i)
+			logs = append(logs, &matched)
+		}
+	}
+	return logs, nil
+}
+
+// matches returns true if the log matches the addresses and topics of the
+// query.
+func (q *filterQuery) matches(log *gethprimitives.Log) bool {
+	if len(q.Addresses) > 0 && !slices.Contains(q.Addresses, log.Address) {
+		return false
+	}
+	if len(q.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, topics := range q.Topics {
+		if len(topics) > 0 && !slices.Contains(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -21,6 +21,7 @@
 package gethprimitives
 
 import (
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -36,18 +37,28 @@ type (
 	ExecutionAddress = common.Address
 	// ExecutionHash represents a hash on the execution layer which is
 	// currently a Keccak256 hash.
-	ExecutionHash  = common.Hash
-	ExecutableData = engine.ExecutableData
-	Genesis        = core.Genesis
-	Block          = coretypes.Block
-	Body           = coretypes.Body
-	Log            = coretypes.Log
-	LogsBloom      = coretypes.Bloom
-	Header         = coretypes.Header
-	Receipt        = coretypes.Receipt
-	Transaction    = coretypes.Transaction
-	Transactions   = coretypes.Transactions
-	Withdrawals    = coretypes.Withdrawals
+	ExecutionHash            = common.Hash
+	ExecutableData           = engine.ExecutableData
+	ExecutionPayloadEnvelope = engine.ExecutionPayloadEnvelope
+	ForkchoiceStateV1        = engine.ForkchoiceStateV1
+	ForkChoiceResponse       = engine.ForkChoiceResponse
+	PayloadAttributes        = engine.PayloadAttributes
+	PayloadID                = engine.PayloadID
+	PayloadStatusV1          = engine.PayloadStatusV1
+	Genesis                  = core.Genesis
+	Block                    = coretypes.Block
+	Body                     = coretypes.Body
+	CallMsg                  = ethereum.CallMsg
+	FilterQuery              = ethereum.FilterQuery
+	Subscription             = ethereum.Subscription
+	Log                      = coretypes.Log
+	LogsBloom                = coretypes.Bloom
+	Header                   = coretypes.Header
+	Receipt                  = coretypes.Receipt
+	Transaction              = coretypes.Transaction
+	Transactions             = coretypes.Transactions
+	Withdrawal               = coretypes.Withdrawal
+	Withdrawals              = coretypes.Withdrawals
 )
 
 //nolint:gochecknoglobals // alias.
@@ -55,9 +66,12 @@ var (
 	HexToAddress           = common.HexToAddress
 	HexToHash              = common.HexToHash
 	BlockToExecutableData  = engine.BlockToExecutableData
+	ExecutableDataToBlock  = engine.ExecutableDataToBlock
 	NewBlockWithHeader     = coretypes.NewBlockWithHeader
 	DeriveSha              = coretypes.DeriveSha
 	EmptyUncleHash         = coretypes.EmptyUncleHash
+	EmptyTxsHash           = coretypes.EmptyTxsHash
+	EmptyReceiptsHash      = coretypes.EmptyReceiptsHash
 	NewStackTrie           = trie.NewStackTrie
 	SignTx                 = coretypes.SignTx
 	LatestSignerForChainID = coretypes.LatestSignerForChainID
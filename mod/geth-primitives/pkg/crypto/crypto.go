@@ -27,5 +27,6 @@ import (
 //nolint:gochecknoglobals // alias.
 var (
 	HexToECDSA      = crypto.HexToECDSA
+	Keccak256Hash   = crypto.Keccak256Hash
 	PubkeyToAddress = crypto.PubkeyToAddress
 )
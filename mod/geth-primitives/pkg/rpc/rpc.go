@@ -26,6 +26,15 @@ type (
 	BlockNumber = rpc.BlockNumber
 	Client      = rpc.Client
 	DataError   = rpc.DataError
+	Server      = rpc.Server
+)
+
+const (
+	SafeBlockNumber      = rpc.SafeBlockNumber
+	FinalizedBlockNumber = rpc.FinalizedBlockNumber
+	LatestBlockNumber    = rpc.LatestBlockNumber
+	PendingBlockNumber   = rpc.PendingBlockNumber
+	EarliestBlockNumber  = rpc.EarliestBlockNumber
 )
 
 //nolint:gochecknoglobals // its okay.
@@ -33,5 +42,6 @@ var (
 	DialOptions = rpc.DialOptions
 	DialContext = rpc.DialContext
 	DialIPC     = rpc.DialIPC
+	NewServer   = rpc.NewServer
 	WithHeaders = rpc.WithHeaders
 )
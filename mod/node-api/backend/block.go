@@ -22,6 +22,7 @@ package backend
 
 import (
 	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -56,16 +57,29 @@ func (b Backend[
 	return st.GetBlockRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
-// TODO: Implement this.
+// BlockRewardsAtSlot returns the rewards of the block at the given slot,
+// which are the value in Wei of its execution payload as recorded by this
+// node when it proposed the block.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) BlockRewardsAtSlot(math.Slot) (*types.BlockRewardsData, error) {
+]) BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	blockHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := b.sb.BlockStore().GetBlockValue(slot)
+	if err != nil {
+		return nil, err
+	} else if value == nil {
+		return nil, handlertypes.ErrNotFound
+	}
 	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
+		ProposerIndex: blockHeader.GetProposerIndex().Unwrap(),
+		Total:         value,
 	}, nil
 }
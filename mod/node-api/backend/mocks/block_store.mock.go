@@ -7,6 +7,8 @@ import (
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uint256 "github.com/holiman/uint256"
 )
 
 // BlockStore is an autogenerated mock type for the BlockStore type
@@ -22,6 +24,64 @@ func (_m *BlockStore[BeaconBlockT]) EXPECT() *BlockStore_Expecter[BeaconBlockT]
 	return &BlockStore_Expecter[BeaconBlockT]{mock: &_m.Mock}
 }
 
+// GetBlockValue provides a mock function with given fields: slot
+func (_m *BlockStore[BeaconBlockT]) GetBlockValue(slot math.U64) (*uint256.Int, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockValue")
+	}
+
+	var r0 *uint256.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (*uint256.Int, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) *uint256.Int); ok {
+		r0 = rf(slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uint256.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetBlockValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockValue'
+type BlockStore_GetBlockValue_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// GetBlockValue is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetBlockValue(slot interface{}) *BlockStore_GetBlockValue_Call[BeaconBlockT] {
+	return &BlockStore_GetBlockValue_Call[BeaconBlockT]{Call: _e.mock.On("GetBlockValue", slot)}
+}
+
+func (_c *BlockStore_GetBlockValue_Call[BeaconBlockT]) Run(run func(slot math.U64)) *BlockStore_GetBlockValue_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetBlockValue_Call[BeaconBlockT]) Return(_a0 *uint256.Int, _a1 error) *BlockStore_GetBlockValue_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetBlockValue_Call[BeaconBlockT]) RunAndReturn(run func(math.U64) (*uint256.Int, error)) *BlockStore_GetBlockValue_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSlotByExecutionNumber provides a mock function with given fields: executionNumber
 func (_m *BlockStore[BeaconBlockT]) GetSlotByExecutionNumber(executionNumber math.U64) (math.U64, error) {
 	ret := _m.Called(executionNumber)
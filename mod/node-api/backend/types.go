@@ -84,6 +84,9 @@ type BlockStore[BeaconBlockT any] interface {
 	// GetSlotByExecutionNumber retrieves the slot by a given execution number
 	// from the store.
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+	// GetBlockValue returns the value in Wei recorded for the block stored at
+	// the given slot, or nil if no value was recorded for that block.
+	GetBlockValue(slot math.Slot) (*math.U256, error)
 }
 
 // DepositStore defines the interface for deposit storage.
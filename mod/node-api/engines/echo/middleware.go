@@ -68,6 +68,11 @@ func responseFromError(data any, err error) (int, any) {
 			Code:    http.StatusNotImplemented,
 			Message: err.Error(),
 		}
+	case errors.Is(err, types.ErrUnavailable):
+		return http.StatusServiceUnavailable, ErrorResponse{
+			Code:    http.StatusServiceUnavailable,
+			Message: err.Error(),
+		}
 	default:
 		return http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
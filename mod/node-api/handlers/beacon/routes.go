@@ -148,6 +148,11 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Path:    "/eth/v1/beacon/rewards/sync_committee/:block_id",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/rewards/blocks/:block_id",
+			Handler: h.GetBlockRewards,
+		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/deposit_snapshot",
@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 type ValidatorResponse struct {
@@ -76,10 +77,6 @@ type CommitteeData struct {
 }
 
 type BlockRewardsData struct {
-	ProposerIndex     uint64 `json:"proposer_index,string"`
-	Total             uint64 `json:"total,string"`
-	Attestations      uint64 `json:"attestations,string"`
-	SyncAggregate     uint64 `json:"sync_aggregate,string"`
-	ProposerSlashings uint64 `json:"proposer_slashings,string"`
-	AttesterSlashings uint64 `json:"attester_slashings,string"`
+	ProposerIndex uint64     `json:"proposer_index,string"`
+	Total         *math.U256 `json:"total"`
 }
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// Backend is the interface for backend of the node API.
+type Backend[SyncStatusT SyncStatus] interface {
+	// SyncStatus returns the sync status of the node.
+	SyncStatus() SyncStatusT
+}
+
+// HealthReporter is the interface for reporting the health of the services
+// of the node.
+type HealthReporter interface {
+	// Health returns the health of each service, keyed by its name. A
+	// healthy service has a nil error.
+	Health() map[string]error
+}
+
+// SyncStatus is the interface for the sync status of the node.
+type SyncStatus interface {
+	// GetHeadSlot returns the slot of the head of the beacon chain.
+	GetHeadSlot() math.Slot
+	// GetSyncDistance returns the number of execution blocks the execution
+	// client lags behind.
+	GetSyncDistance() uint64
+	// IsSyncing returns true if the execution client is syncing.
+	IsSyncing() bool
+	// IsOptimistic returns true if the head of the beacon chain has not been
+	// validated by the execution client.
+	IsOptimistic() bool
+	// IsELOffline returns true if the execution client could not be reached.
+	IsELOffline() bool
+}
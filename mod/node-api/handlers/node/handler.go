@@ -25,15 +25,26 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
-type Handler[ContextT context.Context] struct {
+type Handler[ContextT context.Context, SyncStatusT SyncStatus] struct {
 	*handlers.BaseHandler[ContextT]
+	backend Backend[SyncStatusT]
+	health  HealthReporter
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+func NewHandler[ContextT context.Context, SyncStatusT SyncStatus](
+	backend Backend[SyncStatusT],
+) *Handler[ContextT, SyncStatusT] {
+	h := &Handler[ContextT, SyncStatusT]{
 		BaseHandler: handlers.NewBaseHandler[ContextT](
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend: backend,
 	}
 	return h
 }
+
+// AttachHealthReporter sets the reporter of the health of the services of
+// the node.
+func (h *Handler[_, _]) AttachHealthReporter(health HealthReporter) {
+	h.health = health
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+// GetHealth returns the health of the services of the node. It fails as
+// unavailable, listing the unhealthy services, unless all of them are
+// healthy.
+func (h *Handler[ContextT, _]) GetHealth(ContextT) (any, error) {
+	if h.health == nil {
+		return nil, errors.Wrap(types.ErrUnavailable, "node is starting")
+	}
+
+	var (
+		health    = h.health.Health()
+		services  = make([]nodetypes.ServiceHealth, 0, len(health))
+		unhealthy []string
+	)
+	for name, err := range health {
+		service := nodetypes.ServiceHealth{Name: name, Healthy: err == nil}
+		if err != nil {
+			service.Error = err.Error()
+			unhealthy = append(unhealthy, name+": "+service.Error)
+		}
+		services = append(services, service)
+	}
+
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		return nil, errors.Wrapf(
+			types.ErrUnavailable,
+			"unhealthy services: %s", strings.Join(unhealthy, "; "),
+		)
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+	return types.Wrap(nodetypes.HealthData{Services: services}), nil
+}
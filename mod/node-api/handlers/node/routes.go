@@ -27,7 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 )
 
-func (h *Handler[ContextT]) RegisterRoutes(
+func (h *Handler[ContextT, _]) RegisterRoutes(
 	logger log.Logger[any],
 ) {
 	h.SetLogger(logger)
@@ -60,12 +60,12 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/syncing",
-			Handler: h.NotImplemented,
+			Handler: h.GetSyncing,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/health",
-			Handler: h.NotImplemented,
+			Handler: h.GetHealth,
 		},
 	})
 }
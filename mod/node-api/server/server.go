@@ -22,13 +22,20 @@ package server
 
 import (
 	"context"
+	"net/http"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
+// shutdownTimeout is how long the API server waits for in-flight requests
+// when stopping.
+const shutdownTimeout = 5 * time.Second
+
 // Server is the API Server service.
 type Server[
 	ContextT apicontext.Context,
@@ -76,13 +83,16 @@ func (s *Server[_, _]) Start(ctx context.Context) error {
 }
 
 func (s *Server[_, _]) start(ctx context.Context) {
-	errCh := make(chan error)
+	errCh := make(chan error, 1)
 	go func() {
 		errCh <- s.engine.Run(s.config.Address)
 	}()
 	for {
 		select {
 		case err := <-errCh:
+			if errors.Is(err, http.ErrServerClosed) {
+				return
+			}
 			s.logger.Error(err.Error())
 		case <-ctx.Done():
 			return
@@ -90,6 +100,16 @@ func (s *Server[_, _]) start(ctx context.Context) {
 	}
 }
 
+// Stop gracefully shuts down the API server.
+func (s *Server[_, _]) Stop() error {
+	if !s.config.Enabled {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.engine.Shutdown(ctx)
+}
+
 // Name returns the name of the API server service.
 func (s *Server[_, _]) Name() string {
 	return "node-api-server"
@@ -27,7 +27,6 @@ import (
 
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -44,10 +43,10 @@ import (
 
 // WithCometParamStore sets the param store to the comet consensus engine.
 func WithCometParamStore(
-	chainSpec common.ChainSpec,
+	paramStore *comet.ConsensusParamsStore,
 ) func(bApp *baseapp.BaseApp) {
 	return func(bApp *baseapp.BaseApp) {
-		bApp.SetParamStore(comet.NewConsensusParamsStore(chainSpec))
+		bApp.SetParamStore(paramStore)
 	}
 }
 
@@ -69,6 +68,25 @@ func WithProcessProposal(
 	}
 }
 
+// WithExtendVote sets the extend vote handler to the baseapp.
+func WithExtendVote(
+	handler sdk.ExtendVoteHandler,
+) func(bApp *baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetExtendVoteHandler(handler)
+	}
+}
+
+// WithVerifyVoteExtension sets the verify vote extension handler to the
+// baseapp.
+func WithVerifyVoteExtension(
+	handler sdk.VerifyVoteExtensionHandler,
+) func(bApp *baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetVerifyVoteExtensionHandler(handler)
+	}
+}
+
 // WithPreBlocker sets the pre-blocker to the baseapp.
 func WithPreBlocker(
 	preBlocker sdk.PreBlocker,
@@ -29,7 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/p2p/pkg/gossip"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	dbm "github.com/cosmos/cosmos-db"
@@ -77,12 +77,19 @@ func (nb *NodeBuilder[NodeT]) Build(
 
 	// variables to hold the components needed to set up BeaconApp
 	var (
-		chainSpec       common.ChainSpec
 		appBuilder      *runtime.AppBuilder
+		paramStore      *components.ConsensusParamsStore
 		abciMiddleware  *components.ABCIMiddleware
 		serviceRegistry *service.Registry
 		consensusEngine *components.ConsensusEngine
 		apiBackend      *components.NodeAPIBackend
+		executionSync   *components.ExecutionSyncService
+		validatorSvc    *components.ValidatorService
+		nodeAPIHandler  *components.NodeAPIHandler
+		followHead      *components.DepositFollowHead
+		forkVersions    *components.EngineForkVersions
+		gossipReactor   *components.GossipReactor
+		cometStores     *components.CometStores
 	)
 
 	// build all node components using depinject
@@ -101,11 +108,18 @@ func (nb *NodeBuilder[NodeT]) Build(
 			),
 		),
 		&appBuilder,
-		&chainSpec,
+		&paramStore,
 		&abciMiddleware,
 		&serviceRegistry,
 		&consensusEngine,
 		&apiBackend,
+		&executionSync,
+		&validatorSvc,
+		&nodeAPIHandler,
+		&followHead,
+		&forkVersions,
+		&gossipReactor,
+		&cometStores,
 	); err != nil {
 		panic(err)
 	}
@@ -116,16 +130,25 @@ func (nb *NodeBuilder[NodeT]) Build(
 			db, traceStore, true, appBuilder,
 			append(
 				DefaultBaseappOptions(appOpts),
-				WithCometParamStore(chainSpec),
+				WithCometParamStore(paramStore),
 				WithPrepareProposal(consensusEngine.PrepareProposal),
 				WithProcessProposal(consensusEngine.ProcessProposal),
+				WithExtendVote(consensusEngine.ExtendVote),
+				WithVerifyVoteExtension(consensusEngine.VerifyVoteExtension),
 				WithPreBlocker(consensusEngine.PreBlock),
 			)...,
 		),
 	)
 	// TODO: so hood
 	apiBackend.AttachNode(nb.node)
+	executionSync.AttachNode(nb.node)
+	validatorSvc.AttachNode(nb.node)
+	followHead.AttachNode(nb.node)
+	forkVersions.AttachNode(nb.node)
+	nodeAPIHandler.AttachHealthReporter(serviceRegistry)
 	nb.node.SetServiceRegistry(serviceRegistry)
+	nb.node.RegisterReactor(gossip.ReactorName, gossipReactor)
+	nb.node.SetCometStores(cometStores)
 
 	// TODO: put this in some post node creation hook/listener.
 	if err := nb.node.Start(context.Background()); err != nil {
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// replayer replays journals through the blockchain, DA and block store
+// services of a node built over a copy of its application state.
+type replayer struct {
+	replayer *journal.Replayer
+	// sdkCtx holds the copy of the application state.
+	sdkCtx sdk.Context
+	// cancel stops the services of the replayer.
+	cancel context.CancelFunc
+}
+
+// Replay replays entries, reporting where the services diverge from the
+// recorded behaviour.
+func (r *replayer) Replay(
+	ctx context.Context, entries []journal.Entry,
+) (*journal.Report, error) {
+	return r.replayer.Replay(r.sdkCtx.WithContext(ctx), entries)
+}
+
+// Close stops the services of the replayer.
+func (r *replayer) Close() {
+	r.cancel()
+}
+
+// BuildReplayer builds the blockchain, DA and block store services of the
+// node over a copy-on-write view of the application state committed at the
+// given height, so that replaying a journal never modifies the state in db.
+// The services drive the execution client and write to the stores of the
+// home directory found in appOpts, which must therefore be a throwaway client
+// and a scratch home holding copies of the node's stores, as set up by the
+// replay command.
+// It is necessary to adhere to the types.ReplayerCreator interface.
+func (nb *NodeBuilder[NodeT]) BuildReplayer(
+	logger log.Logger,
+	db dbm.DB,
+	appOpts servertypes.AppOptions,
+	height int64,
+) (types.Replayer, error) {
+	var (
+		appBuilder        *runtime.AppBuilder
+		blockBroker       *components.BlockBroker
+		blockStoreService *components.BlockStoreService
+		chainService      *components.ChainService
+		daService         *components.DAService
+		engineClient      *components.EngineClient
+		jr                *journal.Replayer
+	)
+
+	if err := depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(
+				nb.components...,
+			),
+			depinject.Supply(
+				appOpts,
+				logger,
+			),
+			depinject.Invoke(
+				SetLoggerConfig,
+			),
+		),
+		&appBuilder,
+		&blockBroker,
+		&blockStoreService,
+		&chainService,
+		&daService,
+		&engineClient,
+		&jr,
+	); err != nil {
+		return nil, err
+	}
+
+	app := runtime.NewBeaconKitApp(
+		db, nil, false, appBuilder, DefaultBaseappOptions(appOpts)...,
+	)
+	if err := app.LoadHeight(height); err != nil {
+		return nil, err
+	}
+
+	// the block broker and the block store service are started so that
+	// the finalized blocks published by the chain service are consumed.
+	ctx, cancel := context.WithCancel(context.Background())
+	for _, svc := range []service.Basic{
+		engineClient, blockBroker, blockStoreService, chainService, daService,
+	} {
+		if err := svc.Start(ctx); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	return &replayer{
+		replayer: jr,
+		sdkCtx: sdk.NewContext(
+			app.CommitMultiStore().CacheMultiStore(), false, logger,
+		),
+		cancel: cancel,
+	}, nil
+}
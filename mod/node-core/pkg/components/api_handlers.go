@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	executionsync "github.com/berachain/beacon-kit/mod/beacon/execution_sync"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
@@ -83,8 +84,8 @@ func ProvideNodeAPIEventsHandler() *EventsAPIHandler {
 	return eventsapi.NewHandler[NodeAPIContext]()
 }
 
-func ProvideNodeAPINodeHandler() *NodeAPIHandler {
-	return nodeapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPINodeHandler(s *ExecutionSyncService) *NodeAPIHandler {
+	return nodeapi.NewHandler[NodeAPIContext, *executionsync.Status](s)
 }
 
 func ProvideNodeAPIProofHandler(b *NodeAPIBackend) *ProofAPIHandler {
@@ -22,18 +22,19 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
-	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 type AttributesFactoryInput struct {
 	depinject.In
 
-	ChainSpec common.ChainSpec
-	Config    *config.Config
-	Logger    log.Logger[any]
+	ChainSpec     common.ChainSpec
+	FeeRecipients *FeeRecipientServer
+	Logger        log.Logger[any]
+	Signer        crypto.BLSSigner
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -47,6 +48,7 @@ func ProvideAttributesFactory(
 	](
 		in.ChainSpec,
 		in.Logger,
-		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.FeeRecipients,
+		in.Signer.PublicKey(),
 	), nil
 }
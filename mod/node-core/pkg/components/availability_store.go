@@ -26,8 +26,10 @@ import (
 
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -72,10 +74,13 @@ func ProvideAvailibilityStore(
 // function for the depinject framework.
 type AvailabilityPrunerInput struct {
 	depinject.In
+	AppOpts           servertypes.AppOptions
 	AvailabilityStore *AvailabilityStore
 	BlockBroker       *BlockBroker
 	ChainSpec         common.ChainSpec
+	Config            *config.Config
 	Logger            log.AdvancedLogger[any, sdklog.Logger]
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideAvailabilityPruner provides a availability pruner for the depinject
@@ -89,12 +94,16 @@ func ProvideAvailabilityPruner(
 		return nil, errors.New("availability store does not have a range db")
 	}
 
-	subCh, err := in.BlockBroker.Subscribe()
+	subCh, err := subscribePruner(in.BlockBroker, manager.AvailabilityPrunerName)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
 	}
 
+	sizeFn := pruner.DirSizeFn(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data/blobs",
+	)
+
 	// build the availability pruner if IndexDB is available.
 	return pruner.NewPruner[
 		*BeaconBlock,
@@ -109,5 +118,10 @@ func ProvideAvailabilityPruner(
 			*BeaconBlock,
 			*BlockEvent,
 		](in.ChainSpec),
+		pruner.WithCompaction(
+			in.Config.Pruner.Interval, in.Config.Pruner.BatchSize,
+		),
+		pruner.WithRetention(in.Config.Pruner.Blobs, sizeFn),
+		pruner.WithTelemetrySink(in.TelemetrySink),
 	), nil
 }
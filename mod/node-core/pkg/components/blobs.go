@@ -96,10 +96,10 @@ func ProvideBlobProcessor(in BlobProcessorIn) *BlobProcessor {
 type DAServiceIn struct {
 	depinject.In
 
-	AvailabilityStore *AvailabilityStore
-	SidecarsBroker    *SidecarsBroker
-	BlobProcessor     *BlobProcessor
-	Logger            log.Logger
+	AvailabilityStore  *AvailabilityStore
+	SidecarsDispatcher *SidecarsDispatcher
+	BlobProcessor      *BlobProcessor
+	Logger             log.Logger
 }
 
 // ProvideDAService is a function that provides the BlobService to the
@@ -109,12 +109,12 @@ func ProvideDAService(in DAServiceIn) *DAService {
 		*AvailabilityStore,
 		*BeaconBlockBody,
 		*BlobSidecars,
-		*SidecarsBroker,
+		*SidecarsDispatcher,
 		*ExecutionPayload,
 	](
 		in.AvailabilityStore,
 		in.BlobProcessor,
-		in.SidecarsBroker,
+		in.SidecarsDispatcher,
 		in.Logger.With("service", "da"),
 	)
 }
@@ -26,6 +26,7 @@ import (
 	storev2 "cosmossdk.io/store/v2/db"
 	blockservice "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -60,22 +61,28 @@ func ProvideBlockStore(
 type BlockPrunerInput struct {
 	depinject.In
 
-	BlockBroker *BlockBroker
-	BlockStore  *BlockStore
-	Config      *config.Config
-	Logger      log.Logger
+	AppOpts       servertypes.AppOptions
+	BlockBroker   *BlockBroker
+	BlockStore    *BlockStore
+	Config        *config.Config
+	Logger        log.Logger
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideBlockPruner provides a block pruner for the depinject framework.
 func ProvideBlockPruner(
 	in BlockPrunerInput,
 ) (BlockPruner, error) {
-	subCh, err := in.BlockBroker.Subscribe()
+	subCh, err := subscribePruner(in.BlockBroker, manager.BlockPrunerName)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
 	}
 
+	sizeFn := pruner.DirSizeFn(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data/blocks.db",
+	)
+
 	return pruner.NewPruner[
 		*BeaconBlock,
 		*BlockEvent,
@@ -89,5 +96,10 @@ func ProvideBlockPruner(
 			*BeaconBlock,
 			*BlockEvent,
 		](in.Config.BlockStoreService),
+		pruner.WithCompaction(
+			in.Config.Pruner.Interval, in.Config.Pruner.BatchSize,
+		),
+		pruner.WithRetention(in.Config.Pruner.Blocks, sizeFn),
+		pruner.WithTelemetrySink(in.TelemetrySink),
 	), nil
 }
@@ -22,9 +22,14 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
 )
 
 // BlockServiceInput is the input for the block service.
@@ -46,3 +51,31 @@ func ProvideBlockStoreService(in BlockServiceInput) *BlockStoreService {
 		in.BlockStore,
 	)
 }
+
+// BlockBackfillServiceInput is the input for the block backfill service.
+type BlockBackfillServiceInput struct {
+	depinject.In
+
+	BlockStore    *BlockStore
+	ChainSpec     common.ChainSpec
+	CometStores   *CometStores
+	Config        *config.Config
+	Logger        log.AdvancedLogger[any, sdklog.Logger]
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideBlockBackfillService provides the block backfill service, which
+// reads historical blocks from the block store of CometBFT.
+func ProvideBlockBackfillService(
+	in BlockBackfillServiceInput,
+) *BlockBackfillService {
+	return blockstore.NewBackfillService[*BeaconBlock, *BlockStore](
+		in.Config.BlockStoreService,
+		in.Logger.With("service", "block-backfill"),
+		in.ChainSpec,
+		cometbft.NewBlockSource(in.CometStores),
+		in.BlockStore,
+		middleware.BeaconBlockTxIndex,
+		in.TelemetrySink,
+	)
+}
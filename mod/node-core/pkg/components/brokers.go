@@ -21,59 +21,50 @@
 package components
 
 import (
+	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 )
 
-// ProvideBlobBroker provides a blob feed for the depinject framework.
-func ProvideBlobBroker() *SidecarsBroker {
-	return broker.New[*SidecarEvent](
-		"blob-broker",
-	)
+// BrokerInput is the input for the broker providers for the depinject
+// framework.
+type BrokerInput struct {
+	depinject.In
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideBlockBroker provides a block feed for the depinject framework.
-func ProvideBlockBroker() *BlockBroker {
+func ProvideBlockBroker(in BrokerInput) *BlockBroker {
 	return broker.New[*BlockEvent](
 		"blk-broker",
-	)
-}
-
-// ProvideGenesisBroker provides a genesis feed for the depinject framework.
-func ProvideGenesisBroker() *GenesisBroker {
-	return broker.New[*GenesisEvent](
-		"genesis-broker",
-	)
-}
-
-// ProvideSlotBroker provides a slot feed for the depinject framework.
-func ProvideSlotBroker() *SlotBroker {
-	return broker.New[*SlotEvent](
-		"slot-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideStatusBroker provides a status feed.
-func ProvideStatusBroker() *StatusBroker {
+func ProvideStatusBroker(in BrokerInput) *StatusBroker {
 	return broker.New[*StatusEvent](
 		"status-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
-// ProvideValidatorUpdateBroker provides a validator updates feed.
-func ProvideValidatorUpdateBroker() *ValidatorUpdateBroker {
-	return broker.New[*ValidatorUpdateEvent](
-		"validator-updates-broker",
+// subscribePruner subscribes the pruner of the given name to the block
+// broker. Pruning ranges are cumulative, so a lagging pruner only needs the
+// latest block, and the oldest queued blocks are dropped.
+func subscribePruner(
+	blockBroker *BlockBroker, name string,
+) (chan *BlockEvent, error) {
+	return blockBroker.SubscribeWithOptions(
+		broker.WithPolicy(broker.PolicyDropOldest),
+		broker.WithSubscriberName(name),
 	)
 }
 
 // DefaultBrokerProviders returns a slice of the default broker providers.
 func DefaultBrokerProviders() []interface{} {
 	return []interface{}{
-		ProvideBlobBroker,
 		ProvideBlockBroker,
-		ProvideGenesisBroker,
-		ProvideSlotBroker,
 		ProvideStatusBroker,
-		ProvideValidatorUpdateBroker,
 	}
 }
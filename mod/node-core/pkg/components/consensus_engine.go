@@ -23,13 +23,16 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 // ConsensusEngineInput is the input for the consensus engine.
 type ConsensusEngineInput struct {
 	depinject.In
+	ChainSpec           common.ChainSpec
 	ConsensusMiddleware *ABCIMiddleware
 	StorageBackend      *StorageBackend
+	CometStores         *CometStores
 }
 
 // ProvideConsensusEngine is a depinject provider for the consensus engine.
@@ -42,9 +45,18 @@ func ProvideConsensusEngine(
 		*SlashingInfo,
 		*SlotData,
 		*StorageBackend,
+		*Validator,
 		*ValidatorUpdate,
 	](
+		in.ChainSpec,
 		in.ConsensusMiddleware,
 		in.StorageBackend,
+		in.CometStores,
 	), nil
 }
+
+// ProvideCometStores provides the stores of the CometBFT node, which are
+// attached once the node is created.
+func ProvideCometStores() *CometStores {
+	return cometbft.NewStores()
+}
@@ -24,6 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/migration"
 )
 
 // DBManagerInput is the input for the dep inject framework.
@@ -33,6 +34,7 @@ type DBManagerInput struct {
 	BlockPruner        BlockPruner
 	DepositPruner      DepositPruner
 	Logger             log.Logger
+	MigrationRegistry  *migration.Registry
 }
 
 // ProvideDBManager provides a DBManager for the depinject framework.
@@ -41,6 +43,7 @@ func ProvideDBManager(
 ) (*DBManager, error) {
 	return manager.NewDBManager(
 		in.Logger.With("service", "db-manager"),
+		in.MigrationRegistry,
 		in.DepositPruner,
 		in.AvailabilityPruner,
 		in.BlockPruner,
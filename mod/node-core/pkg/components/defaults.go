@@ -27,6 +27,8 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideAvailabilityPruner,
 		ProvideAvailibilityStore,
 		ProvideBeaconDepositContract,
+		ProvideBeaconMetadataDB,
+		ProvideBlockBackfillService,
 		ProvideBlockPruner,
 		ProvideBlockStore,
 		ProvideBlockStoreService,
@@ -36,17 +38,27 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideBlobVerifier,
 		ProvideChainService,
 		ProvideChainSpec,
+		ProvideCometStores,
 		ProvideConfig,
 		ProvideConsensusEngine,
 		ProvideDAService,
 		ProvideDBManager,
+		ProvideDepositFollowHead,
 		ProvideDepositPruner,
 		ProvideDepositService,
 		ProvideDepositStore,
 		ProvideEngineClient,
+		ProvideEngineForkVersions,
 		ProvideExecutionEngine,
+		ProvideExecutionSyncService,
+		ProvideFeeRecipientServer,
+		ProvideGossipReactor,
 		ProvideJWTSecret,
+		ProvideJournal,
+		ProvideJournalReplayer,
 		ProvideLocalBuilder,
+		ProvideMigrationRegistry,
+		ProvideRelayBuilder,
 		ProvideReportingService,
 		ProvideServiceRegistry,
 		ProvideSidecarFactory,
@@ -59,5 +71,6 @@ func DefaultComponentsWithStandardTypes() []any {
 	components = append(components, DefaultNodeAPIComponents()...)
 	components = append(components, DefaultNodeAPIHandlers()...)
 	components = append(components, DefaultBrokerProviders()...)
+	components = append(components, DefaultDispatcherProviders()...)
 	return components
 }
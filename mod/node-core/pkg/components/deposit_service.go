@@ -25,6 +25,7 @@ import (
 
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
@@ -38,6 +39,7 @@ type DepositServiceIn struct {
 	BeaconDepositContract *DepositContract
 	BlockBroker           *BlockBroker
 	ChainSpec             common.ChainSpec
+	Config                *config.Config
 	DepositStore          *DepositStore
 	EngineClient          *EngineClient
 	Logger                log.AdvancedLogger[any, sdklog.Logger]
@@ -61,6 +63,7 @@ func ProvideDepositService(in DepositServiceIn) (*DepositService, error) {
 		*DepositStore,
 		*ExecutionPayload,
 	](
+		in.Config.DepositSync,
 		in.Logger.With("service", "deposit"),
 		math.U64(in.ChainSpec.Eth1FollowDistance()),
 		in.TelemetrySink,
@@ -24,7 +24,9 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
@@ -59,22 +61,27 @@ func ProvideDepositStore(
 // DepositPrunerInput is the input for the deposit pruner.
 type DepositPrunerInput struct {
 	depinject.In
-	BlockBroker  *BlockBroker
-	ChainSpec    common.ChainSpec
-	DepositStore *DepositStore
-	Logger       log.Logger
+	AppOpts       servertypes.AppOptions
+	BlockBroker   *BlockBroker
+	ChainSpec     common.ChainSpec
+	Config        *config.Config
+	DepositStore  *DepositStore
+	Logger        log.Logger
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideDepositPruner provides a deposit pruner for the depinject framework.
 func ProvideDepositPruner(
 	in DepositPrunerInput,
 ) (DepositPruner, error) {
-	subCh, err := in.BlockBroker.Subscribe()
+	subCh, err := subscribePruner(in.BlockBroker, manager.DepositPrunerName)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
 	}
 
+	// Deposits are indexed by deposit index rather than by slot, so the
+	// slot-based retention policies do not apply to this store.
 	return pruner.NewPruner[
 		*BeaconBlock,
 		*BlockEvent,
@@ -92,5 +99,9 @@ func ProvideDepositPruner(
 			*ExecutionPayload,
 			WithdrawalCredentials,
 		](in.ChainSpec),
+		pruner.WithCompaction(
+			in.Config.Pruner.Interval, in.Config.Pruner.BatchSize,
+		),
+		pruner.WithTelemetrySink(in.TelemetrySink),
 	), nil
 }
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+const (
+	// blockDispatcherName is the name of the block request dispatcher.
+	blockDispatcherName = "blk-dispatcher"
+	// genesisDispatcherName is the name of the genesis request dispatcher.
+	genesisDispatcherName = "genesis-dispatcher"
+	// sidecarsDispatcherName is the name of the sidecars request dispatcher.
+	sidecarsDispatcherName = "sidecars-dispatcher"
+	// slotDispatcherName is the name of the slot request dispatcher.
+	slotDispatcherName = "slot-dispatcher"
+)
+
+// ProvideBlockDispatcher provides a block request dispatcher for the
+// depinject framework.
+func ProvideBlockDispatcher() *BlockDispatcher {
+	return dispatcher.New[*BeaconBlock, transition.ValidatorUpdates](
+		blockDispatcherName,
+	)
+}
+
+// ProvideGenesisDispatcher provides a genesis request dispatcher for the
+// depinject framework.
+func ProvideGenesisDispatcher() *GenesisDispatcher {
+	return dispatcher.New[*Genesis, transition.ValidatorUpdates](
+		genesisDispatcherName,
+	)
+}
+
+// ProvideSidecarsDispatcher provides a sidecars request dispatcher for the
+// depinject framework.
+func ProvideSidecarsDispatcher() *SidecarsDispatcher {
+	return dispatcher.New[*BlobSidecars, struct{}](
+		sidecarsDispatcherName,
+	)
+}
+
+// ProvideSlotDispatcher provides a slot request dispatcher for the depinject
+// framework.
+func ProvideSlotDispatcher() *SlotDispatcher {
+	return dispatcher.New[*SlotData, *BlockBundle](
+		slotDispatcherName,
+	)
+}
+
+// DefaultDispatcherProviders returns a slice of the default dispatcher
+// providers.
+func DefaultDispatcherProviders() []interface{} {
+	return []interface{}{
+		ProvideBlockDispatcher,
+		ProvideGenesisDispatcher,
+		ProvideSidecarsDispatcher,
+		ProvideSlotDispatcher,
+	}
+}
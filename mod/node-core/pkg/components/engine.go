@@ -21,7 +21,9 @@
 package components
 
 import (
+	"errors"
 	"math/big"
+	"slices"
 
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
@@ -31,8 +33,12 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 )
 
 // EngineClientInputs is the input for the EngineClient.
@@ -44,22 +50,147 @@ type EngineClientInputs struct {
 	JWTSecret     *jwt.Secret `optional:"true"`
 	Logger        log.AdvancedLogger[any, sdklog.Logger]
 	TelemetrySink *metrics.TelemetrySink
+	ForkVersions  *EngineForkVersions
 }
 
 // ProvideEngineClient creates a new EngineClient.
 func ProvideEngineClient(
 	in EngineClientInputs,
-) *EngineClient {
+) (*EngineClient, error) {
+	cfg := in.Config.GetEngine()
+	fallbacks, err := engineEndpoints(
+		cfg.RPCFallbackDialURLs, cfg.FallbackJWTSecretPaths, in.JWTSecret,
+	)
+	if err != nil {
+		return nil, err
+	}
+	shadows, err := engineEndpoints(
+		cfg.ShadowDialURLs, cfg.ShadowJWTSecretPaths, in.JWTSecret,
+	)
+	if err != nil {
+		return nil, err
+	}
 	return client.New[
 		*ExecutionPayload,
 		*PayloadAttributes,
 	](
-		in.Config.GetEngine(),
+		cfg,
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
-	)
+		client.WithFallbacks(fallbacks...),
+		client.WithShadows(shadows...),
+		client.WithForkVersionsFunc(in.ForkVersions.Get),
+	), nil
+}
+
+// EngineForkVersionsInput is the input for the engine fork versions
+// provider.
+type EngineForkVersionsInput struct {
+	depinject.In
+	ChainSpec      common.ChainSpec
+	StorageBackend *StorageBackend
+}
+
+// EngineForkVersions reads the forks whose engine API methods the execution
+// client must serve from the head of the committed beacon state.
+type EngineForkVersions struct {
+	chainSpec      common.ChainSpec
+	storageBackend *StorageBackend
+	node           nodetypes.Node
+}
+
+// ProvideEngineForkVersions provides the engine fork versions.
+func ProvideEngineForkVersions(
+	in EngineForkVersionsInput,
+) *EngineForkVersions {
+	return &EngineForkVersions{
+		chainSpec:      in.ChainSpec,
+		storageBackend: in.StorageBackend,
+	}
+}
+
+// AttachNode sets the node the committed beacon state is queried from.
+func (f *EngineForkVersions) AttachNode(node nodetypes.Node) {
+	f.node = node
+}
+
+// Get returns the versions of the fork active at the head epoch and of the
+// fork upcoming after it.
+func (f *EngineForkVersions) Get() []uint32 {
+	return forkVersionsAt(f.chainSpec, f.headEpoch())
+}
+
+// headEpoch returns the epoch of the committed beacon state, or 0 if nothing
+// has been committed yet.
+func (f *EngineForkVersions) headEpoch() math.Epoch {
+	if f.node == nil {
+		return 0
+	}
+	queryCtx, err := f.node.CreateQueryContext(0, false)
+	if err != nil {
+		return 0
+	}
+	slot, err := f.storageBackend.StateFromContext(queryCtx).GetSlot()
+	if err != nil {
+		return 0
+	}
+	return f.chainSpec.SlotToEpoch(slot)
+}
+
+// forkVersionsAt returns the version of the fork active at the given epoch
+// and, unless it never activates, of the first fork activating after it.
+// Forks further ahead are ignored, as chain specs schedule the forks they do
+// not activate yet at sentinel epochs in the far future.
+func forkVersionsAt(cs common.ChainSpec, epoch math.Epoch) []uint32 {
+	forkVersions := []uint32{cs.ActiveForkVersionForEpoch(epoch)}
+	next := math.Epoch(constants.FarFutureEpoch)
+	for _, forkEpoch := range []math.Epoch{
+		cs.DenebPlusForkEpoch(), cs.ElectraForkEpoch(),
+	} {
+		if forkEpoch > epoch && forkEpoch < next {
+			next = forkEpoch
+		}
+	}
+	if next == math.Epoch(constants.FarFutureEpoch) {
+		return forkVersions
+	}
+	forkVersion := cs.ActiveForkVersionForEpoch(next)
+	if !slices.Contains(forkVersions, forkVersion) {
+		forkVersions = append(forkVersions, forkVersion)
+	}
+	return forkVersions
+}
+
+// engineEndpoints returns the execution client endpoints at the given urls,
+// loading their JWT secrets from the given paths. Endpoints without a JWT
+// secret path share the JWT secret of the primary endpoint.
+func engineEndpoints(
+	rawURLs, jwtSecretPaths []string,
+	jwtSecret *jwt.Secret,
+) ([]client.Endpoint, error) {
+	if len(jwtSecretPaths) > len(rawURLs) {
+		return nil, errors.New("more JWT secret paths than engine urls")
+	}
+
+	endpoints := make([]client.Endpoint, len(rawURLs))
+	for i, rawURL := range rawURLs {
+		dialURL, err := url.NewFromRaw(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		endpoints[i] = client.Endpoint{DialURL: dialURL, JWTSecret: jwtSecret}
+		if i >= len(jwtSecretPaths) {
+			continue
+		}
+		if endpoints[i].JWTSecret, err = LoadJWTFromFile(
+			jwtSecretPaths[i],
+		); err != nil {
+			return nil, err
+		}
+	}
+	return endpoints, nil
 }
 
 // EngineClientInputs is the input for the EngineClient.
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package components
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkVersionsAt(t *testing.T) {
+	scheduled := spec.BaseSpec()
+	scheduled.DenebPlusForkEpoch = 5
+	scheduled.ElectraForkEpoch = 10
+
+	unscheduled := spec.BaseSpec()
+	unscheduled.DenebPlusForkEpoch = math.Epoch(constants.FarFutureEpoch)
+	unscheduled.ElectraForkEpoch = math.Epoch(constants.FarFutureEpoch)
+
+	for _, tc := range []struct {
+		name     string
+		cs       common.ChainSpec
+		epoch    math.Epoch
+		expected []uint32
+	}{
+		{
+			// The testnet schedules its forks at sentinel epochs, which
+			// must not require the Electra engine API.
+			name:     "base spec",
+			cs:       chain.NewChainSpec(spec.BaseSpec()),
+			expected: []uint32{version.Deneb, version.DenebPlus},
+		},
+		{
+			name:     "unscheduled forks",
+			cs:       chain.NewChainSpec(unscheduled),
+			epoch:    100,
+			expected: []uint32{version.Deneb},
+		},
+		{
+			name:     "before deneb plus",
+			cs:       chain.NewChainSpec(scheduled),
+			epoch:    4,
+			expected: []uint32{version.Deneb, version.DenebPlus},
+		},
+		{
+			name:     "at deneb plus",
+			cs:       chain.NewChainSpec(scheduled),
+			epoch:    5,
+			expected: []uint32{version.DenebPlus, version.Electra},
+		},
+		{
+			name:     "at electra",
+			cs:       chain.NewChainSpec(scheduled),
+			epoch:    10,
+			expected: []uint32{version.Electra},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, forkVersionsAt(tc.cs, tc.epoch))
+		})
+	}
+}
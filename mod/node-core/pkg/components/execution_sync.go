@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	executionsync "github.com/berachain/beacon-kit/mod/beacon/execution_sync"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// executionSyncName is the name of the execution sync service.
+const executionSyncName = "execution-sync"
+
+// ExecutionSyncServiceInput is the input for the execution sync service
+// provider.
+type ExecutionSyncServiceInput struct {
+	depinject.In
+
+	BlockBroker     *BlockBroker
+	BlockStore      *BlockStore
+	ChainSpec       common.ChainSpec
+	Config          *config.Config
+	EngineClient    *EngineClient
+	ExecutionEngine *ExecutionEngine
+	Logger          log.AdvancedLogger[any, sdklog.Logger]
+	StorageBackend  *StorageBackend
+}
+
+// ProvideExecutionSyncService provides the service keeping the execution
+// client in sync with the beacon chain.
+func ProvideExecutionSyncService(
+	in ExecutionSyncServiceInput,
+) (*ExecutionSyncService, error) {
+	// Only the latest finalized block matters to the head of the execution
+	// client.
+	subCh, err := in.BlockBroker.SubscribeWithOptions(
+		broker.WithPolicy(broker.PolicyDropOldest),
+		broker.WithSubscriberName(executionSyncName),
+	)
+	if err != nil {
+		in.Logger.Error("failed to subscribe to block feed", "err", err)
+		return nil, err
+	}
+
+	return executionsync.NewService[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconState,
+		*BlockStore,
+		sdk.Context,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+		nodetypes.Node,
+		*PayloadAttributes,
+	](
+		in.Config.ExecutionSync,
+		in.Logger.With("service", executionSyncName),
+		in.ChainSpec,
+		in.EngineClient,
+		in.ExecutionEngine,
+		in.StorageBackend,
+		in.BlockStore,
+		subCh,
+	), nil
+}
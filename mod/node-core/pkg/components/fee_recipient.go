@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/feerecipient"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// FeeRecipientServerInput is the input for the fee recipient provider.
+type FeeRecipientServerInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+	Config  *config.Config
+	Logger  log.AdvancedLogger[any, sdklog.Logger]
+}
+
+// ProvideFeeRecipientServer provides the fee recipients of the validators.
+// Fee recipients set through the API take precedence over those mapped in
+// the fee recipient file, which take precedence over the suggested fee
+// recipient of the payload builder.
+func ProvideFeeRecipientServer(
+	in FeeRecipientServerInput,
+) (*FeeRecipientServer, error) {
+	var (
+		cfg      = in.Config.FeeRecipient
+		logger   = in.Logger.With("service", "fee-recipient")
+		fallback feerecipient.Provider
+	)
+	if cfg.APIStoreFile == "" {
+		cfg.APIStoreFile = filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+			"data", "fee_recipients.json",
+		)
+	}
+	fallback = feerecipient.NewStatic(
+		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+	)
+	if cfg.File != "" {
+		file, err := feerecipient.NewFile(
+			cfg.File, cfg.ReloadInterval, fallback, logger,
+		)
+		if err != nil {
+			return nil, err
+		}
+		fallback = file
+	}
+	return feerecipient.NewServer(cfg, logger, fallback), nil
+}
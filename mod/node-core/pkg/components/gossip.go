@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/p2p/pkg/gossip"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GossipReactorInput is the input for the gossip reactor provider.
+type GossipReactorInput struct {
+	depinject.In
+	AvailabilityStore *AvailabilityStore
+	BlobProofVerifier kzg.BlobProofVerifier
+	Config            *config.Config
+	Logger            log.AdvancedLogger[any, sdklog.Logger]
+}
+
+// ProvideGossipReactor provides the CometBFT reactor through which blob
+// sidecars are exchanged out of band.
+func ProvideGossipReactor(in GossipReactorInput) *GossipReactor {
+	return gossip.New(
+		in.Config.Gossip,
+		in.Logger.With("service", "gossip"),
+		sidecarCodec{verifier: in.BlobProofVerifier},
+		sidecarSource{store: in.AvailabilityStore},
+	)
+}
+
+// sidecarCodec relates SSZ encoded blob sidecars to their KZG commitments.
+type sidecarCodec struct {
+	verifier kzg.BlobProofVerifier
+}
+
+// Split returns the encoded sidecars bundled in the given encoding.
+func (sidecarCodec) Split(bundle []byte) ([][]byte, error) {
+	sidecars := new(BlobSidecars)
+	if err := sidecars.UnmarshalSSZ(bundle); err != nil {
+		return nil, err
+	}
+	encoded := make([][]byte, len(sidecars.Sidecars))
+	for i, sidecar := range sidecars.Sidecars {
+		bz, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = bz
+	}
+	return encoded, nil
+}
+
+// Join bundles the given encoded sidecars.
+func (sidecarCodec) Join(encoded [][]byte) ([]byte, error) {
+	sidecars := &BlobSidecars{
+		Sidecars: make([]*datypes.BlobSidecar, len(encoded)),
+	}
+	for i, bz := range encoded {
+		sidecars.Sidecars[i] = new(datypes.BlobSidecar)
+		if err := sidecars.Sidecars[i].UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+	}
+	return sidecars.MarshalSSZ()
+}
+
+// Commitment returns the KZG commitment of the given encoded sidecar.
+func (sidecarCodec) Commitment(bz []byte) (gossip.Commitment, error) {
+	sidecar := new(datypes.BlobSidecar)
+	if err := sidecar.UnmarshalSSZ(bz); err != nil {
+		return gossip.Commitment{}, err
+	}
+	return gossip.Commitment(sidecar.KzgCommitment), nil
+}
+
+// Verify verifies that the given encoded sidecar carries the blob of the
+// given KZG commitment.
+func (c sidecarCodec) Verify(commitment gossip.Commitment, bz []byte) error {
+	sidecar := new(datypes.BlobSidecar)
+	if err := sidecar.UnmarshalSSZ(bz); err != nil {
+		return err
+	}
+	if gossip.Commitment(sidecar.KzgCommitment) != commitment {
+		return gossip.ErrCommitmentMismatch
+	}
+	return c.verifier.VerifyBlobProof(
+		&sidecar.Blob, sidecar.KzgProof, sidecar.KzgCommitment,
+	)
+}
+
+// sidecarSource recovers the blob sidecars committed at a slot from the
+// availability store.
+type sidecarSource struct {
+	store *AvailabilityStore
+}
+
+// Sidecar returns the encoded blob sidecar with the given KZG commitment
+// committed at the given slot.
+func (s sidecarSource) Sidecar(
+	slot uint64, commitment gossip.Commitment,
+) ([]byte, error) {
+	sidecars, err := s.store.GetBlobSidecars(math.Slot(slot))
+	if err != nil {
+		return nil, err
+	}
+	for _, sidecar := range sidecars.Sidecars {
+		if gossip.Commitment(sidecar.KzgCommitment) == commitment {
+			return sidecar.MarshalSSZ()
+		}
+	}
+	return nil, gossip.ErrUnavailable
+}
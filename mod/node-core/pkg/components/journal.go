@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// JournalInput is the input for the journal provider.
+type JournalInput struct {
+	depinject.In
+	AppOpts            servertypes.AppOptions
+	BlockBroker        *BlockBroker
+	BlockDispatcher    *BlockDispatcher
+	Config             *config.Config
+	GenesisDispatcher  *GenesisDispatcher
+	Logger             log.AdvancedLogger[any, sdklog.Logger]
+	SidecarsDispatcher *SidecarsDispatcher
+	SlotDispatcher     *SlotDispatcher
+}
+
+// ProvideJournal provides the event journal, recording the block broker and
+// every dispatcher if it is enabled.
+func ProvideJournal(in JournalInput) (*Journal, error) {
+	cfg := in.Config.Journal
+	if cfg.Dir == "" {
+		cfg.Dir = filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data", "journal",
+		)
+	}
+	j := journal.New(cfg, in.Logger.With("service", "journal"))
+	if !cfg.Enabled {
+		return j, nil
+	}
+
+	journal.ObserveDispatcher(
+		j, in.BlockDispatcher, blockCodec{}, validatorUpdatesCodec{},
+	)
+	journal.ObserveDispatcher(
+		j, in.GenesisDispatcher, genesisCodec{}, validatorUpdatesCodec{},
+	)
+	journal.ObserveDispatcher[*BlobSidecars, struct{}](
+		j, in.SidecarsDispatcher, sidecarsCodec{}, nil,
+	)
+	journal.ObserveDispatcher[*SlotData, *BlockBundle](
+		j, in.SlotDispatcher, slotCodec{}, nil,
+	)
+	return j, journal.ObserveBroker(j, in.BlockBroker, blockCodec{})
+}
+
+// JournalReplayerInput is the input for the journal replayer provider.
+type JournalReplayerInput struct {
+	depinject.In
+	BlockDispatcher    *BlockDispatcher
+	GenesisDispatcher  *GenesisDispatcher
+	SidecarsDispatcher *SidecarsDispatcher
+}
+
+// ProvideJournalReplayer provides a replayer feeding a journal back through
+// the blockchain and DA services. Slot requests are not replayed, as
+// building a block depends on the execution client.
+func ProvideJournalReplayer(in JournalReplayerInput) *journal.Replayer {
+	r := journal.NewReplayer()
+	r.Register(in.BlockDispatcher.Name(), journal.DispatcherHandler(
+		in.BlockDispatcher, blockCodec{}, validatorUpdatesCodec{},
+	))
+	r.Register(in.GenesisDispatcher.Name(), journal.DispatcherHandler(
+		in.GenesisDispatcher, genesisCodec{}, validatorUpdatesCodec{},
+	))
+	r.Register(
+		in.SidecarsDispatcher.Name(),
+		journal.DispatcherHandler[*BlobSidecars, struct{}](
+			in.SidecarsDispatcher, sidecarsCodec{}, nil,
+		),
+	)
+	return r
+}
+
+// JournalStartHeight returns the height of the state a journal must be
+// replayed against: the height preceding the first block it records, or zero
+// if it records the genesis.
+func JournalStartHeight(entries []journal.Entry) (int64, error) {
+	for _, entry := range entries {
+		if entry.Kind != journal.KindRequest {
+			continue
+		}
+		switch entry.Source {
+		case genesisDispatcherName:
+			return 0, nil
+		case blockDispatcherName:
+			blk, err := blockCodec{}.Decode(entry.Payload, entry.Version)
+			if err != nil {
+				return 0, err
+			}
+			//#nosec:G701 // slots never overflow an int64.
+			return max(int64(blk.GetSlot().Unwrap())-1, 0), nil
+		}
+	}
+	return 0, errors.New("journal does not record any block")
+}
+
+// blockCodec encodes beacon blocks as SSZ.
+type blockCodec struct{}
+
+func (blockCodec) Encode(blk *BeaconBlock) ([]byte, uint32, error) {
+	bz, err := blk.MarshalSSZ()
+	return bz, blk.Version(), err
+}
+
+func (blockCodec) Decode(bz []byte, version uint32) (*BeaconBlock, error) {
+	return (&BeaconBlock{}).NewFromSSZ(bz, version)
+}
+
+// sidecarsCodec encodes blob sidecars as SSZ.
+type sidecarsCodec struct{}
+
+func (sidecarsCodec) Encode(sidecars *BlobSidecars) ([]byte, uint32, error) {
+	bz, err := sidecars.MarshalSSZ()
+	return bz, 0, err
+}
+
+func (sidecarsCodec) Decode(bz []byte, _ uint32) (*BlobSidecars, error) {
+	sidecars := &BlobSidecars{}
+	return sidecars, sidecars.UnmarshalSSZ(bz)
+}
+
+// genesisCodec encodes the genesis as JSON, the format it is distributed in.
+type genesisCodec struct{}
+
+func (genesisCodec) Encode(genesis *Genesis) ([]byte, uint32, error) {
+	bz, err := json.Marshal(genesis)
+	return bz, 0, err
+}
+
+func (genesisCodec) Decode(bz []byte, _ uint32) (*Genesis, error) {
+	genesis := &Genesis{}
+	return genesis, json.Unmarshal(bz, genesis)
+}
+
+// slotCodec encodes the slot of the slot data as an SSZ uint64.
+type slotCodec struct{}
+
+func (slotCodec) Encode(data *SlotData) ([]byte, uint32, error) {
+	return binary.LittleEndian.AppendUint64(
+		nil, data.GetSlot().Unwrap(),
+	), 0, nil
+}
+
+func (slotCodec) Decode(bz []byte, _ uint32) (*SlotData, error) {
+	if len(bz) != uint64Size {
+		return nil, errors.New("invalid slot payload")
+	}
+	return (&SlotData{}).New(
+		math.Slot(binary.LittleEndian.Uint64(bz)), nil, nil,
+	), nil
+}
+
+// uint64Size is the size of an SSZ encoded uint64.
+const uint64Size = 8
+
+// validatorUpdateSize is the size of an encoded validator update, its
+// public key followed by its effective balance.
+const validatorUpdateSize = constants.BLSPubkeyLength + uint64Size
+
+// validatorUpdatesCodec encodes validator updates as an SSZ list of
+// (pubkey, effective balance) containers.
+type validatorUpdatesCodec struct{}
+
+func (validatorUpdatesCodec) Encode(
+	updates transition.ValidatorUpdates,
+) ([]byte, uint32, error) {
+	bz := make([]byte, 0, len(updates)*validatorUpdateSize)
+	for _, update := range updates {
+		bz = append(bz, update.Pubkey[:]...)
+		bz = binary.LittleEndian.AppendUint64(
+			bz, update.EffectiveBalance.Unwrap(),
+		)
+	}
+	return bz, 0, nil
+}
+
+func (validatorUpdatesCodec) Decode(
+	bz []byte, _ uint32,
+) (transition.ValidatorUpdates, error) {
+	if len(bz)%validatorUpdateSize != 0 {
+		return nil, errors.New("invalid validator updates payload")
+	}
+	updates := make(transition.ValidatorUpdates, 0, len(bz)/validatorUpdateSize)
+	for ; len(bz) > 0; bz = bz[validatorUpdateSize:] {
+		update := &transition.ValidatorUpdate{
+			EffectiveBalance: math.Gwei(binary.LittleEndian.Uint64(
+				bz[constants.BLSPubkeyLength:validatorUpdateSize],
+			)),
+		}
+		copy(update.Pubkey[:], bz[:constants.BLSPubkeyLength])
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
@@ -31,14 +31,14 @@ import (
 // ABCIMiddlewareInput is the input for the validator middleware provider.
 type ABCIMiddlewareInput struct {
 	depinject.In
-	BeaconBlockFeed       *BlockBroker
-	ChainSpec             common.ChainSpec
-	GenesisBroker         *GenesisBroker
-	Logger                log.Logger[any]
-	SidecarsFeed          *SidecarsBroker
-	SlotBroker            *SlotBroker
-	TelemetrySink         *metrics.TelemetrySink
-	ValidatorUpdateBroker *ValidatorUpdateBroker
+	BlockDispatcher    *BlockDispatcher
+	ChainSpec          common.ChainSpec
+	GenesisDispatcher  *GenesisDispatcher
+	GossipReactor      *GossipReactor
+	Logger             log.Logger[any]
+	SidecarsDispatcher *SidecarsDispatcher
+	SlotDispatcher     *SlotDispatcher
+	TelemetrySink      *metrics.TelemetrySink
 }
 
 // ProvideABCIMiddleware is a depinject provider for the validator
@@ -46,10 +46,6 @@ type ABCIMiddlewareInput struct {
 func ProvideABCIMiddleware(
 	in ABCIMiddlewareInput,
 ) (*ABCIMiddleware, error) {
-	validatorUpdatesSub, err := in.ValidatorUpdateBroker.Subscribe()
-	if err != nil {
-		return nil, err
-	}
 	return middleware.NewABCIMiddleware[
 		*AvailabilityStore, *BeaconBlock, *BlobSidecars,
 		*Deposit, *ExecutionPayload, *Genesis, *SlotData,
@@ -57,10 +53,10 @@ func ProvideABCIMiddleware(
 		in.ChainSpec,
 		in.Logger,
 		in.TelemetrySink,
-		in.GenesisBroker,
-		in.BeaconBlockFeed,
-		in.SidecarsFeed,
-		in.SlotBroker,
-		validatorUpdatesSub,
+		in.GossipReactor,
+		in.GenesisDispatcher,
+		in.BlockDispatcher,
+		in.SidecarsDispatcher,
+		in.SlotDispatcher,
 	), nil
 }
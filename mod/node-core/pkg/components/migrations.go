@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/errors"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/migration"
+)
+
+// DepositFollowHeadInput is the input for the deposit follow head provider.
+type DepositFollowHeadInput struct {
+	depinject.In
+	ChainSpec      common.ChainSpec
+	StorageBackend *StorageBackend
+}
+
+// DepositFollowHead reads the execution block the deposit sync has caught up
+// to from the committed beacon state.
+type DepositFollowHead struct {
+	chainSpec      common.ChainSpec
+	storageBackend *StorageBackend
+	node           nodetypes.Node
+}
+
+// ProvideDepositFollowHead provides the deposit follow head.
+func ProvideDepositFollowHead(
+	in DepositFollowHeadInput,
+) *DepositFollowHead {
+	return &DepositFollowHead{
+		chainSpec:      in.ChainSpec,
+		storageBackend: in.StorageBackend,
+	}
+}
+
+// AttachNode sets the node the committed beacon state is queried from.
+func (h *DepositFollowHead) AttachNode(node nodetypes.Node) {
+	h.node = node
+}
+
+// Get returns the execution block the follow distance behind the latest
+// execution payload of the committed beacon state, or 0 if nothing has been
+// committed yet.
+func (h *DepositFollowHead) Get(context.Context) (uint64, error) {
+	if h.node == nil {
+		return 0, nil
+	}
+	queryCtx, err := h.node.CreateQueryContext(0, false)
+	if err != nil {
+		//nolint:nilerr // the beacon state is not available before genesis.
+		return 0, nil
+	}
+
+	lph, err := h.storageBackend.StateFromContext(queryCtx).
+		GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return 0, err
+	}
+	number := lph.GetNumber().Unwrap()
+	distance := h.chainSpec.Eth1FollowDistance()
+	if number <= distance {
+		return 0, nil
+	}
+	return number - distance, nil
+}
+
+// MigrationRegistryInput is the input for the migration registry provider.
+type MigrationRegistryInput struct {
+	depinject.In
+	AvailabilityStore *AvailabilityStore
+	BlockStore        *BlockStore
+	DepositStore      *DepositStore
+	KVStore           *KVStore
+	// DepositFollowHead seeds the deposit sync of stores written before it
+	// was tracked. If nil, the deposit sync starts over from the first
+	// execution block.
+	DepositFollowHead *DepositFollowHead `optional:"true"`
+}
+
+// ProvideMigrationRegistry provides the registry of schema migrations for
+// the node's local stores.
+func ProvideMigrationRegistry(
+	in MigrationRegistryInput,
+) (*migration.Registry, error) {
+	rangeDB, ok := in.AvailabilityStore.IndexDB.(*IndexDB)
+	if !ok {
+		return nil, errors.New("availability store does not have a range db")
+	}
+
+	var followHead func(context.Context) (uint64, error)
+	if in.DepositFollowHead != nil {
+		followHead = in.DepositFollowHead.Get
+	}
+
+	registry := migration.NewRegistry()
+	if err := registry.Register(
+		manager.DepositStoreName,
+		in.DepositStore,
+		in.DepositStore.Migrations(followHead)...,
+	); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(
+		manager.AvailabilityStoreName,
+		rangeDB,
+		rangeDB.Migrations()...,
+	); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(
+		manager.BlockStoreName,
+		in.BlockStore,
+		in.BlockStore.Migrations()...,
+	); err != nil {
+		return nil, err
+	}
+	if err := registry.Register(
+		manager.BeaconStateName,
+		in.KVStore,
+		in.KVStore.Migrations()...,
+	); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
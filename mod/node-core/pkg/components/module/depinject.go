@@ -26,6 +26,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	modulev1alpha1 "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module/api/module/v1alpha1"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 // TODO: we don't allow generics here? Why? Is it fixable?
@@ -34,6 +35,7 @@ import (
 func init() {
 	appconfig.RegisterModule(&modulev1alpha1.Module{},
 		appconfig.Provide(
+			components.ProvideConsensusParamsStore,
 			components.ProvideKVStore,
 			ProvideModule,
 		),
@@ -44,6 +46,7 @@ func init() {
 type ModuleInput struct {
 	depinject.In
 	ABCIMiddleware *components.ABCIMiddleware
+	ChainSpec      common.ChainSpec
 	StorageBackend *components.StorageBackend
 }
 
@@ -58,6 +61,7 @@ func ProvideModule(in ModuleInput) (ModuleOutput, error) {
 	return ModuleOutput{
 		Module: NewAppModule(
 			in.ABCIMiddleware,
+			in.ChainSpec,
 			in.StorageBackend,
 		),
 	}, nil
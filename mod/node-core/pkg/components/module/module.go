@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
 	consruntimetypes "github.com/berachain/beacon-kit/mod/consensus/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -50,16 +51,19 @@ var (
 // It is a wrapper around the ABCIMiddleware.
 type AppModule struct {
 	ABCIMiddleware *components.ABCIMiddleware
+	ChainSpec      common.ChainSpec
 	StorageBackend *components.StorageBackend
 }
 
 // NewAppModule creates a new AppModule object.
 func NewAppModule(
 	abciMiddleware *components.ABCIMiddleware,
+	chainSpec common.ChainSpec,
 	storageBackend *components.StorageBackend,
 ) AppModule {
 	return AppModule{
 		ABCIMiddleware: abciMiddleware,
+		ChainSpec:      chainSpec,
 		StorageBackend: storageBackend,
 	}
 }
@@ -129,10 +133,13 @@ func (am AppModule) InitGenesis(
 			*types.SlashingInfo,
 		],
 		components.StorageBackend,
+		*types.Validator,
 		appmodule.ValidatorUpdate,
 	](
+		am.ChainSpec,
 		am.ABCIMiddleware,
 		*am.StorageBackend,
+		nil,
 	).InitGenesis(ctx, bz)
 }
 
@@ -149,9 +156,12 @@ func (am AppModule) EndBlock(
 			*types.SlashingInfo,
 		],
 		components.StorageBackend,
+		*types.Validator,
 		appmodule.ValidatorUpdate,
 	](
+		am.ChainSpec,
 		am.ABCIMiddleware,
 		*am.StorageBackend,
+		nil,
 	).EndBlock(ctx)
 }
@@ -24,11 +24,15 @@ import (
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // LocalBuilderInput is an input for the dep inject framework.
@@ -60,3 +64,37 @@ func ProvideLocalBuilder(
 		in.AttributesFactory,
 	)
 }
+
+// RelayBuilderInput is an input for the dep inject framework.
+type RelayBuilderInput struct {
+	depinject.In
+	AttributesFactory *AttributesFactory
+	Cfg               *config.Config
+	ChainSpec         common.ChainSpec
+	FeeRecipients     *FeeRecipientServer
+	Logger            log.AdvancedLogger[any, sdklog.Logger]
+	Signer            crypto.BLSSigner
+}
+
+// ProvideRelayBuilder provides the builder relays payloads are sourced from
+// for the depinject framework.
+func ProvideRelayBuilder(
+	in RelayBuilderInput,
+) (*RelayBuilder, error) {
+	// Builder API messages are signed over the genesis fork version, as
+	// they are not bound to a chain.
+	domain := types.NewForkData(
+		version.FromUint32[common.Version](version.Deneb), common.Root{},
+	).ComputeDomain(in.ChainSpec.DomainTypeApplicationMask())
+	return relay.New[
+		*BeaconState, *ExecutionPayload, *ExecutionPayloadHeader,
+	](
+		&in.Cfg.Relay,
+		in.ChainSpec,
+		in.Logger.With("service", "relay"),
+		in.Signer,
+		domain,
+		in.FeeRecipients,
+		in.AttributesFactory,
+	)
+}
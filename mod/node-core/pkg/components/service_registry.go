@@ -30,23 +30,24 @@ import (
 // ServiceRegistryInput is the input for the service registry provider.
 type ServiceRegistryInput struct {
 	depinject.In
-	ABCIService           *ABCIMiddleware
-	BlockBroker           *BlockBroker
-	BlockStoreService     *BlockStoreService
-	ChainService          *ChainService
-	DAService             *DAService
-	DBManager             *DBManager
-	DepositService        *DepositService
-	EngineClient          *EngineClient
-	GenesisBroker         *GenesisBroker
-	Logger                log.Logger
-	NodeAPIServer         *NodeAPIServer
-	ReportingService      *ReportingService
-	SidecarsBroker        *SidecarsBroker
-	SlotBroker            *SlotBroker
-	TelemetrySink         *metrics.TelemetrySink
-	ValidatorService      *ValidatorService
-	ValidatorUpdateBroker *ValidatorUpdateBroker
+	ABCIService          *ABCIMiddleware
+	BlockBackfillService *BlockBackfillService
+	BlockBroker          *BlockBroker
+	BlockStoreService    *BlockStoreService
+	ChainService         *ChainService
+	DAService            *DAService
+	DBManager            *DBManager
+	DepositService       *DepositService
+	EngineClient         *EngineClient
+	ExecutionSyncService *ExecutionSyncService
+	FeeRecipientServer   *FeeRecipientServer
+	Journal              *Journal
+	Logger               log.Logger
+	NodeAPIServer        *NodeAPIServer
+	RelayBuilder         *RelayBuilder
+	ReportingService     *ReportingService
+	TelemetrySink        *metrics.TelemetrySink
+	ValidatorService     *ValidatorService
 }
 
 // ProvideServiceRegistry is the depinject provider for the service registry.
@@ -55,20 +56,29 @@ func ProvideServiceRegistry(
 ) *service.Registry {
 	return service.NewRegistry(
 		service.WithLogger(in.Logger),
+		// The DB manager migrates the stores, so it must start first.
+		service.WithService(in.DBManager),
+		service.WithService(in.Journal),
 		service.WithService(in.ValidatorService),
-		service.WithService(in.BlockStoreService),
-		service.WithService(in.ChainService),
-		service.WithService(in.DAService),
-		service.WithService(in.DepositService),
-		service.WithService(in.ABCIService),
-		service.WithService(in.NodeAPIServer),
+		service.WithService(in.BlockStoreService, in.DBManager),
+		service.WithService(
+			in.BlockBackfillService, in.DBManager, in.BlockStoreService,
+		),
+		service.WithService(in.ChainService, in.DBManager),
+		service.WithService(in.ExecutionSyncService, in.ChainService),
+		service.WithService(in.DAService, in.DBManager),
+		service.WithService(in.DepositService, in.DBManager),
+		service.WithService(
+			in.ABCIService,
+			in.ChainService, in.DAService, in.ValidatorService,
+		),
+		service.WithService(in.NodeAPIServer, in.ExecutionSyncService),
+		service.WithService(in.FeeRecipientServer),
+		service.WithService(in.RelayBuilder),
 		service.WithService(in.ReportingService),
-		service.WithService(in.DBManager),
-		service.WithService(in.GenesisBroker),
 		service.WithService(in.BlockBroker),
-		service.WithService(in.SlotBroker),
-		service.WithService(in.SidecarsBroker),
-		service.WithService(in.ValidatorUpdateBroker),
+		// The engine client blocks until the execution client is reachable,
+		// so it starts last.
 		service.WithService(in.EngineClient),
 	)
 }
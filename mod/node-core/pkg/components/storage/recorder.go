@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage
+
+import (
+	"io"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// operation is a single write recorded against a store.
+type operation struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// recordingStore is a KVStore that buffers writes on top of its parent and
+// keeps an ordered log of them, so they can later be replayed onto a
+// different branch of the same parent state.
+type recordingStore struct {
+	*cachekv.Store
+	mu  sync.Mutex
+	ops []operation
+}
+
+// newRecordingStore creates a new recordingStore on top of the given parent.
+func newRecordingStore(parent storetypes.KVStore) *recordingStore {
+	return &recordingStore{Store: cachekv.NewStore(parent)}
+}
+
+// Set buffers the write and records it.
+func (s *recordingStore) Set(key, value []byte) {
+	s.Store.Set(key, value)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ops = append(s.ops, operation{
+		key:   append([]byte(nil), key...),
+		value: append([]byte(nil), value...),
+	})
+}
+
+// Delete buffers the deletion and records it.
+func (s *recordingStore) Delete(key []byte) {
+	s.Store.Delete(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ops = append(s.ops, operation{
+		key:    append([]byte(nil), key...),
+		delete: true,
+	})
+}
+
+// Write is a no-op, the recorded writes are only ever replayed.
+func (*recordingStore) Write() {}
+
+// CacheWrap branches the recordingStore, such that writing the branch is
+// recorded as well.
+func (s *recordingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace branches the recordingStore, tracing is not supported.
+func (s *recordingStore) CacheWrapWithTrace(
+	io.Writer, storetypes.TraceContext,
+) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+// replay applies the recorded writes onto the given store.
+func (s *recordingStore) replay(dst storetypes.KVStore) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, op := range s.ops {
+		if op.delete {
+			dst.Delete(op.key)
+			continue
+		}
+		dst.Set(op.key, op.value)
+	}
+}
+
+// cacheMultiStore is a minimal CacheMultiStore that lazily branches the
+// stores of its parent on first access.
+type cacheMultiStore struct {
+	storetypes.MultiStore
+	mu     sync.Mutex
+	stores map[storetypes.StoreKey]*cachekv.Store
+}
+
+// newCacheMultiStore creates a new cacheMultiStore on top of the given parent.
+func newCacheMultiStore(parent storetypes.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{
+		MultiStore: parent,
+		stores:     make(map[storetypes.StoreKey]*cachekv.Store),
+	}
+}
+
+// GetStore returns the branch of the store for the given key.
+func (cms *cacheMultiStore) GetStore(
+	key storetypes.StoreKey,
+) storetypes.Store {
+	return cms.GetKVStore(key)
+}
+
+// GetKVStore returns the branch of the store for the given key.
+func (cms *cacheMultiStore) GetKVStore(
+	key storetypes.StoreKey,
+) storetypes.KVStore {
+	cms.mu.Lock()
+	defer cms.mu.Unlock()
+	s, ok := cms.stores[key]
+	if !ok {
+		s = cachekv.NewStore(cms.MultiStore.GetKVStore(key))
+		cms.stores[key] = s
+	}
+	return s
+}
+
+// CacheMultiStore branches the cacheMultiStore.
+func (cms *cacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(cms)
+}
+
+// Write writes all of the branched stores to the parent.
+func (cms *cacheMultiStore) Write() {
+	cms.mu.Lock()
+	defer cms.mu.Unlock()
+	for _, s := range cms.stores {
+		s.Write()
+	}
+}
+
+// recordingMultiStore is a MultiStore that records every write made to its
+// stores without ever writing to its parent.
+type recordingMultiStore struct {
+	storetypes.MultiStore
+	mu     sync.Mutex
+	stores map[storetypes.StoreKey]*recordingStore
+}
+
+// newRecordingMultiStore creates a new recordingMultiStore on top of the
+// given parent.
+func newRecordingMultiStore(
+	parent storetypes.MultiStore,
+) *recordingMultiStore {
+	return &recordingMultiStore{
+		MultiStore: parent,
+		stores:     make(map[storetypes.StoreKey]*recordingStore),
+	}
+}
+
+// GetStore returns the recording store for the given key.
+func (rms *recordingMultiStore) GetStore(
+	key storetypes.StoreKey,
+) storetypes.Store {
+	return rms.GetKVStore(key)
+}
+
+// GetKVStore returns the recording store for the given key.
+func (rms *recordingMultiStore) GetKVStore(
+	key storetypes.StoreKey,
+) storetypes.KVStore {
+	rms.mu.Lock()
+	defer rms.mu.Unlock()
+	s, ok := rms.stores[key]
+	if !ok {
+		s = newRecordingStore(rms.MultiStore.GetKVStore(key))
+		rms.stores[key] = s
+	}
+	return s
+}
+
+// CacheMultiStore branches the recordingMultiStore.
+func (rms *recordingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(rms)
+}
+
+// replay applies the writes recorded so far onto the given multistore.
+func (rms *recordingMultiStore) replay(dst storetypes.MultiStore) {
+	rms.mu.Lock()
+	defer rms.mu.Unlock()
+	for key, s := range rms.stores {
+		s.replay(dst.GetKVStore(key))
+	}
+}
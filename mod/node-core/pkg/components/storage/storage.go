@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Backend is a struct that holds the storage backend. It provides a simple
@@ -147,6 +148,26 @@ func (k Backend[
 	return st.NewFromDB(k.kvs.WithContext(ctx), k.cs)
 }
 
+// RecordStateChanges returns a branch of the given context whose store writes
+// are recorded instead of being applied, along with a function that replays
+// the writes recorded so far onto the stores of another context. This allows
+// a state transition that was executed against one branch of a state to be
+// committed against another branch of the very same state.
+func (k Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) RecordStateChanges(
+	ctx context.Context,
+) (context.Context, func(context.Context)) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rms := newRecordingMultiStore(sdkCtx.MultiStore())
+	apply := func(dst context.Context) {
+		rms.replay(sdk.UnwrapSDKContext(dst).MultiStore())
+	}
+	return context.WithValue(
+		ctx, sdk.SdkContextKey, sdkCtx.WithMultiStore(rms),
+	), apply
+}
+
 // BeaconStore returns the beacon store struct.
 func (k Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, KVStoreT, _, _, _, _,
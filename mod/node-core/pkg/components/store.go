@@ -23,20 +23,56 @@ package components
 import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
+// BeaconMetadataDB is the node-local database holding the metadata of the
+// beacon state, such as its schema version, outside of the committed state.
+type BeaconMetadataDB struct {
+	*storage.KVStoreProvider
+}
+
+// BeaconMetadataDBInput is the input for the ProvideBeaconMetadataDB
+// function.
+type BeaconMetadataDBInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+}
+
+// ProvideBeaconMetadataDB is the depinject provider that returns the
+// node-local database holding the metadata of the beacon state.
+func ProvideBeaconMetadataDB(
+	in BeaconMetadataDBInput,
+) (*BeaconMetadataDB, error) {
+	name := "metadata"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BeaconMetadataDB{storage.NewKVStoreProvider(kvp)}, nil
+}
+
 // KVStoreInput is the input for the ProvideKVStore function.
 type KVStoreInput struct {
 	depinject.In
 	Environment appmodule.Environment
+	// MetadataDB records the schema version of the beacon state. If nil,
+	// no schema version is recorded.
+	MetadataDB *BeaconMetadataDB `optional:"true"`
 }
 
 // ProvideKVStore is the depinject provider that returns a beacon KV store.
 func ProvideKVStore(in KVStoreInput) *KVStore {
 	payloadCodec := &encoding.SSZInterfaceCodec[*ExecutionPayloadHeader]{}
-	return beacondb.New[
+	kvs := beacondb.New[
 		*BeaconBlockHeader,
 		*Eth1Data,
 		*ExecutionPayloadHeader,
@@ -44,4 +80,8 @@ func ProvideKVStore(in KVStoreInput) *KVStore {
 		*Validator,
 		Validators,
 	](in.Environment.KVStoreService, payloadCodec)
+	if in.MetadataDB != nil {
+		kvs = kvs.WithMetadata(in.MetadataDB)
+	}
+	return kvs
 }
@@ -23,9 +23,12 @@ package components
 import (
 	"cosmossdk.io/core/appmodule/v2"
 	broker "github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	executionsync "github.com/berachain/beacon-kit/mod/beacon/execution_sync"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
@@ -52,10 +55,14 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/p2p/pkg/gossip"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/feerecipient"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
@@ -138,6 +145,11 @@ type (
 	// BlobVerifier is a type alias for the blob verifier.
 	BlobVerifier = dablob.Verifier
 
+	// BlockBackfillService is a type alias for the block backfill service.
+	BlockBackfillService = blockstore.BackfillService[
+		*BeaconBlock, *BlockStore,
+	]
+
 	// BlockStoreService is a type alias for the block store service.
 	BlockStoreService = blockstore.Service[*BeaconBlock, *BlockStore]
 
@@ -159,6 +171,9 @@ type (
 		*Withdrawal,
 	]
 
+	// CometStores is a type alias for the stores of the CometBFT node.
+	CometStores = cometbft.Stores
+
 	// ConsensusEngine is a type alias for the consensus engine.
 	ConsensusEngine = cometbft.ConsensusEngine[
 		*AttestationData,
@@ -166,9 +181,13 @@ type (
 		*SlashingInfo,
 		*SlotData,
 		*StorageBackend,
+		*Validator,
 		*ValidatorUpdate,
 	]
 
+	// ConsensusParamsStore is a type alias for the consensus params store.
+	ConsensusParamsStore = comet.ConsensusParamsStore
+
 	// ConsensusMiddleware is a type alias for the consensus middleware.
 	ConsensusMiddleware = cometbft.Middleware[
 		*AttestationData,
@@ -184,7 +203,7 @@ type (
 		*AvailabilityStore,
 		*BeaconBlockBody,
 		*BlobSidecars,
-		*SidecarsBroker,
+		*SidecarsDispatcher,
 		*ExecutionPayload,
 	]
 
@@ -230,6 +249,20 @@ type (
 		engineprimitives.Withdrawals,
 	]
 
+	// ExecutionSyncService is a type alias for the execution sync service.
+	ExecutionSyncService = executionsync.Service[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconState,
+		*BlockStore,
+		sdk.Context,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+		nodetypes.Node,
+		*PayloadAttributes,
+		engineprimitives.Withdrawals,
+	]
+
 	// ExecutionPayload type aliases.
 	ExecutionPayload       = types.ExecutionPayload
 	ExecutionPayloadHeader = types.ExecutionPayloadHeader
@@ -255,6 +288,15 @@ type (
 	// IndexDB is a type alias for the range DB.
 	IndexDB = filedb.RangeDB
 
+	// FeeRecipientServer is a type alias for the fee recipient server.
+	FeeRecipientServer = feerecipient.Server
+
+	// GossipReactor is a type alias for the gossip reactor.
+	GossipReactor = gossip.Reactor
+
+	// Journal is a type alias for the event journal.
+	Journal = journal.Journal
+
 	// KVStore is a type alias for the KV store.
 	KVStore = beacondb.KVStore[
 		*BeaconBlockHeader,
@@ -321,6 +363,11 @@ type (
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// RelayBuilder is a type alias for the builder relays.
+	RelayBuilder = relay.Builder[
+		*BeaconState, *ExecutionPayload, *ExecutionPayloadHeader,
+	]
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
@@ -397,6 +444,8 @@ type (
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		sdk.Context,
+		nodetypes.Node,
 	]
 
 	// ValidatorUpdate is a type alias for the validator update.
@@ -414,23 +463,14 @@ type (
 /* -------------------------------------------------------------------------- */
 
 type (
+	// BlockBundle is a type alias for a block built with its sidecars.
+	BlockBundle = asynctypes.BlockBundle[*BeaconBlock, *BlobSidecars]
+
 	// BlockEvent is a type alias for the block event.
 	BlockEvent = asynctypes.Event[*BeaconBlock]
 
-	// GenesisEvent is a type alias for the genesis event.
-	GenesisEvent = asynctypes.Event[*Genesis]
-
-	// SidecarEvent is a type alias for the sidecar event.
-	SidecarEvent = asynctypes.Event[*BlobSidecars]
-
-	// SlotEvent is a type alias for the slot event.
-	SlotEvent = asynctypes.Event[*SlotData]
-
 	// StatusEvent is a type alias for the status event.
 	StatusEvent = asynctypes.Event[*service.StatusEvent]
-
-	// ValidatorUpdateEvent is a type alias for the validator update event.
-	ValidatorUpdateEvent = asynctypes.Event[transition.ValidatorUpdates]
 )
 
 /* -------------------------------------------------------------------------- */
@@ -438,23 +478,34 @@ type (
 /* -------------------------------------------------------------------------- */
 
 type (
-	// GenesisBroker is a type alias for the genesis feed.
-	GenesisBroker = broker.Broker[*GenesisEvent]
-
-	// SidecarsBroker is a type alias for the blob feed.
-	SidecarsBroker = broker.Broker[*SidecarEvent]
-
 	// BlockBroker is a type alias for the block feed.
 	BlockBroker = broker.Broker[*BlockEvent]
 
-	// SlotBroker is a type alias for the slot feed.
-	SlotBroker = broker.Broker[*SlotEvent]
-
 	// StatusBroker is a type alias for the status feed.
 	StatusBroker = broker.Broker[*StatusEvent]
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                 Dispatchers                                */
+/* -------------------------------------------------------------------------- */
+
+type (
+	// BlockDispatcher is a type alias for the block request dispatcher.
+	BlockDispatcher = dispatcher.Dispatcher[
+		*BeaconBlock, transition.ValidatorUpdates,
+	]
+
+	// GenesisDispatcher is a type alias for the genesis request dispatcher.
+	GenesisDispatcher = dispatcher.Dispatcher[
+		*Genesis, transition.ValidatorUpdates,
+	]
+
+	// SidecarsDispatcher is a type alias for the sidecars request
+	// dispatcher.
+	SidecarsDispatcher = dispatcher.Dispatcher[*BlobSidecars, struct{}]
 
-	// ValidatorUpdateBroker is a type alias for the validator update feed.
-	ValidatorUpdateBroker = broker.Broker[*ValidatorUpdateEvent]
+	// SlotDispatcher is a type alias for the slot request dispatcher.
+	SlotDispatcher = dispatcher.Dispatcher[*SlotData, *BlockBundle]
 )
 
 /* -------------------------------------------------------------------------- */
@@ -495,7 +546,7 @@ type (
 	EventsAPIHandler = eventsapi.Handler[NodeAPIContext]
 
 	// NodeAPIHandler is a type alias for the node handler.
-	NodeAPIHandler = nodeapi.Handler[NodeAPIContext]
+	NodeAPIHandler = nodeapi.Handler[NodeAPIContext, *executionsync.Status]
 
 	// ProofAPIHandler is a type alias for the proof handler.
 	ProofAPIHandler = proofapi.Handler[
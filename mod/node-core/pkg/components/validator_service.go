@@ -25,39 +25,59 @@ import (
 	sdklog "cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput struct {
 	depinject.In
-	BeaconBlockFeed *BlockBroker
-	BlobProcessor   *BlobProcessor
-	Cfg             *config.Config
-	ChainSpec       common.ChainSpec
-	LocalBuilder    *LocalBuilder
-	Logger          log.AdvancedLogger[any, sdklog.Logger]
-	StateProcessor  *StateProcessor
-	StorageBackend  *StorageBackend
-	Signer          crypto.BLSSigner
-	SidecarsFeed    *SidecarsBroker
-	SidecarFactory  *SidecarFactory
-	SlotBroker      *SlotBroker
-	TelemetrySink   *metrics.TelemetrySink
+	AppOpts        servertypes.AppOptions
+	BlobProcessor  *BlobProcessor
+	BlockStore     *BlockStore
+	Cfg            *config.Config
+	ChainSpec      common.ChainSpec
+	LocalBuilder   *LocalBuilder
+	Logger         log.AdvancedLogger[any, sdklog.Logger]
+	RelayBuilder   *RelayBuilder
+	StateProcessor *StateProcessor
+	StorageBackend *StorageBackend
+	Signer         crypto.BLSSigner
+	SidecarFactory *SidecarFactory
+	SlotDispatcher *SlotDispatcher
+	TelemetrySink  *metrics.TelemetrySink
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
 func ProvideValidatorService(
 	in ValidatorServiceInput,
 ) (*ValidatorService, error) {
-	slotSubscription, err := in.SlotBroker.Subscribe()
-	if err != nil {
-		in.Logger.Error("failed to subscribe to slot feed", "err", err)
-		return nil, err
+	// The slot clock estimates the proposals of this node from the proposer
+	// priorities of the CometBFT validator set.
+	var slotTicker validator.SlotTicker
+	if in.Cfg.Validator.EnableSlotClock {
+		schedule, err := cometbft.NewProposerSchedule(
+			cast.ToString(in.AppOpts.Get("rpc.laddr")),
+		)
+		if err != nil {
+			return nil, err
+		}
+		slotTicker = validator.NewSlotClock(
+			in.Logger.With("service", "slot-clock"),
+			schedule,
+			in.Signer.PublicKey(),
+			cast.ToDuration(in.AppOpts.Get("consensus.timeout_commit")),
+			in.Cfg.Validator.SlotClockLead,
+		)
 	}
+
 	// Build the builder service.
 	return validator.NewService[
 		*AttestationData,
@@ -73,6 +93,8 @@ func ProvideValidatorService(
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		sdk.Context,
+		nodetypes.Node,
 	](
 		&in.Cfg.Validator,
 		in.Logger.With("service", "validator"),
@@ -82,12 +104,10 @@ func ProvideValidatorService(
 		in.Signer,
 		in.SidecarFactory,
 		in.LocalBuilder,
-		[]validator.PayloadBuilder[*BeaconState, *ExecutionPayload]{
-			in.LocalBuilder,
-		},
+		in.RelayBuilder,
+		in.BlockStore,
 		in.TelemetrySink,
-		in.BeaconBlockFeed,
-		in.SidecarsFeed,
-		slotSubscription,
+		in.SlotDispatcher,
+		slotTicker,
 	), nil
 }
@@ -23,9 +23,14 @@ package node
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
 )
 
 // Compile-time assertion that node implements the NodeI interface.
@@ -37,6 +42,10 @@ type node struct {
 
 	// registry is the node's service registry.
 	registry *service.Registry
+	// reactors are the reactors CometBFT runs alongside its own.
+	reactors map[string]p2p.Reactor
+	// cometStores are the stores of CometBFT read by the node's components.
+	cometStores *cometbft.Stores
 }
 
 // New returns a new node.
@@ -49,6 +58,12 @@ func (n *node) Start(ctx context.Context) error {
 	return n.registry.StartAll(ctx)
 }
 
+// Close stops the services of the node, in the reverse order they were
+// started, before closing the application.
+func (n *node) Close() error {
+	return errors.Join(n.registry.StopAll(), n.App.Close())
+}
+
 // SetApplication sets the application.
 func (n *node) RegisterApp(a types.Application) {
 	//nolint:errcheck // BeaconApp is our servertypes.Application
@@ -59,3 +74,32 @@ func (n *node) RegisterApp(a types.Application) {
 func (n *node) SetServiceRegistry(registry *service.Registry) {
 	n.registry = registry
 }
+
+// RegisterReactor registers a reactor for CometBFT to run alongside its own.
+func (n *node) RegisterReactor(name string, reactor p2p.Reactor) {
+	if n.reactors == nil {
+		n.reactors = make(map[string]p2p.Reactor)
+	}
+	n.reactors[name] = reactor
+}
+
+// Reactors returns the reactors registered with the node.
+func (n *node) Reactors() map[string]p2p.Reactor {
+	return n.reactors
+}
+
+// SetCometStores sets the stores of CometBFT read by the node's components.
+func (n *node) SetCometStores(stores *cometbft.Stores) {
+	n.cometStores = stores
+}
+
+// AttachCometStores attaches the block and state stores of the CometBFT node
+// running the application.
+func (n *node) AttachCometStores(
+	blockStore *store.BlockStore,
+	stateStore sm.Store,
+) {
+	if n.cometStores != nil {
+		n.cometStores.Attach(blockStore, stateStore)
+	}
+}
@@ -23,7 +23,11 @@ package types
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
 )
 
 // Node defines the API for the node application.
@@ -38,4 +42,15 @@ type Node interface {
 	RegisterApp(app Application)
 	// SetServiceRegistry sets the node's service registry.
 	SetServiceRegistry(registry *service.Registry)
+	// RegisterReactor registers a reactor for CometBFT to run alongside
+	// its own.
+	RegisterReactor(name string, reactor p2p.Reactor)
+	// Reactors returns the reactors registered with the node.
+	Reactors() map[string]p2p.Reactor
+	// SetCometStores sets the stores through which the node's components
+	// read the stores of CometBFT.
+	SetCometStores(stores *cometbft.Stores)
+	// AttachCometStores hands the block and state stores of the CometBFT
+	// node running the application to the node's components.
+	AttachCometStores(blockStore *store.BlockStore, stateStore sm.Store)
 }
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import "bytes"
+
+// CommitmentSize is the size of the KZG commitment of a blob sidecar.
+const CommitmentSize = 48
+
+// announcementPrefix prefixes every encoded announcement. An SSZ encoding
+// starting with an offset never starts with it, as the first offset of a
+// container is small.
+var announcementPrefix = []byte{0xff, 'b', 'k', 'g'}
+
+// Commitment is the KZG commitment a blob sidecar is announced by.
+type Commitment [CommitmentSize]byte
+
+// Announcement announces blob sidecars by their KZG commitments, in the order
+// the sidecars are bundled in.
+type Announcement struct {
+	// Commitments are the commitments of the announced sidecars.
+	Commitments []Commitment
+}
+
+// Marshal encodes the announcement.
+func (a Announcement) Marshal() []byte {
+	return append(
+		append([]byte{}, announcementPrefix...),
+		encodeCommitments(a.Commitments)...,
+	)
+}
+
+// Unmarshal decodes the announcement.
+func (a *Announcement) Unmarshal(bz []byte) error {
+	if !IsAnnouncement(bz) {
+		return ErrNotAnnouncement
+	}
+	a.Commitments = decodeCommitments(bz[len(announcementPrefix):])
+	return nil
+}
+
+// IsAnnouncement returns true if the given data is an encoded announcement.
+func IsAnnouncement(bz []byte) bool {
+	return len(bz) >= len(announcementPrefix) &&
+		bytes.HasPrefix(bz, announcementPrefix) &&
+		(len(bz)-len(announcementPrefix))%CommitmentSize == 0
+}
+
+// decodeCommitments decodes concatenated commitments, ignoring any trailing
+// bytes.
+func decodeCommitments(bz []byte) []Commitment {
+	commitments := make([]Commitment, len(bz)/CommitmentSize)
+	for i := range commitments {
+		copy(commitments[i][:], bz[i*CommitmentSize:])
+	}
+	return commitments
+}
+
+// encodeCommitments concatenates the given commitments.
+func encodeCommitments(commitments []Commitment) []byte {
+	bz := make([]byte, 0, len(commitments)*CommitmentSize)
+	for _, c := range commitments {
+		bz = append(bz, c[:]...)
+	}
+	return bz
+}
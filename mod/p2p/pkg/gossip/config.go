@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import "time"
+
+const (
+	// defaultFetchTimeout is the default timeout of fetching sidecars from a
+	// peer.
+	defaultFetchTimeout = 2 * time.Second
+	// defaultPoolSize is the default number of sidecars kept in the pool.
+	defaultPoolSize = 64
+)
+
+// Config is the configuration for the gossip reactor.
+type Config struct {
+	// Enabled determines if blob sidecars are announced in proposals and
+	// fetched out of band. If disabled, they travel inside the proposal.
+	Enabled bool `mapstructure:"enabled"`
+	// FetchTimeout is the timeout of fetching sidecars from a single peer.
+	FetchTimeout time.Duration `mapstructure:"fetch-timeout"`
+	// PoolSize is the number of sidecars kept around to serve peers.
+	PoolSize int `mapstructure:"pool-size"`
+}
+
+// DefaultConfig returns the default configuration for the gossip reactor.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:      false,
+		FetchTimeout: defaultFetchTimeout,
+		PoolSize:     defaultPoolSize,
+	}
+}
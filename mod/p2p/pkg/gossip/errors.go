@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import "errors"
+
+var (
+	// ErrNotAnnouncement is returned when decoding data that is not an
+	// announcement.
+	ErrNotAnnouncement = errors.New("not an announcement")
+	// ErrCommitmentMismatch is returned when a fetched sidecar does not match
+	// the commitment it was announced by.
+	ErrCommitmentMismatch = errors.New(
+		"sidecar does not match announced commitment",
+	)
+	// ErrUnavailable is returned when no peer could serve an announced
+	// sidecar.
+	ErrUnavailable = errors.New("announced sidecar unavailable")
+	// ErrInvalidMessage is returned when decoding a malformed message.
+	ErrInvalidMessage = errors.New("invalid gossip message")
+)
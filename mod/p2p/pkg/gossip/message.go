@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import (
+	"encoding/binary"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// messageKind is the kind of a message exchanged over the gossip channel.
+type messageKind byte
+
+const (
+	// kindAnnounce announces sidecars by their commitments, for peers to
+	// prefetch them from the sender.
+	kindAnnounce messageKind = iota + 1
+	// kindRequest requests the sidecar with a commitment.
+	kindRequest
+	// kindResponse serves a requested sidecar, following its commitment.
+	kindResponse
+	// kindUnavailable answers a request for a sidecar the sender does not
+	// have.
+	kindUnavailable
+)
+
+// headerSize is the size of the kind and slot every message starts with.
+const headerSize = 1 + 8
+
+// message is a message exchanged over the gossip channel. It travels as the
+// value of a protobuf BytesValue, CometBFT channels carrying protobuf
+// messages only.
+type message struct {
+	kind messageKind
+	// slot is the slot the sidecars were committed at, zero if unknown.
+	slot uint64
+	// payload holds the commitments the message refers to, followed by the
+	// sidecar for a response.
+	payload []byte
+}
+
+// newMessage creates a message of the given kind.
+func newMessage(kind messageKind, slot uint64, payload ...[]byte) message {
+	m := message{kind: kind, slot: slot}
+	for _, p := range payload {
+		m.payload = append(m.payload, p...)
+	}
+	return m
+}
+
+// wrap encodes the message into the protobuf message sent to peers.
+func (m message) wrap() *gogotypes.BytesValue {
+	bz := make([]byte, headerSize, headerSize+len(m.payload))
+	bz[0] = byte(m.kind)
+	binary.BigEndian.PutUint64(bz[1:headerSize], m.slot)
+	return &gogotypes.BytesValue{Value: append(bz, m.payload...)}
+}
+
+// unwrap decodes a message received from a peer.
+func (m *message) unwrap(bz []byte) error {
+	if len(bz) < headerSize {
+		return ErrInvalidMessage
+	}
+	m.kind = messageKind(bz[0])
+	m.slot = binary.BigEndian.Uint64(bz[1:headerSize])
+	m.payload = bz[headerSize:]
+
+	switch m.kind {
+	case kindAnnounce:
+		if len(m.payload)%CommitmentSize != 0 {
+			return ErrInvalidMessage
+		}
+	case kindRequest, kindUnavailable:
+		if len(m.payload) != CommitmentSize {
+			return ErrInvalidMessage
+		}
+	case kindResponse:
+		if len(m.payload) <= CommitmentSize {
+			return ErrInvalidMessage
+		}
+	default:
+		return ErrInvalidMessage
+	}
+	return nil
+}
+
+// commitment returns the commitment a request, response or unavailability
+// refers to.
+func (m message) commitment() Commitment {
+	var c Commitment
+	copy(c[:], m.payload)
+	return c
+}
+
+// sidecar returns the sidecar served by a response.
+func (m message) sidecar() []byte {
+	return m.payload[CommitmentSize:]
+}
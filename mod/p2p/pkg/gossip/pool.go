@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import "sync"
+
+// pool keeps the most recently announced or fetched sidecars, evicting the
+// oldest once full.
+type pool struct {
+	mu      sync.RWMutex
+	size    int
+	entries map[Commitment][]byte
+	order   []Commitment
+}
+
+// newPool creates a pool holding up to size sidecars.
+func newPool(size int) *pool {
+	return &pool{
+		size:    max(size, 1),
+		entries: make(map[Commitment][]byte),
+	}
+}
+
+// add adds a sidecar to the pool.
+func (p *pool) add(commitment Commitment, data []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.entries[commitment]; ok {
+		return
+	}
+	if len(p.order) == p.size {
+		delete(p.entries, p.order[0])
+		p.order = p.order[1:]
+	}
+	p.entries[commitment] = data
+	p.order = append(p.order, commitment)
+}
+
+// get returns the sidecar with the given commitment, if any.
+func (p *pool) get(commitment Commitment) ([]byte, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	data, ok := p.entries[commitment]
+	return data, ok
+}
@@ -0,0 +1,480 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+const (
+	// ReactorName is the name the reactor is registered with on the
+	// CometBFT switch.
+	ReactorName = "GOSSIP"
+	// Channel is the CometBFT channel blob sidecars are gossiped over.
+	Channel = byte(0x70)
+	// channelPriority is the priority of the channel, on par with the
+	// mempool.
+	channelPriority = 5
+	// sendQueueCapacity is the number of messages queued for each peer.
+	sendQueueCapacity = 64
+	// maxMessageSize is the maximum size of a message, a sidecar being a
+	// little larger than the blob it carries.
+	maxMessageSize = 1 << 20
+)
+
+// Logger is the logger used by the reactor.
+type Logger interface {
+	Debug(msg string, keyVals ...any)
+	Warn(msg string, keyVals ...any)
+}
+
+// Codec relates encoded blob sidecars to the commitments they are announced
+// by.
+type Codec interface {
+	// Split returns the encoded sidecars bundled in the given encoding.
+	Split(bundle []byte) ([][]byte, error)
+	// Join bundles the given encoded sidecars.
+	Join(sidecars [][]byte) ([]byte, error)
+	// Commitment returns the commitment of the given encoded sidecar.
+	Commitment(sidecar []byte) (Commitment, error)
+	// Verify verifies that the given encoded sidecar carries the blob of the
+	// given commitment.
+	Verify(commitment Commitment, sidecar []byte) error
+}
+
+// Source provides the sidecars committed at a slot once they are no longer
+// pooled, such that the sidecars of committed blocks remain recoverable.
+type Source interface {
+	// Sidecar returns the encoded sidecar with the given commitment
+	// committed at the given slot.
+	Sidecar(slot uint64, commitment Commitment) ([]byte, error)
+}
+
+// Reactor is a CometBFT reactor through which nodes exchange blob sidecars
+// outside of proposals. Proposals announce sidecars by their KZG commitments,
+// and peers fetch each of them from the peer that announced it or from any
+// other peer that already fetched it, relaying the announcement once they
+// did. Sidecars that are no longer pooled are recovered from the source of
+// the node or of its peers by the slot they were committed at.
+type Reactor struct {
+	*p2p.BaseReactor
+
+	cfg    Config
+	logger Logger
+	codec  Codec
+	source Source
+	pool   *pool
+	// wg tracks the prefetches of announced sidecars.
+	wg sync.WaitGroup
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// peers are the connected peers serving the gossip channel.
+	peers map[p2p.ID]p2p.Peer
+	// waiters are notified of the outcome of the requests for each
+	// commitment.
+	waiters map[Commitment][]waiter
+}
+
+// waiter waits for a sidecar requested from a peer.
+type waiter struct {
+	// peer is the peer the sidecar was requested from.
+	peer p2p.ID
+	// done is signaled once the sidecar is pooled, or once the peer
+	// answered it does not have it.
+	done chan struct{}
+}
+
+// New creates a new gossip reactor, recovering sidecars that are no longer
+// pooled from the given source, if any.
+func New(cfg Config, logger Logger, codec Codec, source Source) *Reactor {
+	r := &Reactor{
+		cfg:     cfg,
+		logger:  logger,
+		codec:   codec,
+		source:  source,
+		pool:    newPool(cfg.PoolSize),
+		peers:   make(map[p2p.ID]p2p.Peer),
+		waiters: make(map[Commitment][]waiter),
+	}
+	r.BaseReactor = p2p.NewBaseReactor(ReactorName, r)
+	return r
+}
+
+// OnStop waits for the prefetches in flight, which the stopping reactor
+// aborts.
+func (r *Reactor) OnStop() {
+	r.wg.Wait()
+}
+
+// GetChannels returns the channel blob sidecars are gossiped over.
+func (r *Reactor) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{{
+		ID:                  Channel,
+		Priority:            channelPriority,
+		SendQueueCapacity:   sendQueueCapacity,
+		RecvBufferCapacity:  maxMessageSize,
+		RecvMessageCapacity: maxMessageSize,
+		MessageType:         &gogotypes.BytesValue{},
+	}}
+}
+
+// AddPeer tracks the peer if it serves the gossip channel.
+func (r *Reactor) AddPeer(peer p2p.Peer) {
+	info, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok || !info.HasChannel(Channel) {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.peers[peer.ID()] = peer
+}
+
+// RemovePeer stops tracking the peer.
+func (r *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.peers, peer.ID())
+}
+
+// Enabled returns true if sidecars are announced to peers rather than
+// carried inside proposals, which requires every connected peer to serve the
+// gossip channel.
+func (r *Reactor) Enabled() bool {
+	if !r.cfg.Enabled || !r.IsRunning() || r.Switch == nil {
+		return false
+	}
+	r.mu.Lock()
+	n := len(r.peers)
+	r.mu.Unlock()
+	return n > 0 && n == r.Switch.Peers().Size()
+}
+
+// Announce pools the encoded sidecars of a proposal and announces them to
+// the peers, which prefetch them. It returns the encoded announcement.
+func (r *Reactor) Announce(bundle []byte) ([]byte, error) {
+	sidecars, err := r.codec.Split(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	a := Announcement{Commitments: make([]Commitment, len(sidecars))}
+	for i, sidecar := range sidecars {
+		if a.Commitments[i], err = r.codec.Commitment(sidecar); err != nil {
+			return nil, err
+		}
+		r.pool.add(a.Commitments[i], sidecar)
+	}
+	r.broadcast(
+		newMessage(kindAnnounce, 0, encodeCommitments(a.Commitments)), "",
+	)
+	return a.Marshal(), nil
+}
+
+// Fetch returns the encoded sidecars the encoded announcement committed at
+// the given slot refers to, fetching from the peers those that are neither
+// pooled nor in the source of the node.
+func (r *Reactor) Fetch(
+	ctx context.Context, slot uint64, bz []byte,
+) ([]byte, error) {
+	var a Announcement
+	if err := a.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	if err := r.fetch(ctx, slot, a.Commitments, ""); err != nil {
+		return nil, err
+	}
+	return r.collect(slot, a.Commitments)
+}
+
+// Lookup returns the encoded sidecars the encoded announcement committed at
+// the given slot refers to from the pool or the source of the node only,
+// never contacting its peers.
+func (r *Reactor) Lookup(slot uint64, bz []byte) ([]byte, error) {
+	var a Announcement
+	if err := a.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return r.collect(slot, a.Commitments)
+}
+
+// Receive handles a message received from a peer over the gossip channel.
+func (r *Reactor) Receive(e p2p.Envelope) {
+	var m message
+	bv, ok := e.Message.(*gogotypes.BytesValue)
+	if !ok {
+		r.Switch.StopPeerForError(e.Src, ErrInvalidMessage)
+		return
+	}
+	if err := m.unwrap(bv.GetValue()); err != nil {
+		r.Switch.StopPeerForError(e.Src, err)
+		return
+	}
+
+	switch m.kind {
+	case kindAnnounce:
+		r.handleAnnounce(e.Src, m)
+	case kindRequest:
+		r.handleRequest(e.Src, m)
+	case kindResponse:
+		r.handleResponse(e.Src, m)
+	case kindUnavailable:
+		r.notify(m.commitment(), e.Src.ID(), false)
+	}
+}
+
+// handleAnnounce prefetches the announced sidecars that are not pooled yet
+// from the peer that announced them, relaying the announcement once fetched.
+func (r *Reactor) handleAnnounce(src p2p.Peer, m message) {
+	commitments := decodeCommitments(m.payload)
+	if len(r.missing(m.slot, commitments)) == 0 || !r.IsRunning() {
+		return
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		err := r.fetch(context.Background(), m.slot, commitments, src.ID())
+		if err != nil {
+			r.logger.Debug(
+				"Failed to prefetch announced sidecars", "error", err,
+			)
+			return
+		}
+		r.broadcast(m, src.ID())
+	}()
+}
+
+// handleRequest serves the requested sidecar if the node has it.
+func (r *Reactor) handleRequest(src p2p.Peer, m message) {
+	c := m.commitment()
+	sidecar, ok := r.lookup(m.slot, c)
+	if !ok {
+		r.send(src, newMessage(kindUnavailable, m.slot, c[:]), false)
+		return
+	}
+	r.send(src, newMessage(kindResponse, m.slot, c[:], sidecar), false)
+}
+
+// handleResponse pools the requested sidecar once verified against its
+// commitment. Sidecars nobody waits for are dropped.
+func (r *Reactor) handleResponse(src p2p.Peer, m message) {
+	c := m.commitment()
+	if !r.awaited(c) {
+		return
+	}
+	if err := r.codec.Verify(c, m.sidecar()); err != nil {
+		r.logger.Debug(
+			"Received invalid sidecar",
+			"peer", src.ID(), "commitment", fmt.Sprintf("%x", c),
+			"error", err,
+		)
+		r.notify(c, src.ID(), false)
+		return
+	}
+	r.pool.add(c, m.sidecar())
+	r.notify(c, src.ID(), true)
+}
+
+// fetch fetches the given sidecars committed at the given slot that are not
+// pooled yet, asking the given peer first, if any.
+func (r *Reactor) fetch(
+	ctx context.Context, slot uint64, commitments []Commitment, first p2p.ID,
+) error {
+	missing := r.missing(slot, commitments)
+	for _, peer := range r.sources(first) {
+		if len(missing) == 0 {
+			break
+		}
+		missing = r.fetchFrom(ctx, peer, slot, missing)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %x", ErrUnavailable, missing[0])
+	}
+	return nil
+}
+
+// fetchFrom requests the given sidecars from a peer, returning those it did
+// not serve in time.
+func (r *Reactor) fetchFrom(
+	ctx context.Context, peer p2p.Peer, slot uint64, commitments []Commitment,
+) []Commitment {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.FetchTimeout)
+	defer cancel()
+
+	waiters := make([]waiter, len(commitments))
+	for i, c := range commitments {
+		waiters[i] = r.await(c, peer.ID())
+		r.send(peer, newMessage(kindRequest, slot, c[:]), true)
+	}
+	defer r.release(commitments, waiters)
+
+	var missing []Commitment
+	for i, c := range commitments {
+		select {
+		case <-waiters[i].done:
+		case <-ctx.Done():
+		case <-r.Quit():
+		}
+		if _, ok := r.pool.get(c); !ok {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+// collect bundles the given sidecars committed at the given slot, all of
+// which must be available locally.
+func (r *Reactor) collect(
+	slot uint64, commitments []Commitment,
+) ([]byte, error) {
+	sidecars := make([][]byte, len(commitments))
+	for i, c := range commitments {
+		sidecar, ok := r.lookup(slot, c)
+		if !ok {
+			return nil, fmt.Errorf("%w: %x", ErrUnavailable, c)
+		}
+		sidecars[i] = sidecar
+	}
+	return r.codec.Join(sidecars)
+}
+
+// missing returns the given sidecars committed at the given slot that are not
+// available locally.
+func (r *Reactor) missing(
+	slot uint64, commitments []Commitment,
+) []Commitment {
+	return slices.DeleteFunc(slices.Clone(commitments), func(c Commitment) bool {
+		_, ok := r.lookup(slot, c)
+		return ok
+	})
+}
+
+// lookup returns the sidecar with the given commitment from the pool, or from
+// the source if it was committed at the given slot. A zero slot is unknown,
+// the genesis block never committing to any sidecar.
+func (r *Reactor) lookup(slot uint64, c Commitment) ([]byte, bool) {
+	if sidecar, ok := r.pool.get(c); ok {
+		return sidecar, true
+	}
+	if r.source == nil || slot == 0 {
+		return nil, false
+	}
+
+	sidecar, err := r.source.Sidecar(slot, c)
+	if err != nil {
+		return nil, false
+	}
+	r.pool.add(c, sidecar)
+	return sidecar, true
+}
+
+// sources returns the peers to fetch sidecars from, starting with the given
+// one if it serves the gossip channel.
+func (r *Reactor) sources(first p2p.ID) []p2p.Peer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	peers := make([]p2p.Peer, 0, len(r.peers))
+	if peer, ok := r.peers[first]; ok {
+		peers = append(peers, peer)
+	}
+	for id, peer := range r.peers {
+		if id != first {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+// broadcast sends a message to every peer serving the gossip channel but the
+// given one, dropping it for the peers whose queue is full.
+func (r *Reactor) broadcast(m message, except p2p.ID) {
+	for _, peer := range r.sources("") {
+		if peer.ID() != except {
+			r.send(peer, m, false)
+		}
+	}
+}
+
+// send sends a message to a peer, blocking until it is queued if required.
+func (r *Reactor) send(peer p2p.Peer, m message, block bool) {
+	e := p2p.Envelope{ChannelID: Channel, Message: m.wrap()}
+	if block {
+		peer.Send(e)
+		return
+	}
+	peer.TrySend(e)
+}
+
+// await registers a waiter for the sidecar with the given commitment
+// requested from the given peer.
+func (r *Reactor) await(c Commitment, peer p2p.ID) waiter {
+	w := waiter{peer: peer, done: make(chan struct{}, 1)}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.waiters[c] = append(r.waiters[c], w)
+	return w
+}
+
+// awaited returns true if a sidecar with the given commitment was requested.
+func (r *Reactor) awaited(c Commitment) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.waiters[c]) > 0
+}
+
+// release unregisters the given waiters.
+func (r *Reactor) release(commitments []Commitment, waiters []waiter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, c := range commitments {
+		r.waiters[c] = slices.DeleteFunc(r.waiters[c], func(w waiter) bool {
+			return w.done == waiters[i].done
+		})
+		if len(r.waiters[c]) == 0 {
+			delete(r.waiters, c)
+		}
+	}
+}
+
+// notify signals the waiters for the sidecar with the given commitment once
+// it is pooled, or those that requested it from the given peer once it
+// answered it does not have it.
+func (r *Reactor) notify(c Commitment, peer p2p.ID, pooled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, w := range r.waiters[c] {
+		if !pooled && w.peer != peer {
+			continue
+		}
+		select {
+		case w.done <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,318 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gossip
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// sidecarSize is the size of the sidecars of the test codec.
+const sidecarSize = CommitmentSize + 16
+
+// nopLogger discards every log.
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Warn(string, ...any)  {}
+
+// testCodec encodes a sidecar as its commitment followed by its blob, and
+// bundles sidecars by concatenating them.
+type testCodec struct{}
+
+func (testCodec) Split(bundle []byte) ([][]byte, error) {
+	if len(bundle)%sidecarSize != 0 {
+		return nil, errors.New("invalid bundle")
+	}
+	var sidecars [][]byte
+	for ; len(bundle) > 0; bundle = bundle[sidecarSize:] {
+		sidecars = append(sidecars, bundle[:sidecarSize])
+	}
+	return sidecars, nil
+}
+
+func (testCodec) Join(sidecars [][]byte) ([]byte, error) {
+	return bytes.Join(sidecars, nil), nil
+}
+
+func (testCodec) Commitment(sidecar []byte) (Commitment, error) {
+	var c Commitment
+	if len(sidecar) != sidecarSize {
+		return c, errors.New("invalid sidecar")
+	}
+	copy(c[:], sidecar)
+	return c, nil
+}
+
+func (codec testCodec) Verify(c Commitment, sidecar []byte) error {
+	got, err := codec.Commitment(sidecar)
+	if err != nil {
+		return err
+	}
+	if got != c || sidecar[CommitmentSize] != c[0] {
+		return ErrCommitmentMismatch
+	}
+	return nil
+}
+
+// newSidecar returns a valid sidecar of the test codec.
+func newSidecar(seed byte) []byte {
+	sidecar := bytes.Repeat([]byte{seed}, sidecarSize)
+	sidecar[1] = 0xff
+	return sidecar
+}
+
+// slotSource is a source of the sidecars committed at each slot.
+type slotSource map[uint64][][]byte
+
+func (s slotSource) Sidecar(slot uint64, c Commitment) ([]byte, error) {
+	for _, sidecar := range s[slot] {
+		if bytes.HasPrefix(sidecar, c[:]) {
+			return sidecar, nil
+		}
+	}
+	return nil, ErrUnavailable
+}
+
+// newNetwork starts n switches gossiping through the given reactors, a nil
+// reactor leaving its switch without the gossip channel, and connects them
+// through connect. The switches are stopped once the test completes.
+func newNetwork(
+	t *testing.T,
+	reactors []*Reactor,
+	connect func([]*p2p.Switch, int, int),
+) {
+	t.Helper()
+	switches := p2p.MakeConnectedSwitches(
+		cmtcfg.DefaultP2PConfig(),
+		len(reactors),
+		func(i int, sw *p2p.Switch) *p2p.Switch {
+			if reactors[i] != nil {
+				sw.AddReactor(ReactorName, reactors[i])
+			}
+			return sw
+		},
+		connect,
+	)
+	for _, sw := range switches {
+		t.Cleanup(func() { _ = sw.Stop() })
+	}
+}
+
+// newReactors creates n enabled reactors without sources.
+func newReactors(n int) []*Reactor {
+	reactors := make([]*Reactor, n)
+	for i := range reactors {
+		cfg := DefaultConfig()
+		cfg.Enabled = true
+		cfg.FetchTimeout = time.Second
+		reactors[i] = New(cfg, nopLogger{}, testCodec{}, nil)
+	}
+	return reactors
+}
+
+// connectChain connects the switches in a line.
+func connectChain(switches []*p2p.Switch, i, j int) {
+	if j == i+1 {
+		p2p.Connect2Switches(switches, i, j)
+	}
+}
+
+// eventually fails the test if cond does not hold within a second.
+func eventually(t *testing.T, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); !cond(); {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestAnnouncement_Codec(t *testing.T) {
+	a := Announcement{Commitments: []Commitment{{1}, {2}}}
+	var decoded Announcement
+	if err := decoded.Unmarshal(a.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Commitments) != 2 ||
+		decoded.Commitments[0] != a.Commitments[0] ||
+		decoded.Commitments[1] != a.Commitments[1] {
+		t.Fatalf("got %v, want %v", decoded, a)
+	}
+
+	// An SSZ encoding starts with the offset of its first field.
+	ssz := append([]byte{0x04, 0x00, 0x00, 0x00}, make([]byte, 96)...)
+	if IsAnnouncement(ssz) {
+		t.Fatal("SSZ encoding mistaken for an announcement")
+	}
+	if IsAnnouncement(a.Marshal()[:CommitmentSize]) {
+		t.Fatal("truncated announcement accepted")
+	}
+}
+
+func TestReactor_AnnounceAndFetch(t *testing.T) {
+	reactors := newReactors(3)
+	newNetwork(t, reactors, p2p.Connect2Switches)
+	eventually(t, reactors[0].Enabled)
+
+	bundle := append(newSidecar(1), newSidecar(2)...)
+	bz, err := reactors[0].Announce(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := reactors[1].Fetch(context.Background(), 7, bz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, bundle) {
+		t.Fatal("fetched sidecars do not match the announced ones")
+	}
+
+	// The other peer prefetches the announced sidecars.
+	eventually(t, func() bool {
+		got, err = reactors[2].Lookup(7, bz)
+		return err == nil && bytes.Equal(got, bundle)
+	})
+}
+
+func TestReactor_RelaysAnnouncements(t *testing.T) {
+	reactors := newReactors(3)
+	newNetwork(t, reactors, connectChain)
+	eventually(t, reactors[1].Enabled)
+
+	bundle := newSidecar(1)
+	bz, err := reactors[0].Announce(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last node is only connected to the middle one, which relays the
+	// announcement once it fetched the sidecars.
+	eventually(t, func() bool {
+		got, lErr := reactors[2].Lookup(7, bz)
+		return lErr == nil && bytes.Equal(got, bundle)
+	})
+}
+
+func TestReactor_FetchFromAnyPeer(t *testing.T) {
+	reactors := newReactors(3)
+	newNetwork(t, reactors, p2p.Connect2Switches)
+	eventually(t, reactors[2].Enabled)
+
+	// Only one of the peers has the sidecars, which were never announced,
+	// and they are fetched whichever peer is asked first.
+	for seed := range byte(3) {
+		sidecar := newSidecar(seed)
+		c, _ := testCodec{}.Commitment(sidecar)
+		reactors[1].pool.add(c, sidecar)
+		bz := Announcement{Commitments: []Commitment{c}}.Marshal()
+
+		got, err := reactors[2].Fetch(context.Background(), 7, bz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, sidecar) {
+			t.Fatal("fetched sidecar does not match")
+		}
+	}
+}
+
+func TestReactor_RejectsInvalidSidecars(t *testing.T) {
+	reactors := newReactors(2)
+	newNetwork(t, reactors, p2p.Connect2Switches)
+	eventually(t, reactors[1].Enabled)
+
+	// The peer serves a sidecar that does not carry the blob committed to.
+	sidecar := newSidecar(1)
+	c, _ := testCodec{}.Commitment(sidecar)
+	sidecar[CommitmentSize] = 0
+	reactors[0].pool.add(c, sidecar)
+	bz := Announcement{Commitments: []Commitment{c}}.Marshal()
+
+	_, err := reactors[1].Fetch(context.Background(), 7, bz)
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("got %v, want %v", err, ErrUnavailable)
+	}
+	if _, err = reactors[1].Lookup(7, bz); !errors.Is(err, ErrUnavailable) {
+		t.Fatal("invalid sidecar was pooled")
+	}
+}
+
+func TestReactor_FetchFromSource(t *testing.T) {
+	reactors := newReactors(2)
+	source := slotSource{}
+	reactors[0] = New(
+		reactors[0].cfg, nopLogger{}, testCodec{}, source,
+	)
+	newNetwork(t, reactors, p2p.Connect2Switches)
+	eventually(t, reactors[1].Enabled)
+
+	// The sidecar is no longer pooled by its peer, but was committed.
+	sidecar := newSidecar(1)
+	c, _ := testCodec{}.Commitment(sidecar)
+	source[7] = [][]byte{sidecar}
+	bz := Announcement{Commitments: []Commitment{c}}.Marshal()
+
+	got, err := reactors[1].Fetch(context.Background(), 7, bz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, sidecar) {
+		t.Fatal("fetched sidecar does not match the committed one")
+	}
+
+	// Without the slot, the source cannot be used.
+	sidecar = newSidecar(2)
+	c, _ = testCodec{}.Commitment(sidecar)
+	source[8] = [][]byte{sidecar}
+	bz = Announcement{Commitments: []Commitment{c}}.Marshal()
+	if _, err = reactors[1].Fetch(context.Background(), 0, bz); err == nil {
+		t.Fatal("expected the sidecar to be unavailable without a slot")
+	}
+}
+
+func TestReactor_Enabled(t *testing.T) {
+	// A peer without the gossip channel cannot fetch announced sidecars.
+	reactors := newReactors(3)
+	reactors[2] = nil
+	newNetwork(t, reactors, p2p.Connect2Switches)
+	eventually(t, func() bool { return reactors[1].Switch.Peers().Size() == 2 })
+	if reactors[0].Enabled() || reactors[1].Enabled() {
+		t.Fatal("enabled despite a peer without the gossip channel")
+	}
+
+	// Nor is a disabled reactor, even when every peer could fetch.
+	reactors = newReactors(2)
+	reactors[1] = New(DefaultConfig(), nopLogger{}, testCodec{}, nil)
+	newNetwork(t, reactors, p2p.Connect2Switches)
+	eventually(t, reactors[0].Enabled)
+	if reactors[1].Enabled() {
+		t.Fatal("disabled reactor enabled")
+	}
+}
@@ -23,6 +23,7 @@ package attributes
 import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -36,9 +37,11 @@ type Factory[
 	chainSpec common.ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger[any]
-	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	// feeRecipients provides the suggested fee recipient sent to the
+	// execution client for the payload build.
+	feeRecipients FeeRecipientProvider
+	// pubkey is the public key of the validator payloads are built for.
+	pubkey crypto.BLSPubkey
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -49,15 +52,23 @@ func NewAttributesFactory[
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
-	suggestedFeeRecipient common.ExecutionAddress,
+	feeRecipients FeeRecipientProvider,
+	pubkey crypto.BLSPubkey,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
 	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:     chainSpec,
+		logger:        logger,
+		feeRecipients: feeRecipients,
+		pubkey:        pubkey,
 	}
 }
 
+// SuggestedFeeRecipient returns the fee recipient currently suggested for
+// the payloads of the validator.
+func (f *Factory[_, _, _]) SuggestedFeeRecipient() common.ExecutionAddress {
+	return f.feeRecipients.FeeRecipient(f.pubkey)
+}
+
 // CreateAttributes creates a new instance of PayloadAttributes.
 func (f *Factory[
 	BeaconStateT,
@@ -97,7 +108,7 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.SuggestedFeeRecipient(),
 		withdrawals,
 		prevHeadRoot,
 	)
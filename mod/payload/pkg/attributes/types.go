@@ -23,6 +23,7 @@ package attributes
 import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 // BeaconState is an interface for accessing the beacon state.
@@ -33,6 +34,13 @@ type BeaconState[WithdrawalT any] interface {
 	GetRandaoMixAtIndex(index uint64) (common.Bytes32, error)
 }
 
+// FeeRecipientProvider is the interface for the provider of the fee
+// recipients of the validators.
+type FeeRecipientProvider interface {
+	// FeeRecipient returns the fee recipient of the given validator.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
+
 // PayloadAttributes is the interface for the payload attributes.
 type PayloadAttributes[SelfT any, WithdrawalT any] interface {
 	engineprimitives.PayloadAttributer
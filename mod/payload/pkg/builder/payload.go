@@ -208,55 +208,14 @@ func (pb *PayloadBuilder[
 
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	feeRecipient := pb.attributesFactory.SuggestedFeeRecipient()
+	if payload.GetFeeRecipient() != feeRecipient {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", feeRecipient,
 		)
 	}
 	return envelope, err
 }
-
-// SendForceHeadFCU builds a payload for the given slot and
-// returns the payload ID.
-//
-// TODO: This should be moved onto a "sync service"
-// of some kind.
-func (pb *PayloadBuilder[
-	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	PayloadAttributesT, PayloadIDT, WithdrawalT,
-]) SendForceHeadFCU(
-	ctx context.Context,
-	st BeaconStateT,
-	slot math.Slot,
-) error {
-	lph, err := st.GetLatestExecutionPayloadHeader()
-	if err != nil {
-		return err
-	}
-
-	pb.logger.Info(
-		"Sending startup forkchoice update to execution client",
-		"head_eth1_hash", lph.GetBlockHash(),
-		"safe_eth1_hash", lph.GetParentHash(),
-		"finalized_eth1_hash", lph.GetParentHash(),
-		"for_slot", slot.Base10(),
-	)
-
-	// Submit the forkchoice update to the execution client.
-	var attrs PayloadAttributesT
-	_, _, err = pb.ee.NotifyForkchoiceUpdate(
-		ctx, &engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT]{
-			State: &engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
-				SafeBlockHash:      lph.GetParentHash(),
-				FinalizedBlockHash: lph.GetParentHash(),
-			},
-			PayloadAttributes: attrs,
-			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
-		},
-	)
-	return err
-}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import "time"
+
+const (
+	// defaultReloadInterval is the default interval at which the mapping
+	// file is checked for changes.
+	defaultReloadInterval = 10 * time.Second
+	// defaultAPIListenAddress is the default address the fee recipient API
+	// is served at.
+	defaultAPIListenAddress = "127.0.0.1:7500"
+)
+
+// Config is the configuration for the fee recipients of the validators.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// File is the path to a JSON or TOML file mapping validator public keys
+	// to fee recipients. Validators that are not in the file use the
+	// suggested fee recipient of the payload builder.
+	File string `mapstructure:"file"`
+	// ReloadInterval is the interval at which the file is checked for
+	// changes.
+	ReloadInterval time.Duration `mapstructure:"reload-interval"`
+	// APIEnabled determines if the fee recipient API is served.
+	APIEnabled bool `mapstructure:"api-enabled"`
+	// APIListenAddress is the address the fee recipient API is served at.
+	APIListenAddress string `mapstructure:"api-listen-address"`
+	// APITokenPath is the path to the bearer token that authorizes requests
+	// to the fee recipient API. It is required if the API is enabled.
+	APITokenPath string `mapstructure:"api-token-path"`
+	// APIStoreFile is the path to the JSON file the fee recipients set
+	// through the fee recipient API are persisted to. It defaults to a file
+	// in the data directory of the node.
+	APIStoreFile string `mapstructure:"api-store-file"`
+}
+
+// DefaultConfig returns the default fee recipient configuration.
+func DefaultConfig() Config {
+	return Config{
+		File:             "",
+		ReloadInterval:   defaultReloadInterval,
+		APIEnabled:       false,
+		APIListenAddress: defaultAPIListenAddress,
+		APITokenPath:     "",
+		APIStoreFile:     "",
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnsupportedFileFormat is returned when the mapping file is neither
+	// a JSON nor a TOML file.
+	ErrUnsupportedFileFormat = errors.New(
+		"fee recipient file must be a .json or .toml file",
+	)
+
+	// ErrMissingAPIToken is returned when the fee recipient API is enabled
+	// without a token.
+	ErrMissingAPIToken = errors.New(
+		"fee recipient API requires an api-token-path",
+	)
+
+	// ErrAlreadyStarted is returned when the server is started twice.
+	ErrAlreadyStarted = errors.New("fee recipient server already started")
+)
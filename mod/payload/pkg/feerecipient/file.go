@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// File provides the fee recipients mapped to the validators in a JSON or
+// TOML file, which maps hex encoded public keys to fee recipients:
+//
+//	{"0x93247f...": "0x20f33c..."}
+//
+// The file is checked for changes at most once per reload interval, when a
+// fee recipient is requested. A file that fails to load leaves the
+// previously loaded mapping in place.
+type File struct {
+	// path is the path to the mapping file.
+	path string
+	// reloadInterval is the interval at which the file is checked for
+	// changes.
+	reloadInterval time.Duration
+	// fallback provides the fee recipients of unmapped validators.
+	fallback Provider
+	// logger is used to report reloads of the file.
+	logger log.Logger[any]
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// recipients holds the loaded mapping.
+	recipients map[crypto.BLSPubkey]common.ExecutionAddress
+	// modTime is the modification time of the loaded file.
+	modTime time.Time
+	// checkedAt is the time the file was last checked for changes.
+	checkedAt time.Time
+}
+
+// NewFile creates a provider of the fee recipients mapped in the file at
+// the given path, which must load.
+func NewFile(
+	path string,
+	reloadInterval time.Duration,
+	fallback Provider,
+	logger log.Logger[any],
+) (*File, error) {
+	f := &File{
+		path:           path,
+		reloadInterval: reloadInterval,
+		fallback:       fallback,
+		logger:         logger,
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if f.recipients, err = loadFile(path); err != nil {
+		return nil, err
+	}
+	f.modTime = info.ModTime()
+	f.checkedAt = time.Now()
+	return f, nil
+}
+
+// FeeRecipient returns the fee recipient mapped to the given validator, or
+// the fee recipient of the fallback if the validator is not mapped.
+func (f *File) FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress {
+	f.mu.Lock()
+	f.reloadIfChanged()
+	recipient, ok := f.recipients[pubkey]
+	f.mu.Unlock()
+	if !ok {
+		return f.fallback.FeeRecipient(pubkey)
+	}
+	return recipient
+}
+
+// reloadIfChanged reloads the file if it changed since it was loaded and
+// the reload interval has passed.
+func (f *File) reloadIfChanged() {
+	if time.Since(f.checkedAt) < f.reloadInterval {
+		return
+	}
+	f.checkedAt = time.Now()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		f.logger.Error(
+			"Failed to check fee recipient file", "path", f.path, "error", err,
+		)
+		return
+	}
+	if info.ModTime().Equal(f.modTime) {
+		return
+	}
+
+	recipients, err := loadFile(f.path)
+	if err != nil {
+		f.logger.Error(
+			"Failed to reload fee recipient file", "path", f.path, "error", err,
+		)
+		return
+	}
+	f.recipients = recipients
+	f.modTime = info.ModTime()
+	f.logger.Info(
+		"Reloaded fee recipient file",
+		"path", f.path, "num_recipients", len(recipients),
+	)
+}
+
+// loadFile reads and decodes the mapping file at the given path.
+func loadFile(
+	path string,
+) (map[crypto.BLSPubkey]common.ExecutionAddress, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(bz, &raw)
+	case ".toml":
+		err = toml.Unmarshal(bz, &raw)
+	default:
+		return nil, ErrUnsupportedFileFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseRecipients(raw)
+}
+
+// parseRecipients decodes a mapping of hex encoded public keys to fee
+// recipients.
+func parseRecipients(
+	raw map[string]string,
+) (map[crypto.BLSPubkey]common.ExecutionAddress, error) {
+	recipients := make(
+		map[crypto.BLSPubkey]common.ExecutionAddress, len(raw),
+	)
+	for key, value := range raw {
+		var (
+			pubkey    crypto.BLSPubkey
+			recipient common.ExecutionAddress
+		)
+		if err := hex.DecodeFixedText([]byte(key), pubkey[:]); err != nil {
+			return nil, errors.Wrapf(err, "invalid pubkey %s", key)
+		}
+		if err := hex.DecodeFixedText([]byte(value), recipient[:]); err != nil {
+			return nil, errors.Wrapf(err, "invalid fee recipient %s", value)
+		}
+		recipients[pubkey] = recipient
+	}
+	return recipients, nil
+}
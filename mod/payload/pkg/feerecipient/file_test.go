@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pubkeyA = crypto.BLSPubkey{0xaa}
+	pubkeyB = crypto.BLSPubkey{0xbb}
+
+	defaultRecipient = common.ExecutionAddress{0x01}
+	recipientA       = common.ExecutionAddress{0x0a}
+	recipientB       = common.ExecutionAddress{0x0b}
+)
+
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestFile_JSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recipients.json")
+	writeFile(t, path, `{"`+pubkeyA.String()+`": "`+recipientA.Hex()+`"}`,
+		time.Now())
+
+	f, err := NewFile(
+		path, time.Hour, NewStatic(defaultRecipient), noop.NewLogger[any](),
+	)
+	require.NoError(t, err)
+	require.Equal(t, recipientA, f.FeeRecipient(pubkeyA))
+	require.Equal(t, defaultRecipient, f.FeeRecipient(pubkeyB))
+}
+
+func TestFile_TOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recipients.toml")
+	writeFile(t, path, `"`+pubkeyB.String()+`" = "`+recipientB.Hex()+`"`,
+		time.Now())
+
+	f, err := NewFile(
+		path, time.Hour, NewStatic(defaultRecipient), noop.NewLogger[any](),
+	)
+	require.NoError(t, err)
+	require.Equal(t, recipientB, f.FeeRecipient(pubkeyB))
+	require.Equal(t, defaultRecipient, f.FeeRecipient(pubkeyA))
+}
+
+func TestFile_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	logger := noop.NewLogger[any]()
+	fallback := NewStatic(defaultRecipient)
+
+	path := filepath.Join(dir, "recipients.yaml")
+	writeFile(t, path, "", time.Now())
+	_, err := NewFile(path, time.Hour, fallback, logger)
+	require.ErrorIs(t, err, ErrUnsupportedFileFormat)
+
+	path = filepath.Join(dir, "recipients.json")
+	writeFile(t, path, `{"0x01": "`+recipientA.Hex()+`"}`, time.Now())
+	_, err = NewFile(path, time.Hour, fallback, logger)
+	require.Error(t, err)
+
+	_, err = NewFile(filepath.Join(dir, "missing.json"), time.Hour,
+		fallback, logger)
+	require.Error(t, err)
+}
+
+func TestFile_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recipients.json")
+	loadedAt := time.Now().Add(-time.Minute)
+	writeFile(t, path, `{"`+pubkeyA.String()+`": "`+recipientA.Hex()+`"}`,
+		loadedAt)
+
+	f, err := NewFile(
+		path, 0, NewStatic(defaultRecipient), noop.NewLogger[any](),
+	)
+	require.NoError(t, err)
+	require.Equal(t, recipientA, f.FeeRecipient(pubkeyA))
+
+	// A file that fails to load keeps the previous mapping.
+	writeFile(t, path, `{`, loadedAt.Add(time.Second))
+	require.Equal(t, recipientA, f.FeeRecipient(pubkeyA))
+
+	writeFile(t, path, `{"`+pubkeyA.String()+`": "`+recipientB.Hex()+`"}`,
+		loadedAt.Add(2*time.Second))
+	require.Equal(t, recipientB, f.FeeRecipient(pubkeyA))
+}
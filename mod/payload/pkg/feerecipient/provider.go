@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package feerecipient provides the fee recipients of the payloads built
+// for the validators of this node.
+package feerecipient
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// Provider provides the fee recipient of the payloads proposed by a
+// validator.
+type Provider interface {
+	// FeeRecipient returns the fee recipient of the given validator.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
+
+// Static provides the same fee recipient for every validator.
+type Static struct {
+	address common.ExecutionAddress
+}
+
+// NewStatic creates a provider of the given fee recipient.
+func NewStatic(address common.ExecutionAddress) *Static {
+	return &Static{address: address}
+}
+
+// FeeRecipient returns the fee recipient of every validator.
+func (s *Static) FeeRecipient(crypto.BLSPubkey) common.ExecutionAddress {
+	return s.address
+}
@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"maps"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+)
+
+const (
+	// feeRecipientPath is the path of the fee recipient API, around the
+	// public key of the validator, as defined by the keymanager API.
+	feeRecipientPath = "/eth/v1/validator/{pubkey}/feerecipient"
+	// readHeaderTimeout is the time allowed to read the request headers.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout is how long stopping the server waits for in-flight
+	// requests to complete.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server provides the fee recipients set through the fee recipient API of
+// the keymanager API, and those of the fallback for the validators whose
+// fee recipient is not set. Fee recipients set through the API are
+// persisted to the store file, and loaded from it when the API is started.
+type Server struct {
+	cfg      Config
+	logger   log.Logger[any]
+	fallback Provider
+	// wg tracks the goroutine serving the API.
+	wg sync.WaitGroup
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// recipients holds the fee recipients set through the API.
+	recipients map[crypto.BLSPubkey]common.ExecutionAddress
+	// token is the bearer token that authorizes requests.
+	token []byte
+	// srv is the server the API is served by, once started.
+	srv *http.Server
+}
+
+// NewServer creates a new fee recipient server.
+func NewServer(cfg Config, logger log.Logger[any], fallback Provider) *Server {
+	return &Server{
+		cfg:        cfg,
+		logger:     logger,
+		fallback:   fallback,
+		recipients: make(map[crypto.BLSPubkey]common.ExecutionAddress),
+	}
+}
+
+// Name returns the name of the fee recipient server.
+func (s *Server) Name() string {
+	return "fee-recipient-api"
+}
+
+// Start serves the fee recipient API until the server is stopped, if it is
+// enabled.
+func (s *Server) Start(context.Context) error {
+	if !s.cfg.APIEnabled {
+		return nil
+	}
+	if s.cfg.APITokenPath == "" {
+		return ErrMissingAPIToken
+	}
+	token, err := os.ReadFile(s.cfg.APITokenPath)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.srv != nil {
+		return ErrAlreadyStarted
+	}
+	s.token = bytes.TrimSpace(token)
+	if s.recipients, err = loadStore(s.cfg.APIStoreFile); err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", s.cfg.APIListenAddress)
+	if err != nil {
+		return err
+	}
+
+	s.srv = &http.Server{
+		Handler:           s.handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	s.wg.Add(1)
+	go func(srv *http.Server) {
+		defer s.wg.Done()
+		if serveErr := srv.Serve(listener); serveErr != nil &&
+			!errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("Fee recipient API stopped", "error", serveErr)
+		}
+	}(s.srv)
+	s.logger.Info(
+		"Serving fee recipient API", "address", listener.Addr().String(),
+	)
+	return nil
+}
+
+// Stop gracefully shuts down the fee recipient API.
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	srv := s.srv
+	s.srv = nil
+	s.mu.Unlock()
+	if srv == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := srv.Shutdown(ctx)
+	s.wg.Wait()
+	return err
+}
+
+// FeeRecipient returns the fee recipient set for the given validator, or
+// the fee recipient of the fallback if none is set.
+func (s *Server) FeeRecipient(
+	pubkey crypto.BLSPubkey,
+) common.ExecutionAddress {
+	s.mu.RLock()
+	recipient, ok := s.recipients[pubkey]
+	s.mu.RUnlock()
+	if !ok {
+		return s.fallback.FeeRecipient(pubkey)
+	}
+	return recipient
+}
+
+// handler returns the HTTP handler serving the fee recipient API.
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+feeRecipientPath, s.authorized(s.handleGet))
+	mux.HandleFunc("POST "+feeRecipientPath, s.authorized(s.handleSet))
+	mux.HandleFunc("DELETE "+feeRecipientPath, s.authorized(s.handleDelete))
+	return mux
+}
+
+// authorized rejects requests without the bearer token.
+func (s *Server) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.RLock()
+		want := append([]byte("Bearer "), s.token...)
+		empty := len(s.token) == 0
+		s.mu.RUnlock()
+
+		got := []byte(r.Header.Get("Authorization"))
+		if empty || subtle.ConstantTimeCompare(got, want) != 1 {
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		next(w, r)
+	}
+}
+
+// feeRecipientData is the fee recipient of a validator, as served by the
+// API.
+type feeRecipientData struct {
+	Pubkey     crypto.BLSPubkey        `json:"pubkey"`
+	EthAddress common.ExecutionAddress `json:"ethaddress"`
+}
+
+// handleGet serves the fee recipient of a validator.
+func (s *Server) handleGet(w http.ResponseWriter, r *http.Request) {
+	pubkey, ok := parsePubkey(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data": &feeRecipientData{
+			Pubkey:     pubkey,
+			EthAddress: s.FeeRecipient(pubkey),
+		},
+	})
+}
+
+// handleSet sets the fee recipient of a validator.
+func (s *Server) handleSet(w http.ResponseWriter, r *http.Request) {
+	pubkey, ok := parsePubkey(w, r)
+	if !ok {
+		return
+	}
+	var req struct {
+		EthAddress string `json:"ethaddress"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var recipient common.ExecutionAddress
+	if err := hex.DecodeFixedText(
+		[]byte(req.EthAddress), recipient[:],
+	); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid ethaddress")
+		return
+	}
+
+	if err := s.update(func(
+		recipients map[crypto.BLSPubkey]common.ExecutionAddress,
+	) {
+		recipients[pubkey] = recipient
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.logger.Info(
+		"Set fee recipient", "pubkey", pubkey, "fee_recipient", recipient,
+	)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleDelete removes the fee recipient set for a validator, which falls
+// back to the fee recipient of the fallback.
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	pubkey, ok := parsePubkey(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.update(func(
+		recipients map[crypto.BLSPubkey]common.ExecutionAddress,
+	) {
+		delete(recipients, pubkey)
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.logger.Info("Removed fee recipient", "pubkey", pubkey)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// update applies fn to a copy of the fee recipients set through the API,
+// which replaces them once it is persisted to the store file.
+func (s *Server) update(
+	fn func(map[crypto.BLSPubkey]common.ExecutionAddress),
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	recipients := maps.Clone(s.recipients)
+	fn(recipients)
+	if err := saveStore(s.cfg.APIStoreFile, recipients); err != nil {
+		return err
+	}
+	s.recipients = recipients
+	return nil
+}
+
+// loadStore reads the fee recipients persisted to the store file at the
+// given path, if it exists.
+func loadStore(
+	path string,
+) (map[crypto.BLSPubkey]common.ExecutionAddress, error) {
+	if path == "" {
+		return make(map[crypto.BLSPubkey]common.ExecutionAddress), nil
+	}
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return make(map[crypto.BLSPubkey]common.ExecutionAddress), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]string
+	if err = json.Unmarshal(bz, &raw); err != nil {
+		return nil, err
+	}
+	return parseRecipients(raw)
+}
+
+// saveStore persists the fee recipients to the store file at the given
+// path, replacing it atomically so that a failed write leaves the
+// previously persisted fee recipients in place.
+func saveStore(
+	path string,
+	recipients map[crypto.BLSPubkey]common.ExecutionAddress,
+) error {
+	if path == "" {
+		return nil
+	}
+	raw := make(map[string]string, len(recipients))
+	for pubkey, recipient := range recipients {
+		raw[pubkey.String()] = recipient.Hex()
+	}
+	bz, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// parsePubkey parses the public key of the request path, responding with
+// an error if it is invalid.
+func parsePubkey(
+	w http.ResponseWriter, r *http.Request,
+) (crypto.BLSPubkey, bool) {
+	var pubkey crypto.BLSPubkey
+	if err := hex.DecodeFixedText(
+		[]byte(r.PathValue("pubkey")), pubkey[:],
+	); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid pubkey")
+		return pubkey, false
+	}
+	return pubkey, true
+}
+
+// writeJSON writes v as the JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response as defined by the keymanager API.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"code":    status,
+		"message": message,
+	})
+}
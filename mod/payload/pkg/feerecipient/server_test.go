@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feerecipient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*Server, *httptest.Server) {
+	t.Helper()
+	return newTestServerWith(t, DefaultConfig())
+}
+
+func newTestServerWith(
+	t *testing.T, cfg Config,
+) (*Server, *httptest.Server) {
+	t.Helper()
+	s := NewServer(cfg, noop.NewLogger[any](), NewStatic(defaultRecipient))
+	s.token = []byte("secret")
+	srv := httptest.NewServer(s.handler())
+	t.Cleanup(srv.Close)
+	return s, srv
+}
+
+func request(
+	t *testing.T, method, url, token, body string,
+) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	bz, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(bz)
+}
+
+func TestServer_SetAndDelete(t *testing.T) {
+	s, srv := newTestServer(t)
+	url := srv.URL + "/eth/v1/validator/" + pubkeyA.String() + "/feerecipient"
+
+	resp, body := request(t, http.MethodGet, url, "secret", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, body, defaultRecipient.Hex())
+
+	resp, _ = request(t, http.MethodPost, url, "secret",
+		`{"ethaddress": "`+recipientA.Hex()+`"}`)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Equal(t, recipientA, s.FeeRecipient(pubkeyA))
+	require.Equal(t, defaultRecipient, s.FeeRecipient(pubkeyB))
+
+	resp, body = request(t, http.MethodGet, url, "secret", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, body, recipientA.Hex())
+
+	resp, _ = request(t, http.MethodDelete, url, "secret", "")
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Equal(t, defaultRecipient, s.FeeRecipient(pubkeyA))
+}
+
+func TestServer_Rejects(t *testing.T) {
+	s, srv := newTestServer(t)
+	url := srv.URL + "/eth/v1/validator/" + pubkeyA.String() + "/feerecipient"
+	body := `{"ethaddress": "` + recipientA.Hex() + `"}`
+
+	resp, _ := request(t, http.MethodPost, url, "", body)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp, _ = request(t, http.MethodPost, url, "wrong", body)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = request(t, http.MethodPost, url, "secret",
+		`{"ethaddress": "0x01"}`)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = request(t, http.MethodPost,
+		srv.URL+"/eth/v1/validator/0x01/feerecipient", "secret", body)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	require.Equal(t, defaultRecipient, s.FeeRecipient(pubkeyA))
+}
+
+func TestServer_StartRequiresToken(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.APIEnabled = true
+	s := NewServer(cfg, noop.NewLogger[any](), NewStatic(defaultRecipient))
+	require.ErrorIs(t, s.Start(context.Background()), ErrMissingAPIToken)
+}
+
+func TestServer_StartAndStop(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.APIEnabled = true
+	cfg.APIListenAddress = "127.0.0.1:0"
+	cfg.APITokenPath = filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(cfg.APITokenPath, []byte("secret"), 0o600))
+
+	s := NewServer(cfg, noop.NewLogger[any](), NewStatic(defaultRecipient))
+	require.NoError(t, s.Start(context.Background()))
+	require.ErrorIs(t, s.Start(context.Background()), ErrAlreadyStarted)
+	require.NoError(t, s.Stop())
+	// Stopping a stopped server is a no-op, and it can be started again.
+	require.NoError(t, s.Stop())
+	require.NoError(t, s.Start(context.Background()))
+	require.NoError(t, s.Stop())
+}
+
+func TestServer_Persists(t *testing.T) {
+	dir := t.TempDir()
+	cfg := DefaultConfig()
+	cfg.APIEnabled = true
+	cfg.APIListenAddress = "127.0.0.1:0"
+	cfg.APITokenPath = filepath.Join(dir, "token")
+	cfg.APIStoreFile = filepath.Join(dir, "data", "fee_recipients.json")
+	require.NoError(t, os.WriteFile(cfg.APITokenPath, []byte("secret"), 0o600))
+
+	_, srv := newTestServerWith(t, cfg)
+	pathOf := func(pubkey string) string {
+		return srv.URL + "/eth/v1/validator/" + pubkey + "/feerecipient"
+	}
+	resp, _ := request(t, http.MethodPost, pathOf(pubkeyA.String()), "secret",
+		`{"ethaddress": "`+recipientA.Hex()+`"}`)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	resp, _ = request(t, http.MethodPost, pathOf(pubkeyB.String()), "secret",
+		`{"ethaddress": "`+recipientA.Hex()+`"}`)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	resp, _ = request(t, http.MethodDelete, pathOf(pubkeyB.String()), "secret",
+		"")
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// A restarted server serves the fee recipients persisted before.
+	s := NewServer(cfg, noop.NewLogger[any](), NewStatic(defaultRecipient))
+	require.Equal(t, defaultRecipient, s.FeeRecipient(pubkeyA))
+	require.NoError(t, s.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, s.Stop()) })
+	require.Equal(t, recipientA, s.FeeRecipient(pubkeyA))
+	require.Equal(t, defaultRecipient, s.FeeRecipient(pubkeyB))
+}
+
+func TestServer_StartInvalidStore(t *testing.T) {
+	dir := t.TempDir()
+	cfg := DefaultConfig()
+	cfg.APIEnabled = true
+	cfg.APIListenAddress = "127.0.0.1:0"
+	cfg.APITokenPath = filepath.Join(dir, "token")
+	cfg.APIStoreFile = filepath.Join(dir, "fee_recipients.json")
+	require.NoError(t, os.WriteFile(cfg.APITokenPath, []byte("secret"), 0o600))
+	require.NoError(t, os.WriteFile(
+		cfg.APIStoreFile, []byte(`{"0x01": "0x02"}`), 0o600,
+	))
+
+	s := NewServer(cfg, noop.NewLogger[any](), NewStatic(defaultRecipient))
+	require.Error(t, s.Start(context.Background()))
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package mockrelay provides an in-process stand-in for a builder relay,
+// used to exercise the relay flow in tests.
+package mockrelay
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Bid is the bid served by the relay.
+type Bid struct {
+	// Header is the payload header offered, encoded as JSON.
+	Header any
+	// Value is the amount, in Wei, offered to the proposer.
+	Value *math.U256
+	// Pubkey is the public key of the builder of the bid.
+	Pubkey crypto.BLSPubkey
+	// Signature is the signature of the builder over the bid.
+	Signature crypto.BLSSignature
+	// Envelope is the payload envelope revealed once the bid is accepted,
+	// encoded as JSON.
+	Envelope any
+}
+
+// Relay serves the builder API with a configurable bid, and records the
+// registrations and accepted bids it receives.
+type Relay struct {
+	mu sync.Mutex
+	// bid is the bid served, or nil if the relay has no bid.
+	bid *Bid
+	// delay is the time the relay waits before serving a bid.
+	delay time.Duration
+	// registrations holds the validator registrations received.
+	registrations []*relay.SignedValidatorRegistration
+	// submissions holds the accepted bids received.
+	submissions []*relay.SignedBlindedPayloadRequest
+}
+
+// New creates a new mock relay without a bid.
+func New() *Relay {
+	return &Relay{}
+}
+
+// SetBid sets the bid served by the relay. A nil bid makes the relay
+// respond without content.
+func (r *Relay) SetBid(bid *Bid) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bid = bid
+}
+
+// SetDelay sets the time the relay waits before serving a bid.
+func (r *Relay) SetDelay(delay time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.delay = delay
+}
+
+// Registrations returns the validator registrations received.
+func (r *Relay) Registrations() []*relay.SignedValidatorRegistration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append(
+		[]*relay.SignedValidatorRegistration(nil), r.registrations...,
+	)
+}
+
+// Submissions returns the accepted bids received.
+func (r *Relay) Submissions() []*relay.SignedBlindedPayloadRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append(
+		[]*relay.SignedBlindedPayloadRequest(nil), r.submissions...,
+	)
+}
+
+// Handler returns the HTTP handler serving the builder API.
+func (r *Relay) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"POST "+relay.RegisterValidatorsPath, r.handleRegisterValidators,
+	)
+	mux.HandleFunc(
+		"GET "+relay.GetHeaderPath+"{slot}/{parent_hash}/{pubkey}",
+		r.handleGetHeader,
+	)
+	mux.HandleFunc(
+		"POST "+relay.SubmitBlindedPayloadPath, r.handleSubmitBlindedPayload,
+	)
+	mux.HandleFunc("GET "+relay.StatusPath, r.handleStatus)
+	return mux
+}
+
+// handleStatus reports the relay as available.
+func (*Relay) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleRegisterValidators records the validator registrations.
+func (r *Relay) handleRegisterValidators(
+	w http.ResponseWriter, req *http.Request,
+) {
+	var registrations []*relay.SignedValidatorRegistration
+	if err := json.NewDecoder(req.Body).Decode(&registrations); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.registrations = append(r.registrations, registrations...)
+}
+
+// handleGetHeader serves the bid of the relay.
+func (r *Relay) handleGetHeader(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	bid, delay := r.bid, r.delay
+	r.mu.Unlock()
+
+	select {
+	case <-req.Context().Done():
+		return
+	case <-time.After(delay):
+	}
+	if bid == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	header, err := json.Marshal(bid.Header)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, &relay.GetHeaderResponse{
+		Data: &relay.SignedBuilderBid{
+			Message: &relay.BuilderBid{
+				Header: header,
+				Value:  bid.Value,
+				Pubkey: bid.Pubkey,
+			},
+			Signature: bid.Signature,
+		},
+	})
+}
+
+// handleSubmitBlindedPayload records the accepted bid and reveals the
+// payload of the bid.
+func (r *Relay) handleSubmitBlindedPayload(
+	w http.ResponseWriter, req *http.Request,
+) {
+	submission := new(relay.SignedBlindedPayloadRequest)
+	if err := json.NewDecoder(req.Body).Decode(submission); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	r.submissions = append(r.submissions, submission)
+	bid := r.bid
+	r.mu.Unlock()
+	if bid == nil {
+		http.Error(w, "no bid for the slot", http.StatusBadRequest)
+		return
+	}
+
+	envelope, err := json.Marshal(bid.Envelope)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, &relay.SubmitBlindedPayloadResponse{Data: envelope})
+}
+
+// writeJSON writes v as the JSON response.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
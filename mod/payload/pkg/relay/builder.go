@@ -0,0 +1,561 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// gasLimitBoundDivisor bounds the change of the gas limit between a payload
+// and its parent to the parent gas limit divided by it.
+const gasLimitBoundDivisor = 1024
+
+// Builder sources execution payloads from external builders through the
+// relays of the builder API. Bids must be signed by their builder and
+// commit to the attributes of the proposal before they are accepted, and
+// the revealed payload is checked against the header of the bid.
+type Builder[
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// cfg holds the configuration of the relays.
+	cfg *Config
+	// chainSpec is used to find the fork version of a slot.
+	chainSpec ChainSpec
+	// logger is used for logging within the Builder.
+	logger log.Logger[any]
+	// signer signs the registrations and accepted bids of this node.
+	signer crypto.BLSSigner
+	// domain is the builder domain messages are signed with.
+	domain common.Domain
+	// feeRecipients provides the address registered with the relays.
+	feeRecipients FeeRecipientProvider
+	// attributesFactory builds the attributes the bids must commit to.
+	attributesFactory AttributesFactory[BeaconStateT]
+	// clients holds a client for each of the relays.
+	clients []*client
+}
+
+// New creates a new relay builder.
+func New[
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+](
+	cfg *Config,
+	chainSpec ChainSpec,
+	logger log.Logger[any],
+	signer crypto.BLSSigner,
+	domain common.Domain,
+	feeRecipients FeeRecipientProvider,
+	attributesFactory AttributesFactory[BeaconStateT],
+) (*Builder[BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT], error) {
+	httpClient := &http.Client{}
+	clients := make([]*client, 0, len(cfg.URLs))
+	for _, url := range cfg.URLs {
+		c, err := newClient(url, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	return &Builder[BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT]{
+		cfg:               cfg,
+		chainSpec:         chainSpec,
+		logger:            logger,
+		signer:            signer,
+		domain:            domain,
+		feeRecipients:     feeRecipients,
+		attributesFactory: attributesFactory,
+		clients:           clients,
+	}, nil
+}
+
+// Name returns the name of the relay builder service.
+func (b *Builder[_, _, _]) Name() string {
+	return "relay"
+}
+
+// Enabled returns true if payloads are sourced from the relays.
+func (b *Builder[_, _, _]) Enabled() bool {
+	return b.cfg.Enabled && len(b.clients) > 0
+}
+
+// Start registers this node with the relays until ctx is done.
+func (b *Builder[_, _, _]) Start(ctx context.Context) error {
+	if !b.Enabled() {
+		return nil
+	}
+	go b.registrationLoop(ctx)
+	return nil
+}
+
+// registrationLoop registers this node with the relays once started and at
+// every registration interval thereafter.
+func (b *Builder[_, _, _]) registrationLoop(ctx context.Context) {
+	ticker := time.NewTicker(b.registrationInterval())
+	defer ticker.Stop()
+	for {
+		b.registerValidator(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// registrationInterval returns the interval at which the validator
+// registration is resubmitted to the relays.
+func (b *Builder[_, _, _]) registrationInterval() time.Duration {
+	if b.cfg.RegistrationInterval <= 0 {
+		return defaultRegistrationInterval
+	}
+	return b.cfg.RegistrationInterval
+}
+
+// registerValidator registers the current fee recipient and the gas limit
+// of this node with every relay.
+func (b *Builder[_, _, _]) registerValidator(ctx context.Context) {
+	pubkey := b.signer.PublicKey()
+	registration := &ValidatorRegistration{
+		FeeRecipient: b.feeRecipients.FeeRecipient(pubkey),
+		GasLimit:     b.cfg.GasLimit,
+		//#nosec:G701 // the unix time is never negative.
+		Timestamp: uint64(time.Now().Unix()),
+		Pubkey:    pubkey,
+	}
+	signature, err := b.sign(registration.HashTreeRoot())
+	if err != nil {
+		b.logger.Error("Failed to sign validator registration", "error", err)
+		return
+	}
+	registrations := []*SignedValidatorRegistration{{
+		Message:   registration,
+		Signature: signature,
+	}}
+
+	ctx, cancel := context.WithTimeout(ctx, b.cfg.Timeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, c := range b.clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err = c.registerValidators(ctx, registrations); err != nil {
+				b.logger.Warn(
+					"Failed to register validator with relay",
+					"relay", c.url, "error", err,
+				)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// GetPayload requests bids for the slot of the block proposed on top of
+// the given state from the relays, and returns the payload of the best bid
+// if it exceeds both the minimum bid and the value of the local payload.
+// Only bids signed by their builder that commit to the attributes of the
+// proposal are considered. The bid is accepted by committing to its
+// header, in exchange for which the relay reveals the payload, which has
+// to match the header and commit to the parent beacon block and blobs.
+func (b *Builder[BeaconStateT, ExecutionPayloadT, _]) GetPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	parentBlockRoot common.Root,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	if !b.Enabled() {
+		return nil, ErrRelaysDisabled
+	}
+	// The relay API does not reveal the execution requests of a payload,
+	// which Electra blocks have to carry.
+	if b.chainSpec.ActiveForkVersionForSlot(slot) >= version.Electra {
+		return nil, ErrUnsupportedForkVersion
+	}
+
+	p, err := b.newProposal(st, slot, parentBlockRoot, local)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, b.cfg.Timeout)
+	defer cancel()
+
+	best, err := b.bestBid(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	localValue := local.GetValue()
+	if best.bid.Value.Lt(math.Gwei(b.cfg.MinBid).ToWei()) ||
+		(localValue != nil && !best.bid.Value.Gt(localValue)) {
+		return nil, ErrBidTooLow
+	}
+
+	signature, err := b.sign(best.header.HashTreeRoot())
+	if err != nil {
+		return nil, err
+	}
+	data, err := best.client.submitBlindedPayload(
+		ctx, &SignedBlindedPayloadRequest{
+			Message: &BlindedPayloadRequest{
+				Slot:           slot.Unwrap(),
+				ParentHash:     p.parent.GetBlockHash(),
+				ProposerPubkey: b.signer.PublicKey(),
+				Header:         best.bid.Header,
+			},
+			Signature: signature,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := b.revealedPayload(p, best, data)
+	if err != nil {
+		return nil, err
+	}
+
+	b.logger.Info(
+		"Received payload from relay",
+		"slot", slot.Base10(),
+		"relay", best.client.url,
+		"block_hash", best.header.GetBlockHash(),
+		"value", best.bid.Value.Dec(),
+	)
+	return envelope, nil
+}
+
+// proposal is the proposal a payload is requested for, which the bids have
+// to commit to.
+type proposal[
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// slot is the slot of the proposal.
+	slot math.Slot
+	// forkVersion is the fork version active at the slot.
+	forkVersion uint32
+	// parentBlockRoot is the root of the parent beacon block.
+	parentBlockRoot common.Root
+	// parent is the header of the payload the bids must build on.
+	parent ExecutionPayloadHeaderT
+	// attributes are the attributes the local payload was built with.
+	attributes *engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal]
+	// withdrawalsRoot is the root of the expected withdrawals.
+	withdrawalsRoot common.Root
+	// gasLimit is the gas limit the bids must target.
+	gasLimit math.U64
+}
+
+// newProposal builds the proposal of the given slot on top of the given
+// state, with the attributes derived from the state at the timestamp of the
+// local payload.
+func (b *Builder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) newProposal(
+	st BeaconStateT,
+	slot math.Slot,
+	parentBlockRoot common.Root,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) (*proposal[ExecutionPayloadHeaderT], error) {
+	parent, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := b.attributesFactory.BuildPayloadAttributes(
+		st,
+		slot,
+		local.GetExecutionPayload().GetTimestamp().Unwrap(),
+		parentBlockRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proposal[ExecutionPayloadHeaderT]{
+		slot:            slot,
+		forkVersion:     b.chainSpec.ActiveForkVersionForSlot(slot),
+		parentBlockRoot: parentBlockRoot,
+		parent:          parent,
+		attributes:      attributes,
+		withdrawalsRoot: engineprimitives.Withdrawals(
+			attributes.Withdrawals,
+		).HashTreeRoot(),
+		gasLimit: math.U64(
+			expectedGasLimit(parent.GetGasLimit().Unwrap(), b.cfg.GasLimit),
+		),
+	}, nil
+}
+
+// relayBid is a bid together with its decoded header and the relay it was
+// received from.
+type relayBid[ExecutionPayloadHeaderT any] struct {
+	client *client
+	bid    *BuilderBid
+	header ExecutionPayloadHeaderT
+}
+
+// bestBid requests bids from all relays concurrently and returns the valid
+// bid with the highest value. Relays that fail, do not respond in time or
+// offer an invalid bid are skipped.
+func (b *Builder[_, _, ExecutionPayloadHeaderT]) bestBid(
+	ctx context.Context,
+	p *proposal[ExecutionPayloadHeaderT],
+) (*relayBid[ExecutionPayloadHeaderT], error) {
+	var (
+		wg   sync.WaitGroup
+		bids = make([]*relayBid[ExecutionPayloadHeaderT], len(b.clients))
+	)
+	for i, c := range b.clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bid, err := b.getBid(ctx, c, p)
+			if err != nil {
+				b.logger.Warn(
+					"Failed to get bid from relay",
+					"relay", c.url, "slot", p.slot.Base10(), "error", err,
+				)
+				return
+			}
+			bids[i] = bid
+		}()
+	}
+	wg.Wait()
+
+	var best *relayBid[ExecutionPayloadHeaderT]
+	for _, bid := range bids {
+		if bid != nil && (best == nil || bid.bid.Value.Gt(best.bid.Value)) {
+			best = bid
+		}
+	}
+	if best == nil {
+		return nil, ErrNoBid
+	}
+	return best, nil
+}
+
+// getBid requests, decodes and validates the bid of a single relay. It
+// returns nil if the relay has no bid.
+func (b *Builder[_, _, ExecutionPayloadHeaderT]) getBid(
+	ctx context.Context,
+	c *client,
+	p *proposal[ExecutionPayloadHeaderT],
+) (*relayBid[ExecutionPayloadHeaderT], error) {
+	signed, err := c.getHeader(
+		ctx, p.slot, p.parent.GetBlockHash(), b.signer.PublicKey(),
+	)
+	if err != nil || signed == nil {
+		return nil, err
+	}
+	bid := signed.Message
+	if bid.Value == nil {
+		return nil, ErrNoBid
+	}
+
+	var header ExecutionPayloadHeaderT
+	if header, err = header.NewFromJSON(
+		bid.Header, p.forkVersion,
+	); err != nil {
+		return nil, err
+	}
+	if err = b.verifyBid(c, signed, header.HashTreeRoot()); err != nil {
+		return nil, err
+	}
+	if err = p.validate(header, header.GetWithdrawalsRoot()); err != nil {
+		return nil, err
+	}
+	return &relayBid[ExecutionPayloadHeaderT]{
+		client: c,
+		bid:    bid,
+		header: header,
+	}, nil
+}
+
+// verifyBid verifies that the bid is signed by its builder, and that the
+// builder is the one the relay was configured with, if any.
+func (b *Builder[_, _, _]) verifyBid(
+	c *client, signed *SignedBuilderBid, headerRoot common.Root,
+) error {
+	bid := signed.Message
+	if c.pubkey != nil && *c.pubkey != bid.Pubkey {
+		return ErrBuilderPubkeyMismatch
+	}
+	signingRoot := (&signingData{
+		ObjectRoot: (&builderBidSigning{
+			HeaderRoot: headerRoot,
+			Value:      bid.Value,
+			Pubkey:     bid.Pubkey,
+		}).HashTreeRoot(),
+		Domain: b.domain,
+	}).HashTreeRoot()
+	if err := b.signer.VerifySignature(
+		bid.Pubkey, signingRoot[:], signed.Signature,
+	); err != nil {
+		return errors.Wrap(ErrInvalidBidSignature, err.Error())
+	}
+	return nil
+}
+
+// revealedPayload decodes the payload revealed by the relay for the
+// accepted bid, and verifies that it matches the header of the bid and
+// commits to the parent beacon block and the blobs of its bundle.
+func (b *Builder[
+	_, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) revealedPayload(
+	p *proposal[ExecutionPayloadHeaderT],
+	best *relayBid[ExecutionPayloadHeaderT],
+	data []byte,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var payload ExecutionPayloadT
+	envelope := &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{ExecutionPayload: payload.Empty(p.forkVersion)}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, err
+	}
+	payload = envelope.ExecutionPayload
+	if payload.IsNil() ||
+		payload.GetBlockHash() != best.header.GetBlockHash() {
+		return nil, ErrBlockHashMismatch
+	}
+	if err := p.validate(
+		payload, payload.GetWithdrawals().HashTreeRoot(),
+	); err != nil {
+		return nil, err
+	}
+
+	var commitments eip4844.KZGCommitments[common.ExecutionHash]
+	if envelope.BlobsBundle != nil {
+		commitments = envelope.BlobsBundle.GetCommitments()
+	}
+	if err := engineprimitives.BuildNewPayloadRequest(
+		payload,
+		commitments.ToVersionedHashes(),
+		&p.parentBlockRoot,
+		p.forkVersion,
+		false,
+	).HasValidVersionedAndBlockHashes(); err != nil {
+		return nil, err
+	}
+	envelope.BlockValue = best.bid.Value
+	return envelope, nil
+}
+
+// payloadFields are the fields of a payload or its header that commit to
+// the attributes of the proposal.
+type payloadFields interface {
+	GetParentHash() common.ExecutionHash
+	GetNumber() math.U64
+	GetTimestamp() math.U64
+	GetPrevRandao() common.Bytes32
+	GetFeeRecipient() common.ExecutionAddress
+	GetGasLimit() math.U64
+}
+
+// validate validates that the payload builds on the parent of the
+// proposal and commits to its attributes. The timestamp may precede
+// the one of the local payload, as the builders pick it on their own, but
+// must follow the parent.
+func (p *proposal[_]) validate(
+	fields payloadFields, withdrawalsRoot common.Root,
+) error {
+	switch {
+	case fields.GetParentHash() != p.parent.GetBlockHash():
+		return ErrParentHashMismatch
+	case fields.GetNumber() != p.parent.GetNumber()+1:
+		return errors.Wrapf(
+			ErrInvalidBidHeader, "number %d, expected %d",
+			fields.GetNumber(), p.parent.GetNumber()+1,
+		)
+	case fields.GetTimestamp() <= p.parent.GetTimestamp() ||
+		fields.GetTimestamp() > p.attributes.Timestamp:
+		return errors.Wrapf(
+			ErrInvalidBidHeader, "timestamp %d, expected in (%d, %d]",
+			fields.GetTimestamp(), p.parent.GetTimestamp(),
+			p.attributes.Timestamp,
+		)
+	case fields.GetPrevRandao() != p.attributes.PrevRandao:
+		return errors.Wrapf(
+			ErrInvalidBidHeader, "prev randao %s, expected %s",
+			fields.GetPrevRandao(), p.attributes.PrevRandao,
+		)
+	case fields.GetFeeRecipient() != p.attributes.SuggestedFeeRecipient:
+		return errors.Wrapf(
+			ErrInvalidBidHeader, "fee recipient %s, expected %s",
+			fields.GetFeeRecipient(), p.attributes.SuggestedFeeRecipient,
+		)
+	case withdrawalsRoot != p.withdrawalsRoot:
+		return errors.Wrapf(
+			ErrInvalidBidHeader, "withdrawals root %s, expected %s",
+			withdrawalsRoot, p.withdrawalsRoot,
+		)
+	case fields.GetGasLimit() != p.gasLimit:
+		return errors.Wrapf(
+			ErrInvalidBidHeader, "gas limit %d, expected %d",
+			fields.GetGasLimit(), p.gasLimit,
+		)
+	default:
+		return nil
+	}
+}
+
+// expectedGasLimit returns the gas limit of a payload building on a parent
+// with the given gas limit, which the execution layer moves towards the
+// registered gas limit by at most 1/1024 of the parent gas limit.
+func expectedGasLimit(parent, registered uint64) uint64 {
+	delta := max(parent/gasLimitBoundDivisor, 1) - 1
+	switch {
+	case registered > parent:
+		return min(parent+delta, registered)
+	case registered < parent:
+		return max(parent-delta, registered)
+	default:
+		return parent
+	}
+}
+
+// sign signs the given object root with the builder domain.
+func (b *Builder[_, _, _]) sign(root common.Root) (crypto.BLSSignature, error) {
+	signingRoot := (&signingData{
+		ObjectRoot: root,
+		Domain:     b.domain,
+	}).HashTreeRoot()
+	return b.signer.Sign(signingRoot[:])
+}
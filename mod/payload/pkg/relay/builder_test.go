@@ -0,0 +1,625 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/feerecipient"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/mockrelay"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	parentNumber    = 10
+	parentTimestamp = 100
+	localTimestamp  = 102
+	gasLimit        = 30_000_000
+)
+
+var (
+	parentHash      = common.ExecutionHash{0x01}
+	parentBlockRoot = common.Root{0x06}
+	pubkey          = crypto.BLSPubkey{0x03}
+	builderPubkey   = crypto.BLSPubkey{0x07}
+	validSignature  = crypto.BLSSignature{0x08}
+	prevRandao      = common.Bytes32{0x09}
+	feeRecipient    = common.ExecutionAddress{0x05}
+	withdrawals     = engineprimitives.Withdrawals{{
+		Index:     1,
+		Validator: 2,
+		Address:   common.ExecutionAddress{0x0a},
+		Amount:    3,
+	}}
+)
+
+type header struct {
+	ParentHash      common.ExecutionHash    `json:"parentHash"`
+	BlockHash       common.ExecutionHash    `json:"blockHash"`
+	Number          math.U64                `json:"number"`
+	Timestamp       math.U64                `json:"timestamp"`
+	PrevRandao      common.Bytes32          `json:"prevRandao"`
+	FeeRecipient    common.ExecutionAddress `json:"feeRecipient"`
+	GasLimit        math.U64                `json:"gasLimit"`
+	WithdrawalsRoot common.Root             `json:"withdrawalsRoot"`
+}
+
+func (*header) NewFromJSON(bz []byte, _ uint32) (*header, error) {
+	h := new(header)
+	return h, json.Unmarshal(bz, h)
+}
+
+func (h *header) HashTreeRoot() common.Root {
+	return common.Root(h.BlockHash)
+}
+
+func (h *header) GetBlockHash() common.ExecutionHash {
+	return h.BlockHash
+}
+
+func (h *header) GetParentHash() common.ExecutionHash {
+	return h.ParentHash
+}
+
+func (h *header) GetNumber() math.U64 {
+	return h.Number
+}
+
+func (h *header) GetTimestamp() math.U64 {
+	return h.Timestamp
+}
+
+func (h *header) GetPrevRandao() common.Bytes32 {
+	return h.PrevRandao
+}
+
+func (h *header) GetFeeRecipient() common.ExecutionAddress {
+	return h.FeeRecipient
+}
+
+func (h *header) GetGasLimit() math.U64 {
+	return h.GasLimit
+}
+
+func (h *header) GetWithdrawalsRoot() common.Root {
+	return h.WithdrawalsRoot
+}
+
+type payload struct {
+	ParentHash   common.ExecutionHash         `json:"parentHash"`
+	BlockHash    common.ExecutionHash         `json:"blockHash"`
+	Number       math.U64                     `json:"number"`
+	Timestamp    math.U64                     `json:"timestamp"`
+	PrevRandao   common.Bytes32               `json:"prevRandao"`
+	FeeRecipient common.ExecutionAddress      `json:"feeRecipient"`
+	GasLimit     math.U64                     `json:"gasLimit"`
+	BaseFee      *math.U256                   `json:"baseFeePerGas"`
+	Withdrawals  engineprimitives.Withdrawals `json:"withdrawals"`
+}
+
+func (*payload) Empty(uint32) *payload {
+	return new(payload)
+}
+
+func (*payload) Version() uint32 {
+	return version.Deneb
+}
+
+func (p *payload) IsNil() bool {
+	return p == nil
+}
+
+func (p *payload) GetPrevRandao() common.Bytes32 {
+	return p.PrevRandao
+}
+
+func (p *payload) GetBlockHash() common.ExecutionHash {
+	return p.BlockHash
+}
+
+func (p *payload) GetParentHash() common.ExecutionHash {
+	return p.ParentHash
+}
+
+func (p *payload) GetNumber() math.U64 {
+	return p.Number
+}
+
+func (p *payload) GetGasLimit() math.U64 {
+	return p.GasLimit
+}
+
+func (*payload) GetGasUsed() math.U64 {
+	return 0
+}
+
+func (p *payload) GetTimestamp() math.U64 {
+	return p.Timestamp
+}
+
+func (*payload) GetExtraData() []byte {
+	return nil
+}
+
+func (p *payload) GetBaseFeePerGas() *math.U256 {
+	return p.BaseFee
+}
+
+func (p *payload) GetFeeRecipient() common.ExecutionAddress {
+	return p.FeeRecipient
+}
+
+func (*payload) GetStateRoot() common.Bytes32 {
+	return common.Bytes32{}
+}
+
+func (*payload) GetReceiptsRoot() common.Bytes32 {
+	return common.Bytes32{}
+}
+
+func (*payload) GetLogsBloom() bytes.B256 {
+	return bytes.B256{}
+}
+
+func (*payload) GetBlobGasUsed() math.U64 {
+	return 0
+}
+
+func (*payload) GetExcessBlobGas() math.U64 {
+	return 0
+}
+
+func (p *payload) GetWithdrawals() engineprimitives.Withdrawals {
+	return p.Withdrawals
+}
+
+func (*payload) GetTransactions() engineprimitives.Transactions {
+	return nil
+}
+
+func (p *payload) MarshalJSON() ([]byte, error) {
+	type alias payload
+	return json.Marshal((*alias)(p))
+}
+
+func (p *payload) UnmarshalJSON(bz []byte) error {
+	type alias payload
+	return json.Unmarshal(bz, (*alias)(p))
+}
+
+// seal sets the block hash of the payload, committing to the given parent
+// beacon block root, and returns the header of the payload.
+func (p *payload) seal(parentRoot common.Root) *header {
+	withdrawalsHash := gethprimitives.DeriveSha(
+		p.Withdrawals, gethprimitives.NewStackTrie(nil),
+	)
+	zero := uint64(0)
+	p.BlockHash = common.ExecutionHash((&gethprimitives.Header{
+		ParentHash:       gethprimitives.ExecutionHash(p.ParentHash),
+		UncleHash:        gethprimitives.EmptyUncleHash,
+		Coinbase:         gethprimitives.ExecutionAddress(p.FeeRecipient),
+		TxHash:           gethprimitives.EmptyTxsHash,
+		ReceiptHash:      gethprimitives.ExecutionHash{},
+		Difficulty:       big.NewInt(0),
+		Number:           new(big.Int).SetUint64(p.Number.Unwrap()),
+		GasLimit:         p.GasLimit.Unwrap(),
+		Time:             p.Timestamp.Unwrap(),
+		BaseFee:          p.BaseFee.ToBig(),
+		MixDigest:        gethprimitives.ExecutionHash(p.PrevRandao),
+		WithdrawalsHash:  &withdrawalsHash,
+		ExcessBlobGas:    &zero,
+		BlobGasUsed:      &zero,
+		ParentBeaconRoot: (*gethprimitives.ExecutionHash)(&parentRoot),
+	}).Hash())
+	return &header{
+		ParentHash:      p.ParentHash,
+		BlockHash:       p.BlockHash,
+		Number:          p.Number,
+		Timestamp:       p.Timestamp,
+		PrevRandao:      p.PrevRandao,
+		FeeRecipient:    p.FeeRecipient,
+		GasLimit:        p.GasLimit,
+		WithdrawalsRoot: p.Withdrawals.HashTreeRoot(),
+	}
+}
+
+// newPayload returns a payload committing to the proposal of the tests.
+func newPayload() *payload {
+	return &payload{
+		ParentHash:   parentHash,
+		Number:       parentNumber + 1,
+		Timestamp:    localTimestamp,
+		PrevRandao:   prevRandao,
+		FeeRecipient: feeRecipient,
+		GasLimit:     gasLimit,
+		BaseFee:      math.NewU256(1),
+		Withdrawals:  withdrawals,
+	}
+}
+
+type state struct{}
+
+func (state) GetLatestExecutionPayloadHeader() (*header, error) {
+	return &header{
+		BlockHash: parentHash,
+		Number:    parentNumber,
+		Timestamp: parentTimestamp,
+		GasLimit:  gasLimit,
+	}, nil
+}
+
+type attributesFactory struct{}
+
+func (attributesFactory) BuildPayloadAttributes(
+	_ state, _ math.Slot, timestamp uint64, prevHeadRoot [32]byte,
+) (*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal], error) {
+	return engineprimitives.NewPayloadAttributes(
+		version.Deneb, timestamp, prevRandao, feeRecipient, withdrawals,
+		prevHeadRoot,
+	)
+}
+
+// chainSpec activates Electra at electraSlot, or never if it is zero.
+type chainSpec struct {
+	electraSlot math.Slot
+}
+
+func (cs chainSpec) ActiveForkVersionForSlot(slot math.Slot) uint32 {
+	if cs.electraSlot > 0 && slot >= cs.electraSlot {
+		return version.Electra
+	}
+	return version.Deneb
+}
+
+type envelope = engineprimitives.ExecutionPayloadEnvelope[
+	*payload, *engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	],
+]
+
+// newBid returns a bid of the given value for the given payload, which is
+// sealed over the parent beacon block root of the tests.
+func newBid(value uint64, p *payload) *mockrelay.Bid {
+	h := p.seal(parentBlockRoot)
+	return &mockrelay.Bid{
+		Header:    h,
+		Value:     math.NewU256(value),
+		Pubkey:    builderPubkey,
+		Signature: validSignature,
+		Envelope: &envelope{
+			ExecutionPayload: p,
+			BlobsBundle: &engineprimitives.BlobsBundleV1[
+				eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+			]{},
+		},
+	}
+}
+
+// newLocal returns the local payload of the given value.
+func newLocal(value uint64) *envelope {
+	return &envelope{
+		ExecutionPayload: newPayload(),
+		BlockValue:       math.NewU256(value),
+	}
+}
+
+func newBuilder(
+	t *testing.T, cfg relay.Config, urls ...string,
+) *relay.Builder[state, *payload, *header] {
+	t.Helper()
+	return newBuilderWithSpec(t, chainSpec{}, cfg, urls...)
+}
+
+func newBuilderWithSpec(
+	t *testing.T, cs chainSpec, cfg relay.Config, urls ...string,
+) *relay.Builder[state, *payload, *header] {
+	t.Helper()
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().PublicKey().Return(pubkey).Maybe()
+	signer.EXPECT().Sign(mock.Anything).
+		Return(crypto.BLSSignature{0x04}, nil).Maybe()
+	signer.EXPECT().VerifySignature(
+		builderPubkey, mock.Anything, validSignature,
+	).Return(nil).Maybe()
+	signer.EXPECT().VerifySignature(
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(errors.New("invalid signature")).Maybe()
+
+	cfg.Enabled = true
+	cfg.URLs = urls
+	b, err := relay.New[state, *payload, *header](
+		&cfg, cs, noop.NewLogger[any](), signer,
+		common.Domain{},
+		feerecipient.NewStatic(feeRecipient),
+		attributesFactory{},
+	)
+	require.NoError(t, err)
+	return b
+}
+
+func newRelay(t *testing.T) (*mockrelay.Relay, string) {
+	t.Helper()
+	r := mockrelay.New()
+	srv := httptest.NewServer(r.Handler())
+	t.Cleanup(srv.Close)
+	return r, srv.URL
+}
+
+func getPayload(
+	b *relay.Builder[state, *payload, *header], local *envelope,
+) (engineprimitives.BuiltExecutionPayloadEnv[*payload], error) {
+	return b.GetPayload(
+		context.Background(), state{}, 1, parentBlockRoot, local,
+	)
+}
+
+func TestGetPayload_BestBid(t *testing.T) {
+	low, lowURL := newRelay(t)
+	low.SetBid(newBid(100, newPayload()))
+	high, highURL := newRelay(t)
+	bid := newBid(200, newPayload())
+	high.SetBid(bid)
+
+	b := newBuilder(t, relay.DefaultConfig(), lowURL, highURL)
+	env, err := getPayload(b, newLocal(50))
+	require.NoError(t, err)
+	require.Equal(t,
+		bid.Header.(*header).BlockHash,
+		env.GetExecutionPayload().GetBlockHash(),
+	)
+	require.Equal(t, uint64(200), env.GetValue().Uint64())
+
+	require.Empty(t, low.Submissions())
+	submissions := high.Submissions()
+	require.Len(t, submissions, 1)
+	require.Equal(t, uint64(1), submissions[0].Message.Slot)
+	require.Equal(t, parentHash, submissions[0].Message.ParentHash)
+	require.Equal(t, pubkey, submissions[0].Message.ProposerPubkey)
+}
+
+func TestGetPayload_LocalValueWins(t *testing.T) {
+	r, url := newRelay(t)
+	r.SetBid(newBid(100, newPayload()))
+
+	b := newBuilder(t, relay.DefaultConfig(), url)
+	_, err := getPayload(b, newLocal(100))
+	require.ErrorIs(t, err, relay.ErrBidTooLow)
+	require.Empty(t, r.Submissions())
+}
+
+func TestGetPayload_MinBid(t *testing.T) {
+	r, url := newRelay(t)
+	r.SetBid(newBid(100, newPayload()))
+
+	cfg := relay.DefaultConfig()
+	cfg.MinBid = 1
+	b := newBuilder(t, cfg, url)
+	_, err := getPayload(b, newLocal(0))
+	require.ErrorIs(t, err, relay.ErrBidTooLow)
+}
+
+func TestGetPayload_NoBid(t *testing.T) {
+	_, url := newRelay(t)
+
+	b := newBuilder(t, relay.DefaultConfig(), url)
+	_, err := getPayload(b, newLocal(0))
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestGetPayload_Timeout(t *testing.T) {
+	r, url := newRelay(t)
+	r.SetBid(newBid(100, newPayload()))
+	r.SetDelay(time.Second)
+
+	cfg := relay.DefaultConfig()
+	cfg.Timeout = 50 * time.Millisecond
+	b := newBuilder(t, cfg, url)
+	_, err := getPayload(b, newLocal(0))
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestGetPayload_InvalidBidSkipped(t *testing.T) {
+	valid, validURL := newRelay(t)
+	valid.SetBid(newBid(100, newPayload()))
+	invalid, invalidURL := newRelay(t)
+	bid := newBid(200, newPayload())
+	bid.Signature = crypto.BLSSignature{0xff}
+	invalid.SetBid(bid)
+
+	b := newBuilder(t, relay.DefaultConfig(), validURL, invalidURL)
+	env, err := getPayload(b, newLocal(0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), env.GetValue().Uint64())
+	require.Empty(t, invalid.Submissions())
+	require.Len(t, valid.Submissions(), 1)
+}
+
+func TestGetPayload_PinnedBuilderPubkey(t *testing.T) {
+	r, url := newRelay(t)
+	r.SetBid(newBid(100, newPayload()))
+
+	pinned := func(pk crypto.BLSPubkey) string {
+		return strings.Replace(url, "://", "://"+pk.String()+"@", 1)
+	}
+
+	b := newBuilder(t, relay.DefaultConfig(), pinned(crypto.BLSPubkey{0xff}))
+	_, err := getPayload(b, newLocal(0))
+	require.ErrorIs(t, err, relay.ErrNoBid)
+	require.Empty(t, r.Submissions())
+
+	b = newBuilder(t, relay.DefaultConfig(), pinned(builderPubkey))
+	_, err = getPayload(b, newLocal(0))
+	require.NoError(t, err)
+	require.Len(t, r.Submissions(), 1)
+}
+
+func TestGetPayload_InvalidHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *payload)
+	}{
+		{"parent hash", func(p *payload) {
+			p.ParentHash = common.ExecutionHash{0xff}
+		}},
+		{"number", func(p *payload) { p.Number++ }},
+		{"timestamp after local", func(p *payload) { p.Timestamp++ }},
+		{"timestamp before parent", func(p *payload) {
+			p.Timestamp = parentTimestamp
+		}},
+		{"prev randao", func(p *payload) {
+			p.PrevRandao = common.Bytes32{0xff}
+		}},
+		{"fee recipient", func(p *payload) {
+			p.FeeRecipient = common.ExecutionAddress{0xff}
+		}},
+		{"withdrawals", func(p *payload) {
+			p.Withdrawals = engineprimitives.Withdrawals{}
+		}},
+		{"gas limit", func(p *payload) { p.GasLimit = 2 * gasLimit }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPayload()
+			tt.modify(p)
+			r, url := newRelay(t)
+			r.SetBid(newBid(100, p))
+
+			b := newBuilder(t, relay.DefaultConfig(), url)
+			_, err := getPayload(b, newLocal(0))
+			require.ErrorIs(t, err, relay.ErrNoBid)
+			require.Empty(t, r.Submissions())
+		})
+	}
+}
+
+func TestGetPayload_EarlierTimestamp(t *testing.T) {
+	p := newPayload()
+	p.Timestamp = parentTimestamp + 1
+	r, url := newRelay(t)
+	r.SetBid(newBid(100, p))
+
+	b := newBuilder(t, relay.DefaultConfig(), url)
+	_, err := getPayload(b, newLocal(0))
+	require.NoError(t, err)
+}
+
+func TestGetPayload_GasLimitTowardsRegistered(t *testing.T) {
+	p := newPayload()
+	p.GasLimit = gasLimit + gasLimit/1024 - 1
+	r, url := newRelay(t)
+	r.SetBid(newBid(100, p))
+
+	cfg := relay.DefaultConfig()
+	cfg.GasLimit = 36_000_000
+	b := newBuilder(t, cfg, url)
+	_, err := getPayload(b, newLocal(0))
+	require.NoError(t, err)
+}
+
+func TestGetPayload_BlockHashMismatch(t *testing.T) {
+	r, url := newRelay(t)
+	bid := newBid(100, newPayload())
+	revealed := newPayload()
+	revealed.BlockHash = common.ExecutionHash{0xff}
+	bid.Envelope.(*envelope).ExecutionPayload = revealed
+	r.SetBid(bid)
+
+	b := newBuilder(t, relay.DefaultConfig(), url)
+	_, err := getPayload(b, newLocal(0))
+	require.ErrorIs(t, err, relay.ErrBlockHashMismatch)
+}
+
+func TestGetPayload_ParentBeaconRootMismatch(t *testing.T) {
+	r, url := newRelay(t)
+	p := newPayload()
+	bid := newBid(100, p)
+	bid.Header = p.seal(common.Root{0xff})
+	r.SetBid(bid)
+
+	b := newBuilder(t, relay.DefaultConfig(), url)
+	_, err := getPayload(b, newLocal(0))
+	require.ErrorIs(t, err, engineprimitives.ErrPayloadBlockHashMismatch)
+}
+
+func TestGetPayload_ElectraUnsupported(t *testing.T) {
+	r, url := newRelay(t)
+	r.SetBid(newBid(100, newPayload()))
+
+	b := newBuilderWithSpec(
+		t, chainSpec{electraSlot: 1}, relay.DefaultConfig(), url,
+	)
+	_, err := getPayload(b, newLocal(0))
+	require.ErrorIs(t, err, relay.ErrUnsupportedForkVersion)
+	require.Empty(t, r.Submissions())
+}
+
+func TestStart_RegistersValidator(t *testing.T) {
+	r, url := newRelay(t)
+
+	cfg := relay.DefaultConfig()
+	cfg.GasLimit = 36_000_000
+	b := newBuilder(t, cfg, url)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, b.Start(ctx))
+
+	require.Eventually(t, func() bool {
+		return len(r.Registrations()) == 1
+	}, time.Second, 10*time.Millisecond)
+	registration := r.Registrations()[0].Message
+	require.Equal(t, pubkey, registration.Pubkey)
+	require.Equal(t, feeRecipient, registration.FeeRecipient)
+	require.Equal(t, uint64(36_000_000), registration.GasLimit)
+}
+
+func TestStart_DefaultRegistrationInterval(t *testing.T) {
+	r, url := newRelay(t)
+
+	cfg := relay.DefaultConfig()
+	cfg.RegistrationInterval = 0
+	b := newBuilder(t, cfg, url)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, b.Start(ctx))
+
+	require.Eventually(t, func() bool {
+		return len(r.Registrations()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// RegisterValidatorsPath is the builder API path validators are
+	// registered at.
+	RegisterValidatorsPath = "/eth/v1/builder/validators"
+	// GetHeaderPath is the builder API path bids are requested at, followed
+	// by the slot, the parent hash and the proposer public key.
+	GetHeaderPath = "/eth/v1/builder/header/"
+	// SubmitBlindedPayloadPath is the builder API path accepted bids are
+	// submitted at in exchange for the payload.
+	SubmitBlindedPayloadPath = "/eth/v1/builder/blinded_blocks"
+	// StatusPath is the builder API path the relay status is served at.
+	StatusPath = "/eth/v1/builder/status"
+
+	// maxResponseSize is the maximum size of a relay response.
+	maxResponseSize = 32 << 20
+)
+
+// client talks to a single relay over the builder API.
+type client struct {
+	url  string
+	http *http.Client
+	// pubkey is the public key the bids of the relay must be signed with,
+	// or nil if the bids may be signed by any builder.
+	pubkey *crypto.BLSPubkey
+}
+
+// newClient creates a new client for the relay at the given URL. The
+// public key of the relay may be given as the user of the URL, as in
+// https://0xabc...@relay.example, in which case only bids signed with it
+// are accepted.
+func newClient(rawURL string, httpClient *http.Client) (*client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &client{http: httpClient}
+	if u.User != nil {
+		c.pubkey = new(crypto.BLSPubkey)
+		if err = c.pubkey.UnmarshalText(
+			[]byte(u.User.Username()),
+		); err != nil {
+			return nil, errors.Wrapf(ErrInvalidRelayURL, "%s: %v", u.Host, err)
+		}
+		u.User = nil
+	}
+	c.url = strings.TrimSuffix(u.String(), "/")
+	return c, nil
+}
+
+// registerValidators registers the given validators with the relay.
+func (c *client) registerValidators(
+	ctx context.Context,
+	registrations []*SignedValidatorRegistration,
+) error {
+	_, err := c.do(
+		ctx, http.MethodPost, RegisterValidatorsPath, registrations,
+	)
+	return err
+}
+
+// getHeader requests the signed bid of the relay for the given slot. It
+// returns nil if the relay has no bid.
+func (c *client) getHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+) (*SignedBuilderBid, error) {
+	bz, err := c.do(
+		ctx, http.MethodGet, GetHeaderPath+strings.Join([]string{
+			strconv.FormatUint(slot.Unwrap(), 10),
+			parentHash.Hex(),
+			pubkey.String(),
+		}, "/"), nil,
+	)
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	resp := new(GetHeaderResponse)
+	if err = json.Unmarshal(bz, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil || resp.Data.Message == nil {
+		return nil, nil
+	}
+	return resp.Data, nil
+}
+
+// submitBlindedPayload submits an accepted bid to the relay and returns
+// the JSON encoding of the revealed payload envelope.
+func (c *client) submitBlindedPayload(
+	ctx context.Context,
+	req *SignedBlindedPayloadRequest,
+) (json.RawMessage, error) {
+	bz, err := c.do(ctx, http.MethodPost, SubmitBlindedPayloadPath, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SubmitBlindedPayloadResponse)
+	if err = json.Unmarshal(bz, resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// do sends a request to the relay and returns the response body, which is
+// nil if the relay responded without content.
+func (c *client) do(
+	ctx context.Context, method, path string, body any,
+) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		bz, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(bz)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	case http.StatusNoContent:
+		return nil, nil
+	default:
+		return nil, errors.Wrapf(
+			ErrUnexpectedStatus, "%s %s: %s", method, path, resp.Status,
+		)
+	}
+}
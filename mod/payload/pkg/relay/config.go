@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultTimeout is the default time the proposer waits for bids from
+	// the relays before falling back to the local payload.
+	defaultTimeout = 500 * time.Millisecond
+	// defaultGasLimit is the default gas limit the proposer registers with
+	// the relays.
+	defaultGasLimit = 30_000_000
+	// defaultRegistrationInterval is the default interval at which the
+	// validator registration is resubmitted to the relays.
+	defaultRegistrationInterval = 5 * time.Minute
+)
+
+// Config is the configuration for sourcing payloads from builder relays.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// Enabled determines if payloads are sourced from the relays.
+	Enabled bool `mapstructure:"enabled"`
+	// URLs is the list of relays that are queried for bids. The public key
+	// of a relay may be given as the user of its URL, restricting its bids
+	// to those signed with it.
+	URLs []string `mapstructure:"urls"`
+	// MinBid is the minimum bid, in Gwei, that a relay has to offer for
+	// its payload to be used over the local one.
+	MinBid uint64 `mapstructure:"min-bid"`
+	// Timeout is the time the proposer waits for bids and for the
+	// unblinded payload before falling back to the local payload.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// GasLimit is the gas limit the proposer registers with the relays.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// RegistrationInterval is the interval at which the validator
+	// registration is resubmitted to the relays. If zero, the default
+	// interval is used.
+	RegistrationInterval time.Duration `mapstructure:"registration-interval"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:              false,
+		URLs:                 []string{},
+		MinBid:               0,
+		Timeout:              defaultTimeout,
+		GasLimit:             defaultGasLimit,
+		RegistrationInterval: defaultRegistrationInterval,
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrRelaysDisabled is returned when payloads are requested from the
+	// relays while the relays are disabled.
+	ErrRelaysDisabled = errors.New("builder relays are disabled")
+
+	// ErrNoBid is returned when no relay offered a bid for the slot.
+	ErrNoBid = errors.New("no bid received from the relays")
+
+	// ErrBidTooLow is returned when the best bid does not exceed both the
+	// minimum bid and the value of the local payload.
+	ErrBidTooLow = errors.New("bid does not exceed the local payload value")
+
+	// ErrParentHashMismatch is returned when a bid does not build on the
+	// requested parent block.
+	ErrParentHashMismatch = errors.New("bid parent hash mismatch")
+
+	// ErrInvalidBidHeader is returned when the header of a bid does not
+	// commit to the attributes of the proposal.
+	ErrInvalidBidHeader = errors.New("bid header does not match proposal")
+
+	// ErrInvalidBidSignature is returned when a bid is not signed by the
+	// builder it claims to be from.
+	ErrInvalidBidSignature = errors.New("invalid bid signature")
+
+	// ErrBuilderPubkeyMismatch is returned when a bid is signed by another
+	// builder than the one the relay was configured with.
+	ErrBuilderPubkeyMismatch = errors.New("bid builder pubkey mismatch")
+
+	// ErrInvalidRelayURL is returned when the URL of a relay is invalid.
+	ErrInvalidRelayURL = errors.New("invalid relay URL")
+
+	// ErrBlockHashMismatch is returned when the payload revealed by a relay
+	// does not match the header of its bid.
+	ErrBlockHashMismatch = errors.New("payload block hash mismatch")
+
+	// ErrUnexpectedStatus is returned when a relay responds with an
+	// unexpected HTTP status code.
+	ErrUnexpectedStatus = errors.New("unexpected relay response status")
+
+	// ErrUnsupportedForkVersion is returned when payloads are requested
+	// from the relays for a fork they cannot serve.
+	ErrUnsupportedForkVersion = errors.New(
+		"relays do not serve payloads of the active fork",
+	)
+)
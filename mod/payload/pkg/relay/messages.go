@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// ValidatorRegistrationSize is the size of the ValidatorRegistration in
+	// bytes.
+	ValidatorRegistrationSize = 84 // 20 + 8 + 8 + 48
+	// builderBidSigningSize is the size of the builderBidSigning in bytes.
+	builderBidSigningSize = 112 // 32 + 32 + 48
+)
+
+var _ ssz.StaticObject = (*ValidatorRegistration)(nil)
+
+// ValidatorRegistration is the message a proposer registers with the
+// relays, as defined by the builder-specs.
+type ValidatorRegistration struct {
+	// FeeRecipient is the address the builder pays the bid to.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the gas limit the builder targets for the payloads.
+	GasLimit uint64 `json:"gas_limit,string"`
+	// Timestamp is the time at which the registration was created.
+	Timestamp uint64 `json:"timestamp,string"`
+	// Pubkey is the public key of the proposer.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SizeSSZ returns the size of the ValidatorRegistration in bytes when SSZ
+// encoded.
+func (*ValidatorRegistration) SizeSSZ() uint32 {
+	return ValidatorRegistrationSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ValidatorRegistration object.
+func (r *ValidatorRegistration) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &r.FeeRecipient)
+	ssz.DefineUint64(c, &r.GasLimit)
+	ssz.DefineUint64(c, &r.Timestamp)
+	ssz.DefineStaticBytes(c, &r.Pubkey)
+}
+
+// HashTreeRoot computes the Merkleization of the ValidatorRegistration.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// SignedValidatorRegistration is a ValidatorRegistration signed by the
+// proposer.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+// BuilderBid is the offer of a builder for the payload of a slot. The
+// header is kept in its JSON encoding, since it is decoded against the
+// fork that is active at the slot.
+type BuilderBid struct {
+	// Header is the header of the payload the builder offers.
+	Header json.RawMessage `json:"header"`
+	// Value is the amount, in Wei, paid to the proposer.
+	Value *math.U256 `json:"value"`
+	// Pubkey is the public key of the builder.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SignedBuilderBid is a BuilderBid signed by the builder.
+type SignedBuilderBid struct {
+	Message   *BuilderBid         `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// GetHeaderResponse is the response of a relay to a request for a bid.
+type GetHeaderResponse struct {
+	Data *SignedBuilderBid `json:"data"`
+}
+
+// BlindedPayloadRequest is the commitment of a proposer to the bid it has
+// accepted. Since CometBFT proposals do not carry blinded beacon blocks,
+// the proposer commits to the header of the bid instead.
+type BlindedPayloadRequest struct {
+	// Slot is the slot the payload is proposed in.
+	Slot uint64 `json:"slot,string"`
+	// ParentHash is the hash of the execution block the payload builds on.
+	ParentHash common.ExecutionHash `json:"parent_hash"`
+	// ProposerPubkey is the public key of the proposer.
+	ProposerPubkey crypto.BLSPubkey `json:"proposer_pubkey"`
+	// Header is the header of the accepted bid.
+	Header json.RawMessage `json:"header"`
+}
+
+// SignedBlindedPayloadRequest is a BlindedPayloadRequest signed by the
+// proposer over the root of the accepted header.
+type SignedBlindedPayloadRequest struct {
+	Message   *BlindedPayloadRequest `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+// SubmitBlindedPayloadResponse is the response of a relay to a blinded
+// payload request. The data is the JSON encoding of an
+// ExecutionPayloadEnvelope holding the revealed payload and blobs bundle.
+type SubmitBlindedPayloadResponse struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// builderBidSigning is the SSZ container of a BuilderBid with the header
+// in its merkleized form, whose root is the root signed by the builder.
+type builderBidSigning struct {
+	HeaderRoot common.Root
+	Value      *math.U256
+	Pubkey     crypto.BLSPubkey
+}
+
+// SizeSSZ returns the size of the builderBidSigning in bytes when SSZ
+// encoded.
+func (*builderBidSigning) SizeSSZ() uint32 {
+	return builderBidSigningSize
+}
+
+// DefineSSZ defines the SSZ encoding for the builderBidSigning object.
+func (b *builderBidSigning) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &b.HeaderRoot)
+	ssz.DefineUint256(c, &b.Value)
+	ssz.DefineStaticBytes(c, &b.Pubkey)
+}
+
+// HashTreeRoot computes the Merkleization of the builderBidSigning.
+func (b *builderBidSigning) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
+
+// signingData is the container whose root is signed, binding an object
+// root to the builder domain.
+type signingData struct {
+	ObjectRoot common.Root
+	Domain     common.Domain
+}
+
+// SizeSSZ returns the size of the signingData in bytes when SSZ encoded.
+func (*signingData) SizeSSZ() uint32 {
+	//nolint:mnd // 32 + 32 = 64.
+	return 64
+}
+
+// DefineSSZ defines the SSZ encoding for the signingData object.
+func (d *signingData) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.ObjectRoot)
+	ssz.DefineStaticBytes(c, &d.Domain)
+}
+
+// HashTreeRoot computes the Merkleization of the signingData.
+func (d *signingData) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// AttributesFactory is the interface for the factory of the attributes
+// the payload of a proposal is built with.
+type AttributesFactory[BeaconStateT any] interface {
+	// BuildPayloadAttributes builds the payload attributes of the proposal
+	// of the given slot on top of the given state.
+	BuildPayloadAttributes(
+		st BeaconStateT,
+		slot math.Slot,
+		timestamp uint64,
+		prevHeadRoot [32]byte,
+	) (*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal], error)
+}
+
+// BeaconState is the interface for the beacon state a payload is requested
+// on top of.
+type BeaconState[ExecutionPayloadHeaderT any] interface {
+	// GetLatestExecutionPayloadHeader returns the header of the payload the
+	// requested payload builds on.
+	GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+}
+
+// ChainSpec is the interface for the chain spec.
+type ChainSpec interface {
+	// ActiveForkVersionForSlot returns the fork version active at a slot.
+	ActiveForkVersionForSlot(slot math.Slot) uint32
+}
+
+// FeeRecipientProvider is the interface for the provider of the fee
+// recipients of the validators.
+type FeeRecipientProvider interface {
+	// FeeRecipient returns the fee recipient of the given validator.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
+
+// ExecutionPayload is the interface for the execution payload.
+type ExecutionPayload[T any] interface {
+	constraints.EngineType[T]
+	GetPrevRandao() common.Bytes32
+	GetBlockHash() common.ExecutionHash
+	GetParentHash() common.ExecutionHash
+	GetNumber() math.U64
+	GetGasLimit() math.U64
+	GetGasUsed() math.U64
+	GetTimestamp() math.U64
+	GetExtraData() []byte
+	GetBaseFeePerGas() *math.U256
+	GetFeeRecipient() common.ExecutionAddress
+	GetStateRoot() common.Bytes32
+	GetReceiptsRoot() common.Bytes32
+	GetLogsBloom() bytes.B256
+	GetBlobGasUsed() math.U64
+	GetExcessBlobGas() math.U64
+	GetWithdrawals() engineprimitives.Withdrawals
+	GetTransactions() engineprimitives.Transactions
+}
+
+// ExecutionPayloadHeader is the interface for the execution payload header.
+type ExecutionPayloadHeader[T any] interface {
+	// NewFromJSON decodes a header of the given fork version.
+	NewFromJSON([]byte, uint32) (T, error)
+	// HashTreeRoot returns the hash tree root of the header.
+	HashTreeRoot() common.Root
+	// GetBlockHash returns the block hash.
+	GetBlockHash() common.ExecutionHash
+	// GetParentHash returns the parent hash.
+	GetParentHash() common.ExecutionHash
+	// GetNumber returns the block number.
+	GetNumber() math.U64
+	// GetTimestamp returns the timestamp.
+	GetTimestamp() math.U64
+	// GetPrevRandao returns the previous randao mix.
+	GetPrevRandao() common.Bytes32
+	// GetFeeRecipient returns the fee recipient.
+	GetFeeRecipient() common.ExecutionAddress
+	// GetGasLimit returns the gas limit.
+	GetGasLimit() math.U64
+	// GetWithdrawalsRoot returns the root of the withdrawals.
+	GetWithdrawalsRoot() common.Root
+}
@@ -31,4 +31,7 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// UnsetDepositRequestsStartIndex is the deposit requests start index
+	// before the first deposit request has been processed.
+	UnsetDepositRequestsStartIndex = ^uint64(0)
 )
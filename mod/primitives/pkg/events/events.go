@@ -22,15 +22,10 @@ package events
 
 const (
 	NewSlot                     = "new-slot"
-	BeaconBlockBuilt            = "beacon-block-built"
 	BeaconBlockReceived         = "beacon-block-received"
-	BeaconBlockVerified         = "beacon-block-verified"
 	BeaconBlockFinalizedRequest = "beacon-block-finalized-request"
 	BeaconBlockFinalized        = "beacon-block-finalized"
-	ValidatorSetUpdated         = "validator-set-updated"
-	BlobSidecarsBuilt           = "blob-sidecars-built"
 	BlobSidecarsReceived        = "blob-sidecars-received"
 	BlobSidecarsProcessRequest  = "blob-sidecars-process-request"
-	BlobSidecarsProcessed       = "blob-sidecars-processed"
 	GenesisDataProcessRequest   = "genesis-data-process-request"
 )
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// PendingPartialWithdrawal is a partial withdrawal requested from the
+// execution layer that is paid out by the withdrawals sweep once it becomes
+// withdrawable.
+type PendingPartialWithdrawal struct {
+	// ValidatorIndex is the index of the withdrawing validator.
+	ValidatorIndex math.ValidatorIndex
+	// Amount is the amount of Gwei to withdraw.
+	Amount math.Gwei
+	// WithdrawableEpoch is the epoch from which the withdrawal is paid out.
+	WithdrawableEpoch math.Epoch
+}
+
+// PendingConsolidation is a consolidation requested from the execution layer
+// that moves the balance of the source validator to the target validator once
+// the source validator is withdrawable.
+type PendingConsolidation struct {
+	// SourceIndex is the index of the validator that is consolidated.
+	SourceIndex math.ValidatorIndex
+	// TargetIndex is the index of the validator receiving the balance.
+	TargetIndex math.ValidatorIndex
+}
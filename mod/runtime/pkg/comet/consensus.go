@@ -26,15 +26,22 @@ import (
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 type ChainSpec interface {
 	// GetCometBFTConfigForSlot returns the CometBFT configuration for the given
 	// slot.
 	GetCometBFTConfigForSlot(math.Slot) any
+	// VoteExtensionsEnableHeight returns the height from which validators
+	// extend their votes, or 0 if vote extensions are disabled.
+	VoteExtensionsEnableHeight() uint64
 }
 
-// ConsensusParamsStore is a store for consensus parameters.
+// ConsensusParamsStore is a store for consensus parameters. The parameters are
+// derived from the chain spec for the height of the context, so they are never
+// written to the committed state.
 type ConsensusParamsStore struct {
 	cs ChainSpec
 }
@@ -47,13 +54,13 @@ func NewConsensusParamsStore(cs ChainSpec) *ConsensusParamsStore {
 }
 
 // Get retrieves the consensus parameters from the store.
-// It returns the consensus parameters and an error, if any.
+// It returns the consensus parameters the chain spec schedules for the block
+// height of the context and an error, if any.
 func (s *ConsensusParamsStore) Get(
-	context.Context,
+	ctx context.Context,
 ) (cmtproto.ConsensusParams, error) {
-	return s.cs.
-		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams).
-		ToProto(), nil
+	//#nosec:G701 // block heights are never negative.
+	return s.scheduled(math.Slot(sdk.UnwrapSDKContext(ctx).BlockHeight())), nil
 }
 
 // Has checks if the consensus parameters exist in the store.
@@ -71,3 +78,37 @@ func (s *ConsensusParamsStore) Set(
 ) error {
 	return nil
 }
+
+// Update returns the consensus parameters scheduled for the given height so
+// they can be handed to CometBFT, which applies them from the next height
+// onwards. They are returned on the first block, so that the ones in the
+// genesis file are replaced by the chain spec, and at every height where the
+// chain spec schedules a change. It returns nil otherwise.
+func (s *ConsensusParamsStore) Update(
+	_ context.Context,
+	height int64,
+) (*cmtproto.ConsensusParams, error) {
+	//#nosec:G701 // block heights are never negative.
+	next := s.scheduled(math.Slot(height))
+	if height > 1 {
+		//#nosec:G701 // block heights are never negative.
+		current := s.scheduled(math.Slot(height - 1))
+		if proto.Equal(&current, &next) {
+			return nil, nil //nolint:nilnil // the parameters are unchanged.
+		}
+	}
+	return &next, nil
+}
+
+// scheduled returns the consensus parameters the chain spec schedules for the
+// given slot, enabling vote extensions from the height set in the chain spec.
+func (s *ConsensusParamsStore) scheduled(
+	slot math.Slot,
+) cmtproto.ConsensusParams {
+	cp := *s.cs.GetCometBFTConfigForSlot(slot).(*cmttypes.ConsensusParams)
+	if height := s.cs.VoteExtensionsEnableHeight(); height > 0 {
+		//#nosec:G701 // heights never overflow an int64.
+		cp.Feature.VoteExtensionsEnableHeight = int64(height)
+	}
+	return cp.ToProto()
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/baseapp"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chainID                    = "consensus-params-test"
+	upgradeSlot                = 3
+	genesisMaxBytes            = 1 << 20
+	upgradeMaxBytes            = 2 << 20
+	voteExtensionsEnableHeight = 2
+)
+
+// chainSpec schedules an increase of the maximum block size at upgradeSlot,
+// and enables vote extensions from voteExtensionsEnableHeight.
+type chainSpec struct{}
+
+func (cs chainSpec) GetCometBFTConfigForSlot(slot math.Slot) any {
+	return cs.params(slot)
+}
+
+func (chainSpec) VoteExtensionsEnableHeight() uint64 {
+	return voteExtensionsEnableHeight
+}
+
+func (chainSpec) params(slot math.Slot) *cmttypes.ConsensusParams {
+	cp := cmttypes.DefaultConsensusParams()
+	cp.Block.MaxBytes = genesisMaxBytes
+	cp.Feature.VoteExtensionsEnableHeight = voteExtensionsEnableHeight
+	if slot >= upgradeSlot {
+		cp.Block.MaxBytes = upgradeMaxBytes
+	}
+	return cp
+}
+
+// newApp creates a baseapp backed by the given database with a single store.
+func newApp(
+	t *testing.T, db dbm.DB,
+) (*baseapp.BaseApp, *storetypes.KVStoreKey) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("beacon")
+	app := baseapp.NewBaseApp(
+		"test", log.NewNopLogger(), db,
+		baseapp.SetChainID(chainID),
+		func(app *baseapp.BaseApp) {
+			app.SetParamStore(comet.NewConsensusParamsStore(chainSpec{}))
+			app.SetExtendVoteHandler(func(
+				_ sdk.Context, req *abci.ExtendVoteRequest,
+			) (*abci.ExtendVoteResponse, error) {
+				return &abci.ExtendVoteResponse{VoteExtension: req.Hash}, nil
+			})
+		},
+	)
+	app.MountStores(key)
+	require.NoError(t, app.LoadLatestVersion())
+	return app, key
+}
+
+func TestConsensusParamsStore_ScheduledUpdates(t *testing.T) {
+	db := dbm.NewMemDB()
+	app, key := newApp(t, db)
+
+	genesis := chainSpec{}.params(0).ToProto()
+	_, err := app.InitChain(&abci.InitChainRequest{
+		ChainId:         chainID,
+		InitialHeight:   1,
+		ConsensusParams: &genesis,
+	})
+	require.NoError(t, err)
+
+	finalize := func(
+		app *baseapp.BaseApp, height int64,
+	) *abci.FinalizeBlockResponse {
+		res, fErr := app.FinalizeBlock(
+			&abci.FinalizeBlockRequest{Height: height},
+		)
+		require.NoError(t, fErr)
+		_, fErr = app.Commit()
+		require.NoError(t, fErr)
+		return res
+	}
+
+	// The params are only handed to CometBFT on the first block and at the
+	// activation height.
+	for height := int64(1); height <= upgradeSlot+2; height++ {
+		res := finalize(app, height)
+		if height != 1 && height != upgradeSlot {
+			require.Nil(t, res.ConsensusParamUpdates, "height %d", height)
+			continue
+		}
+		require.NotNil(t, res.ConsensusParamUpdates)
+		require.Equal(
+			t, chainSpec{}.params(math.Slot(height)).Block.MaxBytes,
+			res.ConsensusParamUpdates.GetBlock().GetMaxBytes(),
+		)
+	}
+
+	// The params are never written to the committed state.
+	ctx, err := app.CreateQueryContext(upgradeSlot+2, false)
+	require.NoError(t, err)
+	it, err := runtime.NewKVStoreService(key).OpenKVStore(ctx).
+		Iterator(nil, nil)
+	require.NoError(t, err)
+	require.False(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	// The params in effect are derived again after a restart.
+	app, _ = newApp(t, db)
+	require.Nil(t, finalize(app, upgradeSlot+3).ConsensusParamUpdates)
+	ctx, err = app.CreateQueryContext(upgradeSlot+3, false)
+	require.NoError(t, err)
+	cp := app.GetConsensusParams(ctx)
+	require.Equal(t, int64(upgradeMaxBytes), cp.GetBlock().GetMaxBytes())
+}
+
+func TestConsensusParamsStore_VoteExtensions(t *testing.T) {
+	app, _ := newApp(t, dbm.NewMemDB())
+
+	genesis := chainSpec{}.params(0).ToProto()
+	_, err := app.InitChain(&abci.InitChainRequest{
+		ChainId:         chainID,
+		InitialHeight:   1,
+		ConsensusParams: &genesis,
+	})
+	require.NoError(t, err)
+
+	// Votes are not extended before the enable height.
+	_, err = app.ExtendVote(
+		context.Background(), &abci.ExtendVoteRequest{Height: 1},
+	)
+	require.Error(t, err)
+
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	res, err := app.ExtendVote(context.Background(), &abci.ExtendVoteRequest{
+		Height: voteExtensionsEnableHeight,
+		Hash:   []byte{0x01},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01}, res.GetVoteExtension())
+}
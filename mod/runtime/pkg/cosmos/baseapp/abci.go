@@ -378,6 +378,127 @@ func (app *BaseApp) ProcessProposal(
 	return resp, nil
 }
 
+// ExtendVote implements the ExtendVote ABCI method and returns a
+// ResponseExtendVote. It calls the application's ExtendVote handler, which
+// is responsible for attaching application-specific data to the pre-commit
+// vote of the validator. An error is returned if vote extensions are not
+// enabled at the height of the vote. If the handler fails, the vote is
+// extended with an empty extension.
+func (app *BaseApp) ExtendVote(
+	_ context.Context,
+	req *abci.ExtendVoteRequest,
+) (*abci.ExtendVoteResponse, error) {
+	if app.extendVote == nil {
+		return nil, errors.New("ExtendVote handler not set")
+	}
+
+	ctx, err := app.getContextForVoteExtension(
+		req.Height, req.Hash, sdk.ExecModeVoteExtension,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := app.extendVote(ctx, req)
+	if err != nil {
+		app.logger.Error(
+			"failed to extend vote",
+			"height",
+			req.Height,
+			"hash",
+			fmt.Sprintf("%X", req.Hash),
+			"err",
+			err,
+		)
+		return &abci.ExtendVoteResponse{VoteExtension: []byte{}}, nil
+	}
+
+	return resp, nil
+}
+
+// VerifyVoteExtension implements the VerifyVoteExtension ABCI method and
+// returns a ResponseVerifyVoteExtension. It calls the application's
+// VerifyVoteExtension handler, which is responsible for verifying the vote
+// extension of another validator during the pre-commit phase. The response
+// MUST be deterministic. An error is returned if vote extensions are not
+// enabled at the height of the vote, and the extension is rejected if the
+// handler fails.
+func (app *BaseApp) VerifyVoteExtension(
+	req *abci.VerifyVoteExtensionRequest,
+) (*abci.VerifyVoteExtensionResponse, error) {
+	if app.verifyVoteExt == nil {
+		return nil, errors.New("VerifyVoteExtension handler not set")
+	}
+
+	ctx, err := app.getContextForVoteExtension(
+		req.Height, req.Hash, sdk.ExecModeVerifyVoteExtension,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := app.verifyVoteExt(ctx, req)
+	if err != nil {
+		app.logger.Error(
+			"failed to verify vote extension",
+			"height",
+			req.Height,
+			"validator",
+			fmt.Sprintf("%X", req.ValidatorAddress),
+			"err",
+			err,
+		)
+		return &abci.VerifyVoteExtensionResponse{
+			Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		}, nil
+	}
+
+	return resp, nil
+}
+
+// getContextForVoteExtension returns the context used to extend or verify a
+// vote at the given height, branched off the last committed state. It
+// returns an error if vote extensions are not enabled at that height.
+func (app *BaseApp) getContextForVoteExtension(
+	height int64,
+	hash []byte,
+	mode sdk.ExecMode,
+) (sdk.Context, error) {
+	var ctx sdk.Context
+
+	// At the initial height, the state written during InitChain is not
+	// committed yet.
+	if height == app.initialHeight {
+		ctx, _ = app.finalizeBlockState.Context().CacheContext()
+	} else {
+		ctx = sdk.NewContext(app.cms.CacheMultiStore(), false, app.logger).
+			WithChainID(app.chainID).
+			WithBlockHeight(height)
+	}
+
+	// As a safety precaution, vote extensions must be enabled at the height
+	// of the vote.
+	cp := app.GetConsensusParams(ctx)
+	enableHeight := cp.GetFeature().GetVoteExtensionsEnableHeight().GetValue()
+	if enableHeight == 0 || height < enableHeight {
+		return ctx, fmt.Errorf(
+			"vote extensions are not enabled at height %d", height,
+		)
+	}
+
+	return ctx.
+		WithConsensusParams(cp).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithBlockHeight(height).
+		WithHeaderHash(hash).
+		WithExecMode(mode).
+		WithHeaderInfo(coreheader.Info{
+			ChainID: app.chainID,
+			Height:  height,
+			Hash:    hash,
+		}), nil
+}
+
 // internalFinalizeBlock executes the block, called by the Optimistic
 // Execution flow or by the FinalizeBlock ABCI method. The context received is
 // only used to handle early cancellation, for anything related to state
@@ -495,11 +616,18 @@ func (app *BaseApp) internalFinalizeBlock(
 		// continue
 	}
 
-	cp := app.GetConsensusParams(app.finalizeBlockState.Context())
+	// Consensus params are only handed to CometBFT when they change.
+	cpUpdates, err := app.paramStore.Update(
+		app.finalizeBlockState.Context(), req.Height,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &abci.FinalizeBlockResponse{
 		TxResults:             txResults,
 		ValidatorUpdates:      endBlock.ValidatorUpdates,
-		ConsensusParamUpdates: &cp,
+		ConsensusParamUpdates: cpUpdates,
 	}, nil
 }
 
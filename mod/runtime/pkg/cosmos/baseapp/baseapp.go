@@ -78,6 +78,11 @@ type BaseApp struct {
 	processProposal sdk.ProcessProposalHandler // ABCI ProcessProposal handler
 	prepareProposal sdk.PrepareProposalHandler // ABCI PrepareProposal handler
 
+	// extendVote and verifyVoteExt are the ABCI ExtendVote and
+	// VerifyVoteExtension handlers.
+	extendVote    sdk.ExtendVoteHandler
+	verifyVoteExt sdk.VerifyVoteExtensionHandler
+
 	// volatile states:
 	//
 	// - checkState is set on InitChain and reset on Commit
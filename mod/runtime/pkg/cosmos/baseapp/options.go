@@ -130,3 +130,16 @@ func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
 	app.prepareProposal = handler
 }
+
+// SetExtendVoteHandler sets the extend vote function for the BaseApp.
+func (app *BaseApp) SetExtendVoteHandler(handler sdk.ExtendVoteHandler) {
+	app.extendVote = handler
+}
+
+// SetVerifyVoteExtensionHandler sets the verify vote extension function for
+// the BaseApp.
+func (app *BaseApp) SetVerifyVoteExtensionHandler(
+	handler sdk.VerifyVoteExtensionHandler,
+) {
+	app.verifyVoteExt = handler
+}
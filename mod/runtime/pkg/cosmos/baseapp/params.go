@@ -34,4 +34,9 @@ type ParamStore interface {
 	Get(ctx context.Context) (cmtproto.ConsensusParams, error)
 	Has(ctx context.Context) (bool, error)
 	Set(ctx context.Context, cp cmtproto.ConsensusParams) error
+	// Update applies the consensus parameters scheduled for the given height,
+	// returning them if they differ from the ones in effect and nil otherwise.
+	Update(
+		ctx context.Context, height int64,
+	) (*cmtproto.ConsensusParams, error)
 }
@@ -24,13 +24,10 @@ import (
 	"context"
 	"time"
 
-	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/proto"
 	"golang.org/x/sync/errgroup"
@@ -47,52 +44,14 @@ func (h *ABCIMiddleware[
 	ctx context.Context,
 	bz []byte,
 ) (transition.ValidatorUpdates, error) {
-	var (
-		g          errgroup.Group
-		valUpdates transition.ValidatorUpdates
-		genesisErr error
-	)
 	data := new(GenesisT)
 	if err := json.Unmarshal(bz, data); err != nil {
 		return nil, err
 	}
-	// Send a request to the chain service to process the genesis data.
-	if err := h.genesisBroker.Publish(ctx, asynctypes.NewEvent(
+	// Request the chain service to process the genesis data.
+	return h.genesisDispatcher.Request(
 		ctx, events.GenesisDataProcessRequest, *data,
-	)); err != nil {
-		return nil, err
-	}
-
-	// Wait for the genesis data to be processed.
-	g.Go(func() error {
-		valUpdates, genesisErr = h.waitForGenesisData(ctx)
-		return genesisErr
-	})
-
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
-	return valUpdates, nil
-}
-
-// waitForGenesisData waits for the genesis data to be processed and returns
-// the validator updates.
-func (h *ABCIMiddleware[
-	_, _, _, _, _, GenesisT, _,
-]) waitForGenesisData(ctx context.Context) (
-	transition.ValidatorUpdates, error) {
-	select {
-	case msg := <-h.valUpdateSub:
-		if msg.Type() != events.ValidatorSetUpdated {
-			return nil, errors.Wrapf(
-				ErrUnexpectedEvent,
-				"unexpected event type: %s", msg.Type(),
-			)
-		}
-		return msg.Data(), msg.Error()
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	}
+	)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,70 +65,25 @@ func (h *ABCIMiddleware[
 	ctx context.Context,
 	slotData SlotDataT,
 ) ([]byte, []byte, error) {
-	var (
-		g                           errgroup.Group
-		startTime                   = time.Now()
-		beaconBlockErr, sidecarsErr error
-		beaconBlockBz, sidecarsBz   []byte
-	)
+	startTime := time.Now()
 	defer h.metrics.measurePrepareProposalDuration(startTime)
 
-	// Send a request to the validator service to give us a beacon block
-	// and blob sidecards to pass to ABCI.
-	if err := h.slotBroker.Publish(ctx, asynctypes.NewEvent(
-		ctx, events.NewSlot, slotData,
-	)); err != nil {
+	// Request the validator service to give us a beacon block and blob
+	// sidecars to pass to ABCI.
+	bundle, err := h.slotDispatcher.Request(ctx, events.NewSlot, slotData)
+	if err != nil {
 		return nil, nil, err
 	}
 
-	// Wait for the beacon block to be built.
-	g.Go(func() error {
-		beaconBlockBz, beaconBlockErr = h.waitforBeaconBlk(ctx)
-		return beaconBlockErr
-	})
-
-	// Wait for the sidecars to be built.
-	g.Go(func() error {
-		sidecarsBz, sidecarsErr = h.waitForSidecars(ctx)
-		return sidecarsErr
-	})
-
-	// Wait for both processes to complete and then
-	// return the appropriate response.
-	return beaconBlockBz, sidecarsBz, g.Wait()
-}
-
-// waitForSidecars waits for the sidecars to be built and returns them.
-func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
-]) waitForSidecars(ctx context.Context) ([]byte, error) {
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	case msg := <-h.sidecarsCh:
-		if msg.Error() != nil {
-			return nil, msg.Error()
-		}
-		return h.blobGossiper.Publish(ctx, msg.Data())
+	beaconBlockBz, err := h.beaconBlockGossiper.Publish(ctx, bundle.Block())
+	if err != nil {
+		return nil, nil, err
 	}
-}
-
-// waitforBeaconBlk waits for the beacon block to be built and returns it.
-func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
-]) waitforBeaconBlk(ctx context.Context) ([]byte, error) {
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	case beaconBlock := <-h.blkCh:
-		if beaconBlock.Error() != nil {
-			return nil, beaconBlock.Error()
-		}
-		return h.beaconBlockGossiper.Publish(
-			ctx,
-			beaconBlock.Data(),
-		)
+	sidecarsBz, err := h.blobGossiper.Publish(ctx, bundle.Sidecars())
+	if err != nil {
+		return nil, nil, err
 	}
+	return beaconBlockBz, sidecarsBz, nil
 }
 
 /* -------------------------------------------------------------------------- */
@@ -203,14 +117,19 @@ func (h *ABCIMiddleware[
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
 
+	// Check whether the validators attested to the availability of the blob
+	// sidecars of the parent block.
+	h.checkAvailabilityQuorum(abciReq, blk)
+
 	// Begin processing the beacon block.
 	g.Go(func() error {
 		return h.verifyBeaconBlock(ctx, blk)
 	})
 
-	// Request the blob sidecars.
+	// Request the blob sidecars, rejecting the proposal if they cannot be
+	// fetched since the block could otherwise not be finalized.
 	if sidecars, err = h.blobGossiper.Request(ctx, abciReq); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return h.createProcessProposalResponse(err)
 	}
 
 	// Begin processing the blob sidecars.
@@ -219,70 +138,40 @@ func (h *ABCIMiddleware[
 	})
 
 	// Wait for both processes to complete and then
-	// return the appropriate response.s
-	return h.createProcessProposalResponse(g.Wait())
+	// return the appropriate response. Once verified, the validator can
+	// attest to the availability of the sidecars.
+	if err = g.Wait(); err == nil {
+		h.verifiedProposals.add(
+			abciReq.Height, abciReq.Hash, blk.HashTreeRoot(),
+		)
+	}
+	return h.createProcessProposalResponse(err)
 }
 
-// verifyBeaconBlock handles the processing of the beacon block.
-// It requests the block, publishes a received event, and waits for
-// verification.
+// verifyBeaconBlock requests the beacon block to be verified and waits for
+// the response.
 func (h *ABCIMiddleware[
 	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
 ]) verifyBeaconBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
 ) error {
-	// Publish the received event.
-	if err := h.blkBroker.Publish(
-		ctx,
-		asynctypes.NewEvent(ctx, events.BeaconBlockReceived, blk, nil),
-	); err != nil {
-		return err
-	}
-
-	// Wait for a response.
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case msg := <-h.blkCh:
-		if msg.Type() != events.BeaconBlockVerified {
-			return errors.Wrapf(
-				ErrUnexpectedEvent, "unexpected event type: %s", msg.Type(),
-			)
-		}
-		return msg.Error()
-	}
+	_, err := h.blkDispatcher.Request(ctx, events.BeaconBlockReceived, blk)
+	return err
 }
 
-// processBlobSidecars handles the processing of blob sidecars.
-// It requests the sidecars, publishes a received event, and waits for
-// processing.
+// verifyBlobSidecars requests the blob sidecars to be verified and waits
+// for the response.
 func (h *ABCIMiddleware[
 	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
 ]) verifyBlobSidecars(
 	ctx context.Context,
 	sidecars BlobSidecarsT,
 ) error {
-	// Publish the received event.
-	if err := h.sidecarsBroker.Publish(
-		ctx,
-		asynctypes.NewEvent(ctx, events.BlobSidecarsReceived, sidecars),
-	); err != nil {
-		return err
-	}
-
-	// Wait for a response.
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case msg := <-h.sidecarsCh:
-		if msg.Type() != events.BlobSidecarsProcessed {
-			return errors.Wrapf(
-				ErrUnexpectedEvent, "unexpected event type: %s", msg.Type(),
-			)
-		}
-		return msg.Error()
-	}
+	_, err := h.sidecarsDispatcher.Request(
+		ctx, events.BlobSidecarsReceived, sidecars,
+	)
+	return err
 }
 
 // createResponse generates the appropriate ProcessProposalResponse based on the
@@ -327,22 +216,24 @@ func (h *ABCIMiddleware[
 ]) EndBlock(
 	ctx context.Context,
 ) (transition.ValidatorUpdates, error) {
-	blk, blobs, err := encoding.
-		ExtractBlobsAndBlockFromRequest[BeaconBlockT, BlobSidecarsT](
-		h.req,
-		BeaconBlockTxIndex,
-		BlobSidecarsTxIndex,
-		h.chainSpec.ActiveForkVersionForSlot(
-			math.Slot(h.req.Height),
-		))
+	blk, err := h.beaconBlockGossiper.Request(ctx, h.req)
 	if err != nil {
 		// If we don't have a block, we can't do anything.
 		//nolint:nilerr // by design.
 		return nil, nil
 	}
 
-	// Send the sidecars to the sidecars feed and wait for a response
-	if err = h.processSidecars(ctx, blobs); err != nil {
+	// The sidecars may only be announced by the proposal, in which case
+	// they were fetched while processing it. The block is committed, so it
+	// is finalized even if they are no longer available locally.
+	blobs, err := h.blobGossiper.RequestLocal(ctx, h.req)
+	if err != nil {
+		h.logger.Warn(
+			"Finalizing block without its blob sidecars",
+			"height", h.req.Height,
+			"error", err,
+		)
+	} else if err = h.processSidecars(ctx, blobs); err != nil {
 		return nil, err
 	}
 
@@ -352,57 +243,25 @@ func (h *ABCIMiddleware[
 	)
 }
 
-// processSidecars publishes the sidecars and waits for a response.
+// processSidecars requests the sidecars to be processed and waits for the
+// response.
 func (h *ABCIMiddleware[
 	_, _, BlobSidecarsT, _, _, _, _,
 ]) processSidecars(ctx context.Context, blobs BlobSidecarsT) error {
-	// Publish the sidecars.
-	if err := h.sidecarsBroker.Publish(ctx, asynctypes.NewEvent(
+	_, err := h.sidecarsDispatcher.Request(
 		ctx, events.BlobSidecarsProcessRequest, blobs,
-	)); err != nil {
-		return err
-	}
-
-	// Wait for the sidecars to be processed.
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case msg := <-h.sidecarsCh:
-		if msg.Type() != events.BlobSidecarsProcessed {
-			return errors.Wrapf(
-				ErrUnexpectedEvent,
-				"unexpected event type: %s", msg.Type(),
-			)
-		}
-		return msg.Error()
-	}
+	)
+	return err
 }
 
-// processBeaconBlock processes the beacon block and returns validator updates.
+// processBeaconBlock requests the beacon block to be finalized and returns
+// the validator updates.
 func (h *ABCIMiddleware[
 	_, BeaconBlockT, _, _, _, _, _,
 ]) processBeaconBlock(
 	ctx context.Context, blk BeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
-	// Publish the verified block event.
-	if err := h.blkBroker.Publish(
-		ctx, asynctypes.NewEvent(
-			ctx, events.BeaconBlockFinalizedRequest, blk,
-		)); err != nil {
-		return nil, err
-	}
-
-	// Wait for the block to be processed.
-	select {
-	case msg := <-h.valUpdateSub:
-		if msg.Type() != events.ValidatorSetUpdated {
-			return nil, errors.Wrapf(
-				ErrUnexpectedEvent,
-				"unexpected event type: %s", msg.Type(),
-			)
-		}
-		return msg.Data(), msg.Error()
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	}
+	return h.blkDispatcher.Request(
+		ctx, events.BeaconBlockFinalizedRequest, blk,
+	)
 }
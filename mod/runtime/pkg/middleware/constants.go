@@ -27,4 +27,9 @@ const (
 	// BlobSidecarsTxIndex represents the index of the blob sidecar transaction.
 	// It follows the beacon block transaction in the tx list.
 	BlobSidecarsTxIndex
+	// ExtendedCommitTxIndex represents the index of the extended commit of
+	// the previous height, carrying the blob availability attestations. It
+	// follows the blob sidecar transaction in the tx list once vote
+	// extensions are enabled.
+	ExtendedCommitTxIndex
 )
@@ -23,8 +23,6 @@ package middleware
 import "errors"
 
 var (
-	// ErrUnexpectedEvent is returned when an unexpected event is encountered.
-	ErrUnexpectedEvent = errors.New("unexpected event")
 	// ErrInvalidProcessProposalRequestType is returned when an invalid
 	// process proposal request type is encountered.
 	ErrInvalidProcessProposalRequestType = errors.New(
@@ -35,4 +33,19 @@ var (
 	ErrInvalidFinalizeBlockRequestType = errors.New(
 		"invalid pre block request type",
 	)
+	// ErrInvalidExtendVoteRequestType is returned when an invalid extend
+	// vote request type is encountered.
+	ErrInvalidExtendVoteRequestType = errors.New(
+		"invalid extend vote request type",
+	)
+	// ErrInvalidVerifyVoteExtensionRequestType is returned when an invalid
+	// verify vote extension request type is encountered.
+	ErrInvalidVerifyVoteExtensionRequestType = errors.New(
+		"invalid verify vote extension request type",
+	)
+	// ErrInvalidAvailabilityAttestation is returned when a vote extension
+	// is not a valid blob availability attestation.
+	ErrInvalidAvailabilityAttestation = errors.New(
+		"invalid availability attestation",
+	)
 )
@@ -56,3 +56,26 @@ func (cm *ABCIMiddlewareMetrics) measureProcessProposalDuration(
 		"beacon_kit.runtime.process_proposal_duration", start,
 	)
 }
+
+// setAvailabilityAttestedPower records the percentage of the voting power
+// that attested to the availability of the blob sidecars of a block.
+func (cm *ABCIMiddlewareMetrics) setAvailabilityAttestedPower(
+	attested, total int64,
+) {
+	if total == 0 {
+		return
+	}
+	cm.sink.SetGauge(
+		"beacon_kit.runtime.availability_attested_power_percent",
+		attested*100/total,
+	)
+}
+
+// markInsufficientAvailabilityQuorum increments the counter for the number of
+// blocks whose blob sidecars less than two thirds of the voting power
+// attested to.
+func (cm *ABCIMiddlewareMetrics) markInsufficientAvailabilityQuorum() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.runtime.insufficient_availability_quorum",
+	)
+}
@@ -24,13 +24,13 @@ import (
 	"context"
 	"encoding/json"
 
-	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/p2p"
+	"github.com/berachain/beacon-kit/mod/p2p/pkg/gossip"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
@@ -52,14 +52,9 @@ type ABCIMiddleware[
 ] struct {
 	// chainSpec is the chain specification.
 	chainSpec common.ChainSpec
-	// TODO: we will eventually gossip the blobs separately from
-	// CometBFT, but for now, these are no-op gossipers.
-	blobGossiper p2p.PublisherReceiver[
-		BlobSidecarsT,
-		[]byte,
-		encoding.ABCIRequest,
-		BlobSidecarsT,
-	]
+	// blobGossiper announces the blob sidecars in proposals for peers to
+	// fetch them out of band, falling back to carrying them inside.
+	blobGossiper BlobGossiper[BlobSidecarsT]
 	// TODO: we will eventually gossip the blocks separately from
 	// CometBFT, but for now, these are no-op gossipers.
 	beaconBlockGossiper p2p.PublisherReceiver[
@@ -73,28 +68,29 @@ type ABCIMiddleware[
 	// logger is the logger for the middleware.
 	logger log.Logger[any]
 
-	// Feeds
+	// Dispatchers
 	//
-	// genesisBroker is a feed for genesis data.
-	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]]
-	// blkBroker is a feed for blocks.
-	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]]
-	// sidecarsBroker is a feed for sidecars.
-	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]]
-	// slotBroker is a feed for slots.
-	slotBroker *broker.Broker[*asynctypes.Event[SlotDataT]]
+	// genesisDispatcher requests genesis data to be processed.
+	genesisDispatcher *dispatcher.Dispatcher[
+		GenesisT, transition.ValidatorUpdates,
+	]
+	// blkDispatcher requests blocks to be verified and finalized.
+	blkDispatcher *dispatcher.Dispatcher[
+		BeaconBlockT, transition.ValidatorUpdates,
+	]
+	// sidecarsDispatcher requests sidecars to be verified and processed.
+	sidecarsDispatcher *dispatcher.Dispatcher[BlobSidecarsT, struct{}]
+	// slotDispatcher requests blocks and sidecars to be built for a slot.
+	slotDispatcher *dispatcher.Dispatcher[
+		SlotDataT, *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	]
+
+	// verifiedProposals tracks the proposals whose blob sidecars were
+	// verified, for the validator to attest to their availability.
+	verifiedProposals *verifiedProposals
 
 	// TODO: this is a temporary hack.
 	req *cmtabci.FinalizeBlockRequest
-
-	// Channels
-	// blkCh is used to communicate the beacon block to the EndBlock method.
-	blkCh chan *asynctypes.Event[BeaconBlockT]
-	// sidecarsCh is used to communicate the sidecars to the EndBlock method.
-	sidecarsCh chan *asynctypes.Event[BlobSidecarsT]
-	// valUpdateSub is the channel for listening for incoming validator set
-	// updates.
-	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates]
 }
 
 // NewABCIMiddleware creates a new instance of the Handler struct.
@@ -113,11 +109,17 @@ func NewABCIMiddleware[
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
 	telemetrySink TelemetrySink,
-	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]],
-	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]],
-	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]],
-	slotBroker *broker.Broker[*asynctypes.Event[SlotDataT]],
-	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates],
+	gossipReactor *gossip.Reactor,
+	genesisDispatcher *dispatcher.Dispatcher[
+		GenesisT, transition.ValidatorUpdates,
+	],
+	blkDispatcher *dispatcher.Dispatcher[
+		BeaconBlockT, transition.ValidatorUpdates,
+	],
+	sidecarsDispatcher *dispatcher.Dispatcher[BlobSidecarsT, struct{}],
+	slotDispatcher *dispatcher.Dispatcher[
+		SlotDataT, *asynctypes.BlockBundle[BeaconBlockT, BlobSidecarsT],
+	],
 ) *ABCIMiddleware[
 	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
 	ExecutionPayloadT, GenesisT, SlotDataT,
@@ -127,30 +129,22 @@ func NewABCIMiddleware[
 		ExecutionPayloadT, GenesisT, SlotDataT,
 	]{
 		chainSpec: chainSpec,
-		blobGossiper: rp2p.NewNoopBlobHandler[
+		blobGossiper: rp2p.NewGossipBlobHandler[
 			BlobSidecarsT, encoding.ABCIRequest,
-		](),
+		](gossipReactor),
 		beaconBlockGossiper: rp2p.
 			NewNoopBlockGossipHandler[
 			BeaconBlockT, encoding.ABCIRequest,
 		](
 			chainSpec,
 		),
-		logger:         logger,
-		metrics:        newABCIMiddlewareMetrics(telemetrySink),
-		genesisBroker:  genesisBroker,
-		blkBroker:      blkBroker,
-		sidecarsBroker: sidecarsBroker,
-		slotBroker:     slotBroker,
-		blkCh: make(
-			chan *asynctypes.Event[BeaconBlockT],
-			1,
-		),
-		sidecarsCh: make(
-			chan *asynctypes.Event[BlobSidecarsT],
-			1,
-		),
-		valUpdateSub: valUpdateSub,
+		logger:             logger,
+		metrics:            newABCIMiddlewareMetrics(telemetrySink),
+		genesisDispatcher:  genesisDispatcher,
+		blkDispatcher:      blkDispatcher,
+		sidecarsDispatcher: sidecarsDispatcher,
+		slotDispatcher:     slotDispatcher,
+		verifiedProposals:  new(verifiedProposals),
 	}
 }
 
@@ -165,47 +159,6 @@ func (am *ABCIMiddleware[
 // Start the middleware.
 func (am *ABCIMiddleware[
 	_, _, _, _, _, _, _,
-]) Start(ctx context.Context) error {
-	subBlkCh, err := am.blkBroker.Subscribe()
-	if err != nil {
-		return err
-	}
-
-	subSidecarsCh, err := am.sidecarsBroker.Subscribe()
-	if err != nil {
-		return err
-	}
-
-	go am.start(ctx, subBlkCh, subSidecarsCh)
+]) Start(context.Context) error {
 	return nil
 }
-
-// start starts the middleware.
-func (am *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
-]) start(
-	ctx context.Context,
-	blkCh chan *asynctypes.Event[BeaconBlockT],
-	sidecarsCh chan *asynctypes.Event[BlobSidecarsT],
-) {
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case msg := <-blkCh:
-			switch msg.Type() {
-			case events.BeaconBlockBuilt:
-				fallthrough
-			case events.BeaconBlockVerified:
-				am.blkCh <- msg
-			}
-		case msg := <-sidecarsCh:
-			switch msg.Type() {
-			case events.BlobSidecarsBuilt:
-				fallthrough
-			case events.BlobSidecarsProcessed:
-				am.sidecarsCh <- msg
-			}
-		}
-	}
-}
@@ -25,24 +25,50 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/p2p"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 )
 
 // BeaconBlock is an interface for accessing the beacon block.
 type BeaconBlock[SelfT any] interface {
-	constraints.SSZMarshallable
+	constraints.SSZMarshallableRootable
 	constraints.Nillable
 	constraints.Empty[SelfT]
 	GetSlot() math.Slot
+	GetParentBlockRoot() common.Root
 	NewFromSSZ([]byte, uint32) (SelfT, error)
 }
 
+// BlobGossiper publishes the blob sidecars of proposals and receives those of
+// the proposals of peers.
+type BlobGossiper[BlobSidecarsT any] interface {
+	p2p.PublisherReceiver[
+		BlobSidecarsT,
+		[]byte,
+		encoding.ABCIRequest,
+		BlobSidecarsT,
+	]
+	// RequestLocal returns the blob sidecars of the proposal without
+	// fetching them from peers.
+	RequestLocal(
+		ctx context.Context, req encoding.ABCIRequest,
+	) (BlobSidecarsT, error)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the
+	// provided keys.
+	IncrementCounter(key string, args ...string)
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
 
 // BlockchainService defines the interface for interacting with the blockchain
@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// availabilityAttestationSize is the size of an encoded availability
+// attestation, that is a slot followed by a block root.
+const availabilityAttestationSize = 8 + 32
+
+// availabilityAttestation is the vote extension through which a validator
+// attests that it holds and has verified the blob sidecars of a block.
+type availabilityAttestation struct {
+	// Slot is the slot of the block.
+	Slot math.Slot
+	// BlockRoot is the root of the beacon block.
+	BlockRoot common.Root
+}
+
+// MarshalSSZ marshals the attestation into its SSZ encoding.
+func (a *availabilityAttestation) MarshalSSZ() ([]byte, error) {
+	bz := make([]byte, 0, availabilityAttestationSize)
+	bz = binary.LittleEndian.AppendUint64(bz, a.Slot.Unwrap())
+	return append(bz, a.BlockRoot[:]...), nil
+}
+
+// UnmarshalSSZ unmarshals the attestation from its SSZ encoding.
+func (a *availabilityAttestation) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != availabilityAttestationSize {
+		return ErrInvalidAvailabilityAttestation
+	}
+	a.Slot = math.Slot(binary.LittleEndian.Uint64(bz[:8]))
+	copy(a.BlockRoot[:], bz[8:])
+	return nil
+}
+
+// verifiedProposals tracks the proposals of the current height whose beacon
+// block and blob sidecars were verified, keyed by their CometBFT hash.
+type verifiedProposals struct {
+	mu     sync.Mutex
+	height int64
+	roots  map[string]common.Root
+}
+
+// add records the root of the beacon block of a verified proposal, dropping
+// the proposals of previous heights.
+func (v *verifiedProposals) add(height int64, hash []byte, root common.Root) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.roots == nil || v.height != height {
+		v.height, v.roots = height, make(map[string]common.Root)
+	}
+	v.roots[string(hash)] = root
+}
+
+// get returns the root of the beacon block of a verified proposal.
+func (v *verifiedProposals) get(
+	height int64, hash []byte,
+) (common.Root, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.height != height {
+		return common.Root{}, false
+	}
+	root, ok := v.roots[string(hash)]
+	return root, ok
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 ExtendVote                                 */
+/* -------------------------------------------------------------------------- */
+
+// ExtendVote extends the pre-commit vote of the validator with an attestation
+// to the availability of the blob sidecars of the proposal, provided it
+// verified them. Otherwise the vote carries an empty extension, abstaining
+// from attesting.
+func (h *ABCIMiddleware[
+	_, _, _, _, _, _, _,
+]) ExtendVote(
+	_ context.Context,
+	req proto.Message,
+) (proto.Message, error) {
+	abciReq, ok := req.(*cmtabci.ExtendVoteRequest)
+	if !ok {
+		return nil, ErrInvalidExtendVoteRequestType
+	}
+
+	root, ok := h.verifiedProposals.get(abciReq.Height, abciReq.Hash)
+	if !ok {
+		h.logger.Warn(
+			"Not attesting to the availability of unverified sidecars",
+			"height", abciReq.Height,
+		)
+		return &cmtabci.ExtendVoteResponse{VoteExtension: []byte{}}, nil
+	}
+
+	bz, err := (&availabilityAttestation{
+		//#nosec:G701 // block heights are never negative.
+		Slot:      math.Slot(abciReq.Height),
+		BlockRoot: root,
+	}).MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &cmtabci.ExtendVoteResponse{VoteExtension: bz}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             VerifyVoteExtension                            */
+/* -------------------------------------------------------------------------- */
+
+// VerifyVoteExtension verifies the vote extension of another validator. Empty
+// extensions abstain from attesting and are accepted, any other extension
+// must be an availability attestation for the height of the vote and, if the
+// proposal was verified locally, for the root of its beacon block.
+func (h *ABCIMiddleware[
+	_, _, _, _, _, _, _,
+]) VerifyVoteExtension(
+	_ context.Context,
+	req proto.Message,
+) (proto.Message, error) {
+	abciReq, ok := req.(*cmtabci.VerifyVoteExtensionRequest)
+	if !ok {
+		return nil, ErrInvalidVerifyVoteExtensionRequestType
+	}
+
+	status := cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT
+	if err := h.verifyAvailabilityAttestation(abciReq); err != nil {
+		h.logger.Warn(
+			"Rejecting vote extension",
+			"height", abciReq.Height,
+			"error", err,
+		)
+		status = cmtabci.VERIFY_VOTE_EXTENSION_STATUS_REJECT
+	}
+	return &cmtabci.VerifyVoteExtensionResponse{Status: status}, nil
+}
+
+// verifyAvailabilityAttestation verifies the availability attestation carried
+// by a vote extension, if any.
+func (h *ABCIMiddleware[
+	_, _, _, _, _, _, _,
+]) verifyAvailabilityAttestation(
+	req *cmtabci.VerifyVoteExtensionRequest,
+) error {
+	if len(req.VoteExtension) == 0 {
+		return nil
+	}
+
+	var attestation availabilityAttestation
+	if err := attestation.UnmarshalSSZ(req.VoteExtension); err != nil {
+		return err
+	}
+
+	//#nosec:G701 // block heights are never negative.
+	if attestation.Slot != math.Slot(req.Height) {
+		return errors.Wrapf(
+			ErrInvalidAvailabilityAttestation,
+			"attestation for slot %d at height %d",
+			attestation.Slot, req.Height,
+		)
+	}
+
+	root, ok := h.verifiedProposals.get(req.Height, req.Hash)
+	if ok && attestation.BlockRoot != root {
+		return errors.Wrapf(
+			ErrInvalidAvailabilityAttestation,
+			"attestation for block root %s, expected %s",
+			attestation.BlockRoot, root,
+		)
+	}
+	return nil
+}
+
+// checkAvailabilityQuorum checks whether more than two thirds of the voting
+// power of the previous height attested to the availability of the blob
+// sidecars of the parent of the given block. The quorum is advisory: a
+// validator that did not verify the sidecars in time abstains from attesting,
+// so falling short of it is only logged and measured, never rejected. The
+// signatures of the extended commit are verified by the consensus engine
+// before the proposal reaches the middleware.
+func (h *ABCIMiddleware[
+	_, BeaconBlockT, _, _, _, _, _,
+]) checkAvailabilityQuorum(
+	req *cmtabci.ProcessProposalRequest,
+	blk BeaconBlockT,
+) {
+	if !h.voteExtensionsEnabled(req.Height-1) ||
+		uint(len(req.Txs)) <= ExtendedCommitTxIndex {
+		return
+	}
+	var commit cmtabci.ExtendedCommitInfo
+	if err := commit.Unmarshal(req.Txs[ExtendedCommitTxIndex]); err != nil {
+		return
+	}
+
+	var (
+		attestation     availabilityAttestation
+		total, attested int64
+		//#nosec:G701 // block heights are never negative.
+		parentSlot = math.Slot(req.Height - 1)
+	)
+	for _, vote := range commit.Votes {
+		total += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit ||
+			attestation.UnmarshalSSZ(vote.VoteExtension) != nil {
+			continue
+		}
+		if attestation.Slot == parentSlot &&
+			attestation.BlockRoot == blk.GetParentBlockRoot() {
+			attested += vote.Validator.Power
+		}
+	}
+
+	h.metrics.setAvailabilityAttestedPower(attested, total)
+	// CometBFT caps the total voting power well below the overflow bound.
+	if attested*3 <= total*2 {
+		h.metrics.markInsufficientAvailabilityQuorum()
+		h.logger.Warn(
+			"Blob sidecars of the parent block lack an availability quorum",
+			"slot", parentSlot.Base10(),
+			"attested_power", attested,
+			"total_power", total,
+		)
+	}
+}
+
+// voteExtensionsEnabled returns whether validators extend their votes at the
+// given height.
+func (h *ABCIMiddleware[
+	_, _, _, _, _, _, _,
+]) voteExtensionsEnabled(height int64) bool {
+	enableHeight := h.chainSpec.VoteExtensionsEnableHeight()
+	//#nosec:G701 // heights never overflow an int64.
+	return enableHeight > 0 && height >= int64(enableHeight)
+}
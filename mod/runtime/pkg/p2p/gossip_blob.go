@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/p2p/pkg/gossip"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+)
+
+// minAnnouncedSize is the size from which encoded sidecars are announced
+// rather than carried inside the proposal, announcing smaller ones not being
+// worth a round trip.
+const minAnnouncedSize = 1 << 10
+
+// GossipBlobHandler announces blob sidecars in proposals by their KZG
+// commitments, leaving peers to fetch them out of band through the gossip
+// reactor. It falls back to carrying the sidecars inside the proposal
+// whenever the reactor is disabled, and accepts proposals carrying either.
+type GossipBlobHandler[
+	BlobSidecarsT interface {
+		constraints.SSZMarshallable
+		Empty() BlobSidecarsT
+	},
+	ReqT encoding.ABCIRequest,
+] struct {
+	NoopBlobHandler[BlobSidecarsT, ReqT]
+	reactor *gossip.Reactor
+}
+
+// NewGossipBlobHandler creates a new gossip blob handler.
+func NewGossipBlobHandler[
+	BlobSidecarsT interface {
+		constraints.SSZMarshallable
+		Empty() BlobSidecarsT
+	},
+	ReqT encoding.ABCIRequest,
+](
+	reactor *gossip.Reactor,
+) GossipBlobHandler[BlobSidecarsT, ReqT] {
+	return GossipBlobHandler[BlobSidecarsT, ReqT]{
+		NoopBlobHandler: NewNoopBlobHandler[BlobSidecarsT, ReqT](),
+		reactor:         reactor,
+	}
+}
+
+// Publish returns the announcement of the sidecars to include in the
+// proposal, or the sidecars themselves if they are not announced.
+func (h GossipBlobHandler[BlobSidecarsT, _]) Publish(
+	ctx context.Context,
+	sidecars BlobSidecarsT,
+) ([]byte, error) {
+	bz, err := h.NoopBlobHandler.Publish(ctx, sidecars)
+	if err != nil || !h.reactor.Enabled() || len(bz) < minAnnouncedSize {
+		return bz, err
+	}
+	return h.reactor.Announce(bz)
+}
+
+// Request returns the sidecars of the proposal, fetching them if the proposal
+// only announces them.
+func (h GossipBlobHandler[BlobSidecarsT, ReqT]) Request(
+	ctx context.Context,
+	req ReqT,
+) (BlobSidecarsT, error) {
+	txs := req.GetTxs()
+	if uint(len(txs)) <= blobSidecarsTxIndex ||
+		!gossip.IsAnnouncement(txs[blobSidecarsTxIndex]) {
+		return h.NoopBlobHandler.Request(ctx, req)
+	}
+
+	var sidecars BlobSidecarsT
+	//#nosec:G701 // heights are never negative.
+	bz, err := h.reactor.Fetch(
+		ctx, uint64(req.GetHeight()), txs[blobSidecarsTxIndex],
+	)
+	if err != nil {
+		return sidecars, err
+	}
+	sidecars = sidecars.Empty()
+	return sidecars, sidecars.UnmarshalSSZ(bz)
+}
+
+// RequestLocal returns the sidecars of the proposal without fetching them
+// from peers, such that a committed block never depends on the network.
+func (h GossipBlobHandler[BlobSidecarsT, ReqT]) RequestLocal(
+	ctx context.Context,
+	req ReqT,
+) (BlobSidecarsT, error) {
+	txs := req.GetTxs()
+	if uint(len(txs)) <= blobSidecarsTxIndex ||
+		!gossip.IsAnnouncement(txs[blobSidecarsTxIndex]) {
+		return h.NoopBlobHandler.Request(ctx, req)
+	}
+
+	var sidecars BlobSidecarsT
+	//#nosec:G701 // heights are never negative.
+	bz, err := h.reactor.Lookup(
+		uint64(req.GetHeight()), txs[blobSidecarsTxIndex],
+	)
+	if err != nil {
+		return sidecars, err
+	}
+	sidecars = sidecars.Empty()
+	return sidecars, sidecars.UnmarshalSSZ(bz)
+}
@@ -27,6 +27,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 )
 
+// blobSidecarsTxIndex is the index of the blob sidecars in a proposal.
+const blobSidecarsTxIndex = 1
+
 // NoopBlobHandler is a gossip handler that simply returns the
 // ssz marshalled data as a "reference" to the object it receives.
 type NoopBlobHandler[
@@ -65,6 +68,6 @@ func (n NoopBlobHandler[BlobT, ReqT]) Request(
 ) (BlobT, error) {
 	return encoding.UnmarshalBlobSidecarsFromABCIRequest[BlobT](
 		req,
-		1,
+		blobSidecarsTxIndex,
 	)
 }
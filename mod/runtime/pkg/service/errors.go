@@ -24,6 +24,14 @@ package service
 import "github.com/berachain/beacon-kit/mod/errors"
 
 var (
+	// ErrServiceNotRunning is returned as the health of a service that is
+	// not running.
+	ErrServiceNotRunning = errors.New("service not running")
+
+	// ErrDependencyCycle is returned when the dependencies of the services
+	// form a cycle, such that there is no order to start them in.
+	ErrDependencyCycle = errors.New("service dependency cycle")
+
 	// errServiceAlreadyExists defines an error for when a service already
 	// exists.
 	errServiceAlreadyExists = func(serviceName string) error {
@@ -43,4 +51,24 @@ var (
 	errUnknownService = func(serviceType interface{}) error {
 		return errors.Newf("unknown service: %T", serviceType)
 	}
+
+	// errUnknownDependency is returned when a service depends on a service
+	// that is not registered.
+	errUnknownDependency = func(serviceName, dependency string) error {
+		return errors.Newf(
+			"service %v depends on unknown service: %v",
+			serviceName,
+			dependency,
+		)
+	}
+
+	// errServiceStartFailed is returned when a service fails to start.
+	errServiceStartFailed = func(serviceName string, err error) error {
+		return errors.Wrapf(err, "failed to start service %v", serviceName)
+	}
+
+	// errServiceStopFailed is returned when a service fails to stop.
+	errServiceStopFailed = func(serviceName string, err error) error {
+		return errors.Wrapf(err, "failed to stop service %v", serviceName)
+	}
 )
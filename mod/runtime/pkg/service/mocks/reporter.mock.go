@@ -0,0 +1,172 @@
+// Code generated by mockery v2.44.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Reporter is an autogenerated mock type for the Reporter type
+type Reporter struct {
+	mock.Mock
+}
+
+type Reporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Reporter) EXPECT() *Reporter_Expecter {
+	return &Reporter_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function with given fields:
+func (_m *Reporter) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Reporter_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type Reporter_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *Reporter_Expecter) Name() *Reporter_Name_Call {
+	return &Reporter_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *Reporter_Name_Call) Run(run func()) *Reporter_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Reporter_Name_Call) Return(_a0 string) *Reporter_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Reporter_Name_Call) RunAndReturn(run func() string) *Reporter_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *Reporter) Start(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Reporter_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type Reporter_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Reporter_Expecter) Start(ctx interface{}) *Reporter_Start_Call {
+	return &Reporter_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *Reporter_Start_Call) Run(run func(ctx context.Context)) *Reporter_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Reporter_Start_Call) Return(_a0 error) *Reporter_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Reporter_Start_Call) RunAndReturn(run func(context.Context) error) *Reporter_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with given fields:
+func (_m *Reporter) Status() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Reporter_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type Reporter_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+func (_e *Reporter_Expecter) Status() *Reporter_Status_Call {
+	return &Reporter_Status_Call{Call: _e.mock.On("Status")}
+}
+
+func (_c *Reporter_Status_Call) Run(run func()) *Reporter_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Reporter_Status_Call) Return(_a0 error) *Reporter_Status_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Reporter_Status_Call) RunAndReturn(run func() error) *Reporter_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReporter creates a new instance of Reporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Reporter {
+	mock := &Reporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.44.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Stoppable is an autogenerated mock type for the Stoppable type
+type Stoppable struct {
+	mock.Mock
+}
+
+type Stoppable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Stoppable) EXPECT() *Stoppable_Expecter {
+	return &Stoppable_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function with given fields:
+func (_m *Stoppable) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Stoppable_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type Stoppable_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *Stoppable_Expecter) Name() *Stoppable_Name_Call {
+	return &Stoppable_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *Stoppable_Name_Call) Run(run func()) *Stoppable_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Stoppable_Name_Call) Return(_a0 string) *Stoppable_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Stoppable_Name_Call) RunAndReturn(run func() string) *Stoppable_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *Stoppable) Start(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Stoppable_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type Stoppable_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Stoppable_Expecter) Start(ctx interface{}) *Stoppable_Start_Call {
+	return &Stoppable_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *Stoppable_Start_Call) Run(run func(ctx context.Context)) *Stoppable_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Stoppable_Start_Call) Return(_a0 error) *Stoppable_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Stoppable_Start_Call) RunAndReturn(run func(context.Context) error) *Stoppable_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields:
+func (_m *Stoppable) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Stoppable_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type Stoppable_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *Stoppable_Expecter) Stop() *Stoppable_Stop_Call {
+	return &Stoppable_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *Stoppable_Stop_Call) Run(run func()) *Stoppable_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Stoppable_Stop_Call) Return(_a0 error) *Stoppable_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Stoppable_Stop_Call) RunAndReturn(run func() error) *Stoppable_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStoppable creates a new instance of Stoppable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStoppable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Stoppable {
+	mock := &Stoppable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
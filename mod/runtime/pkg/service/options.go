@@ -33,9 +33,10 @@ func WithLogger(logger log.Logger[any]) RegistryOption {
 	}
 }
 
-// WithService is an Option that registers a service with the Registry.
-func WithService(svc Basic) RegistryOption {
+// WithService is an Option that registers a service with the Registry. The
+// given dependencies are started before the service.
+func WithService(svc Basic, dependencies ...Basic) RegistryOption {
 	return func(r *Registry) error {
-		return r.RegisterService(svc)
+		return r.RegisterService(svc, dependencies...)
 	}
 }
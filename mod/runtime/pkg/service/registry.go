@@ -23,7 +23,9 @@ package service
 import (
 	"context"
 	"reflect"
+	"sync"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -35,6 +37,21 @@ type Basic interface {
 	Name() string
 }
 
+// Stoppable is a service holding resources that must be released when the
+// node shuts down.
+type Stoppable interface {
+	Basic
+	// Stop stops the service, waiting for its goroutines to exit.
+	Stop() error
+}
+
+// Reporter is a service reporting its health.
+type Reporter interface {
+	Basic
+	// Status returns an error if the service is unhealthy.
+	Status() error
+}
+
 // Registry provides a useful pattern for managing services.
 // It allows for ease of dependency management and ensures services
 // dependent on others use the same references in memory.
@@ -45,12 +62,27 @@ type Registry struct {
 	services map[string]Basic
 	// serviceTypes is an ordered slice of registered service types.
 	serviceTypes []string
+	// dependencies is a map of service type -> the service types that must
+	// be started before it.
+	dependencies map[string][]string
+
+	// mu protects the lifecycle of the services below.
+	mu sync.RWMutex
+	// started is the ordered slice of the service types started.
+	started []string
+	// failures is a map of service type -> the error it failed to start or
+	// stop with.
+	failures map[string]error
+	// cancel cancels the context the services were started with.
+	cancel context.CancelFunc
 }
 
 // NewRegistry starts a registry instance for convenience.
 func NewRegistry(opts ...RegistryOption) *Registry {
 	r := &Registry{
-		services: make(map[string]Basic),
+		services:     make(map[string]Basic),
+		dependencies: make(map[string][]string),
+		failures:     make(map[string]error),
 	}
 
 	for _, opt := range opts {
@@ -61,33 +93,131 @@ func NewRegistry(opts ...RegistryOption) *Registry {
 	return r
 }
 
-// StartAll initialized each service in order of registration.
+// StartAll initialized each service in order of registration, starting the
+// dependencies of a service before it. If a service fails to start, the
+// services already started are stopped and the error is returned.
 func (s *Registry) StartAll(ctx context.Context) error {
-	s.logger.Info("Starting services", "num", len(s.serviceTypes))
-	for _, typeName := range s.serviceTypes {
+	order, err := s.startOrder()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.mu.Unlock()
+
+	s.logger.Info("Starting services", "num", len(order))
+	for _, typeName := range order {
 		s.logger.Info("Starting service", "type", typeName)
-		svc := s.services[typeName]
-		if svc == nil {
-			s.logger.Error("service not found", "type", typeName)
-			continue
+		err = s.services[typeName].Start(ctx)
+
+		s.mu.Lock()
+		if err != nil {
+			s.failures[typeName] = err
+		} else {
+			s.started = append(s.started, typeName)
 		}
+		s.mu.Unlock()
 
-		if err := svc.Start(ctx); err != nil {
-			return err
+		if err != nil {
+			s.logger.Error(
+				"Failed to start service", "type", typeName, "error", err,
+			)
+			return errors.Join(
+				errServiceStartFailed(typeName, err), s.StopAll(),
+			)
 		}
 	}
 	return nil
 }
 
+// StopAll stops the started services in the reverse order they were started,
+// such that no service is stopped before the services depending on it, and
+// then cancels the context they were started with.
+func (s *Registry) StopAll() error {
+	s.mu.Lock()
+	started, cancel := s.started, s.cancel
+	s.started, s.cancel = nil, nil
+	s.mu.Unlock()
+
+	var errs []error
+	for i := len(started) - 1; i >= 0; i-- {
+		typeName := started[i]
+		svc, ok := s.services[typeName].(Stoppable)
+		if !ok {
+			continue
+		}
+
+		s.logger.Info("Stopping service", "type", typeName)
+		if err := svc.Stop(); err != nil {
+			s.logger.Error(
+				"Failed to stop service", "type", typeName, "error", err,
+			)
+			s.mu.Lock()
+			s.failures[typeName] = err
+			s.mu.Unlock()
+			errs = append(errs, errServiceStopFailed(typeName, err))
+		}
+	}
+
+	if cancel != nil {
+		cancel()
+	}
+	return errors.Join(errs...)
+}
+
+// Health returns the health of each registered service, keyed by its type.
+// A service is healthy, with a nil error, if it is running and does not
+// report an error.
+func (s *Registry) Health() map[string]error {
+	s.mu.RLock()
+	running := make(map[string]struct{}, len(s.started))
+	for _, typeName := range s.started {
+		running[typeName] = struct{}{}
+	}
+	failures := make(map[string]error, len(s.failures))
+	for typeName, err := range s.failures {
+		failures[typeName] = err
+	}
+	s.mu.RUnlock()
+
+	health := make(map[string]error, len(s.serviceTypes))
+	for _, typeName := range s.serviceTypes {
+		if err, failed := failures[typeName]; failed {
+			health[typeName] = err
+			continue
+		}
+		if _, ok := running[typeName]; !ok {
+			health[typeName] = ErrServiceNotRunning
+			continue
+		}
+		if svc, ok := s.services[typeName].(Reporter); ok {
+			health[typeName] = svc.Status()
+			continue
+		}
+		health[typeName] = nil
+	}
+	return health
+}
+
 // RegisterService appends a service constructor function to the service
-// registry.
-func (s *Registry) RegisterService(service Basic) error {
+// registry. The given dependencies are started before the service, and
+// stopped after it.
+func (s *Registry) RegisterService(
+	service Basic,
+	dependencies ...Basic,
+) error {
 	typeName := service.Name()
 	if _, exists := s.services[typeName]; exists {
 		return errServiceAlreadyExists(typeName)
 	}
 	s.services[typeName] = service
 	s.serviceTypes = append(s.serviceTypes, typeName)
+	for _, dependency := range dependencies {
+		s.dependencies[typeName] = append(
+			s.dependencies[typeName], dependency.Name(),
+		)
+	}
 	return nil
 }
 
@@ -123,3 +253,50 @@ func (s *Registry) FetchService(service interface{}) error {
 	}
 	return errUnknownService(serviceType)
 }
+
+// startOrder returns the order to start the services in. The services are
+// started in order of registration, unless a service has to wait for its
+// dependencies to be started.
+func (s *Registry) startOrder() ([]string, error) {
+	for typeName, dependencies := range s.dependencies {
+		for _, dependency := range dependencies {
+			if _, ok := s.services[dependency]; !ok {
+				return nil, errUnknownDependency(typeName, dependency)
+			}
+		}
+	}
+
+	order := make([]string, 0, len(s.serviceTypes))
+	placed := make(map[string]struct{}, len(s.serviceTypes))
+	for len(order) < len(s.serviceTypes) {
+		next := s.nextToStart(placed)
+		if next == "" {
+			return nil, ErrDependencyCycle
+		}
+		order = append(order, next)
+		placed[next] = struct{}{}
+	}
+	return order, nil
+}
+
+// nextToStart returns the first registered service which is not placed yet
+// and whose dependencies are all placed, or an empty string if there is
+// none.
+func (s *Registry) nextToStart(placed map[string]struct{}) string {
+	for _, typeName := range s.serviceTypes {
+		if _, ok := placed[typeName]; ok {
+			continue
+		}
+		ready := true
+		for _, dependency := range s.dependencies[typeName] {
+			if _, ok := placed[dependency]; !ok {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			return typeName
+		}
+	}
+	return ""
+}
@@ -22,6 +22,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -81,3 +82,142 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+func TestRegistry_StartAll_DependencyOrder(t *testing.T) {
+	var order []string
+	dependent := &mocks.Basic{}
+	dependent.On("Name").Return("Dependent")
+	dependent.On("Start", mock.Anything).Return(nil).Run(
+		func(mock.Arguments) { order = append(order, "Dependent") },
+	).Once()
+
+	dependency := &mocks.Basic{}
+	dependency.On("Name").Return("Dependency")
+	dependency.On("Start", mock.Anything).Return(nil).Run(
+		func(mock.Arguments) { order = append(order, "Dependency") },
+	).Once()
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(dependent, dependency),
+		service.WithService(dependency),
+	)
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	require.Equal(t, []string{"Dependency", "Dependent"}, order)
+}
+
+func TestRegistry_StartAll_DependencyCycle(t *testing.T) {
+	service1 := &mocks.Basic{}
+	service1.On("Name").Return("Service1")
+	service2 := &mocks.Basic{}
+	service2.On("Name").Return("Service2")
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(service1, service2),
+		service.WithService(service2, service1),
+	)
+
+	require.ErrorIs(
+		t,
+		registry.StartAll(context.Background()),
+		service.ErrDependencyCycle,
+	)
+	service1.AssertNotCalled(t, "Start", mock.Anything)
+	service2.AssertNotCalled(t, "Start", mock.Anything)
+}
+
+func TestRegistry_StartAll_Failure(t *testing.T) {
+	errStart := errors.New("failed to start")
+
+	service1 := &mocks.Stoppable{}
+	service1.On("Name").Return("Service1")
+	service1.On("Start", mock.Anything).Return(nil).Once()
+	service1.On("Stop").Return(nil).Once()
+
+	service2 := &mocks.Basic{}
+	service2.On("Name").Return("Service2")
+	service2.On("Start", mock.Anything).Return(errStart).Once()
+
+	service3 := &mocks.Basic{}
+	service3.On("Name").Return("Service3")
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(service1),
+		service.WithService(service2),
+		service.WithService(service3),
+	)
+
+	require.ErrorIs(t, registry.StartAll(context.Background()), errStart)
+	service1.AssertCalled(t, "Stop")
+	service3.AssertNotCalled(t, "Start", mock.Anything)
+
+	health := registry.Health()
+	require.ErrorIs(t, health["Service1"], service.ErrServiceNotRunning)
+	require.ErrorIs(t, health["Service2"], errStart)
+	require.ErrorIs(t, health["Service3"], service.ErrServiceNotRunning)
+}
+
+func TestRegistry_StopAll(t *testing.T) {
+	var (
+		order   []string
+		stopped = func(name string) func(mock.Arguments) {
+			return func(mock.Arguments) { order = append(order, name) }
+		}
+		errStop = errors.New("failed to stop")
+	)
+
+	service1 := &mocks.Stoppable{}
+	service1.On("Name").Return("Service1")
+	service1.On("Start", mock.Anything).Return(nil).Once()
+	service1.On("Stop").Return(nil).Run(stopped("Service1")).Once()
+
+	service2 := &mocks.Stoppable{}
+	service2.On("Name").Return("Service2")
+	service2.On("Start", mock.Anything).Return(nil).Once()
+	service2.On("Stop").Return(errStop).Run(stopped("Service2")).Once()
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(service1),
+		service.WithService(service2, service1),
+	)
+
+	ctx := context.Background()
+	require.NoError(t, registry.StartAll(ctx))
+	require.ErrorIs(t, registry.StopAll(), errStop)
+	require.Equal(t, []string{"Service2", "Service1"}, order)
+
+	// Services are only stopped once.
+	require.NoError(t, registry.StopAll())
+}
+
+func TestRegistry_Health(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+
+	healthy := &mocks.Basic{}
+	healthy.On("Name").Return("Healthy")
+	healthy.On("Start", mock.Anything).Return(nil).Once()
+
+	unhealthy := &mocks.Reporter{}
+	unhealthy.On("Name").Return("Unhealthy")
+	unhealthy.On("Start", mock.Anything).Return(nil).Once()
+	unhealthy.On("Status").Return(errUnhealthy)
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(healthy),
+		service.WithService(unhealthy),
+	)
+
+	health := registry.Health()
+	require.ErrorIs(t, health["Healthy"], service.ErrServiceNotRunning)
+	require.ErrorIs(t, health["Unhealthy"], service.ErrServiceNotRunning)
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	health = registry.Health()
+	require.NoError(t, health["Healthy"])
+	require.ErrorIs(t, health["Unhealthy"], errUnhealthy)
+}
@@ -46,6 +46,15 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDepositAfterRequestsStart is returned when a block includes a log
+	// based deposit at or after the deposit requests start index.
+	ErrDepositAfterRequestsStart = errors.New(
+		"deposit index at or after deposit requests start index")
+
+	// ErrInvalidDepositRequests is returned when the deposit requests of an
+	// execution payload are not a list of SSZ encoded deposits.
+	ErrInvalidDepositRequests = errors.New("invalid deposit requests")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
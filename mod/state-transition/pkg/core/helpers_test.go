@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testKVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	testBeaconState = state.StateDB[
+		*types.BeaconBlockHeader,
+		*types.BeaconState[
+			*types.BeaconBlockHeader,
+			*types.Eth1Data,
+			*types.ExecutionPayloadHeader,
+			*types.Fork,
+			*types.Validator,
+			types.BeaconBlockHeader,
+			types.Eth1Data,
+			types.ExecutionPayloadHeader,
+			types.Fork,
+			types.Validator,
+		],
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+
+	testStateProcessor = StateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*testBeaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	]
+)
+
+const (
+	testMaxEffectiveBalance = 32e9
+	testEjectionBalance     = 16e9
+)
+
+// testSourceAddress is the address the withdrawal credentials of the
+// validators of the test state point to.
+var testSourceAddress = common.ExecutionAddress{0x01}
+
+// newTestState returns a state processor and a beacon state at the given
+// slot, with a validator for each of the given balances whose withdrawal
+// credentials point to testSourceAddress.
+func newTestState(
+	t *testing.T,
+	slot math.Slot,
+	balances ...math.Gwei,
+) (*testStateProcessor, *testBeaconState) {
+	t.Helper()
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		MaxEffectiveBalance:              testMaxEffectiveBalance,
+		EjectionBalance:                  testEjectionBalance,
+		EffectiveBalanceIncrement:        1e9,
+		SlotsPerEpoch:                    32,
+		MaxDepositsPerBlock:              1,
+		MaxWithdrawalsPerPayload:         16,
+		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
+	})
+
+	kv := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		kvStoreService{memKVStore: newMemKVStore()},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	).WithContext(context.Background())
+	st := (&testBeaconState{}).NewFromDB(kv, cs)
+
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetNextWithdrawalIndex(0))
+	require.NoError(t, st.SetNextWithdrawalValidatorIndex(0))
+	for i, balance := range balances {
+		require.NoError(t, st.AddValidator(types.NewValidatorFromDeposit(
+			crypto.BLSPubkey{byte(i + 1)},
+			types.NewCredentialsFromExecutionAddress(testSourceAddress),
+			balance,
+			math.Gwei(cs.EffectiveBalanceIncrement()),
+			math.Gwei(cs.MaxEffectiveBalance()),
+		)))
+		require.NoError(t, st.SetBalance(math.ValidatorIndex(i), balance))
+	}
+
+	return NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*testBeaconState,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](cs, nil, nil), st
+}
+
+// executionRequests encodes the given requests as the execution requests of
+// a payload.
+func executionRequests(
+	t *testing.T,
+	requestType byte,
+	requests ...interface{ MarshalSSZ() ([]byte, error) },
+) []bytes.Bytes {
+	t.Helper()
+	data := []byte{requestType}
+	for _, req := range requests {
+		bz, err := req.MarshalSSZ()
+		require.NoError(t, err)
+		data = append(data, bz...)
+	}
+	return []bytes.Bytes{data}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // BeaconState is the interface for the beacon state. It
@@ -110,6 +111,12 @@ type WriteOnlyBeaconState[
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 	SetTotalSlashing(math.Gwei) error
+	EnqueuePendingDeposit(uint64, []byte) error
+	DequeuePendingDeposits(uint64) ([][]byte, error)
+	EnqueuePendingPartialWithdrawal(transition.PendingPartialWithdrawal) error
+	DequeuePendingPartialWithdrawals(uint64) error
+	EnqueuePendingConsolidation(transition.PendingConsolidation) error
+	RemovePendingConsolidation(math.ValidatorIndex) error
 }
 
 // WriteOnlyStateRoots defines a struct which only has write access to state
@@ -162,6 +169,7 @@ type ReadOnlyValidators[ValidatorT any] interface {
 type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(Eth1DataT) error
 	SetEth1DepositIndex(uint64) error
+	SetDepositRequestsStartIndex(uint64) error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
 	) error
@@ -171,6 +179,7 @@ type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (Eth1DataT, error)
 	GetEth1DepositIndex() (uint64, error)
+	GetDepositRequestsStartIndex() (uint64, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
@@ -179,4 +188,11 @@ type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 // ReadOnlyWithdrawals only has read access to withdrawal methods.
 type ReadOnlyWithdrawals[WithdrawalT any] interface {
 	ExpectedWithdrawals() ([]WithdrawalT, error)
+	PendingPartialWithdrawalsForSweep() (
+		[]transition.PendingPartialWithdrawal, error,
+	)
+	GetPendingPartialWithdrawals() (
+		[]transition.PendingPartialWithdrawal, error,
+	)
+	GetPendingConsolidations() ([]transition.PendingConsolidation, error)
 }
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sort"
+
+	"cosmossdk.io/core/store"
+)
+
+// kvStoreService is an in-memory store.KVStoreService for tests.
+type kvStoreService struct {
+	*memKVStore
+}
+
+func (s kvStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.memKVStore
+}
+
+// memKVStore is a minimal in-memory store.KVStore.
+type memKVStore struct {
+	data map[string][]byte
+}
+
+func newMemKVStore() *memKVStore {
+	return &memKVStore{data: make(map[string][]byte)}
+}
+
+func (s *memKVStore) Get(key []byte) ([]byte, error) {
+	return s.data[string(key)], nil
+}
+
+func (s *memKVStore) Has(key []byte) (bool, error) {
+	_, ok := s.data[string(key)]
+	return ok, nil
+}
+
+func (s *memKVStore) Set(key, value []byte) error {
+	s.data[string(key)] = slices.Clone(value)
+	return nil
+}
+
+func (s *memKVStore) Delete(key []byte) error {
+	delete(s.data, string(key))
+	return nil
+}
+
+func (s *memKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return s.newIterator(start, end, false), nil
+}
+
+func (s *memKVStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	return s.newIterator(start, end, true), nil
+}
+
+// newIterator snapshots the keys in [start, end), where nil bounds are
+// unbounded.
+func (s *memKVStore) newIterator(
+	start, end []byte, reverse bool,
+) *memIterator {
+	keys := make([]string, 0, len(s.data))
+	for key := range s.data {
+		if (start == nil || bytes.Compare([]byte(key), start) >= 0) &&
+			(end == nil || bytes.Compare([]byte(key), end) < 0) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if reverse {
+		slices.Reverse(keys)
+	}
+
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		values[i] = s.data[key]
+	}
+	return &memIterator{start: start, end: end, keys: keys, values: values}
+}
+
+// memIterator iterates over a snapshot of a memKVStore.
+type memIterator struct {
+	start, end []byte
+	keys       []string
+	values     [][]byte
+}
+
+func (it *memIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+
+func (it *memIterator) Valid() bool { return len(it.keys) > 0 }
+
+func (it *memIterator) Next() {
+	it.keys, it.values = it.keys[1:], it.values[1:]
+}
+
+func (it *memIterator) Key() []byte { return []byte(it.keys[0]) }
+
+func (it *memIterator) Value() []byte { return it.values[0] }
+
+func (*memIterator) Error() error { return nil }
+
+func (*memIterator) Close() error { return nil }
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // KVStore is the interface for the key-value store holding the beacon state.
@@ -59,6 +60,18 @@ type KVStore[
 	SetEth1DepositIndex(
 		index uint64,
 	) error
+	// GetDepositRequestsStartIndex retrieves the index of the first deposit
+	// received through an execution request.
+	GetDepositRequestsStartIndex() (uint64, error)
+	// SetDepositRequestsStartIndex sets the index of the first deposit
+	// received through an execution request.
+	SetDepositRequestsStartIndex(index uint64) error
+	// EnqueuePendingDeposit appends an SSZ encoded deposit to the pending
+	// deposits queue.
+	EnqueuePendingDeposit(index uint64, deposit []byte) error
+	// DequeuePendingDeposits removes and returns up to n SSZ encoded deposits
+	// from the pending deposits queue.
+	DequeuePendingDeposits(n uint64) ([][]byte, error)
 	// GetBalance retrieves the balance of a validator.
 	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	// SetBalance sets the balance of a validator.
@@ -100,6 +113,26 @@ type KVStore[
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 	// SetNextWithdrawalValidatorIndex sets the next withdrawal validator index.
 	SetNextWithdrawalValidatorIndex(index math.ValidatorIndex) error
+	// EnqueuePendingPartialWithdrawal adds a pending partial withdrawal.
+	EnqueuePendingPartialWithdrawal(
+		withdrawal transition.PendingPartialWithdrawal,
+	) error
+	// GetPendingPartialWithdrawals retrieves the pending partial withdrawals.
+	GetPendingPartialWithdrawals() (
+		[]transition.PendingPartialWithdrawal, error,
+	)
+	// DequeuePendingPartialWithdrawals removes the first n pending partial
+	// withdrawals.
+	DequeuePendingPartialWithdrawals(n uint64) error
+	// EnqueuePendingConsolidation adds a pending consolidation.
+	EnqueuePendingConsolidation(
+		consolidation transition.PendingConsolidation,
+	) error
+	// GetPendingConsolidations retrieves the pending consolidations.
+	GetPendingConsolidations() ([]transition.PendingConsolidation, error)
+	// RemovePendingConsolidation removes the pending consolidation of the
+	// given source validator.
+	RemovePendingConsolidation(sourceIndex math.ValidatorIndex) error
 	// GetTotalSlashing retrieves the total slashing.
 	GetTotalSlashing() (math.Gwei, error)
 	// SetTotalSlashing sets the total slashing.
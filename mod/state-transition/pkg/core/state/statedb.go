@@ -23,7 +23,9 @@ package state
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // StateDB is the underlying struct behind the BeaconState interface.
@@ -214,6 +216,49 @@ func (s *StateDB[
 		return nil, err
 	}
 
+	// Pay out the pending partial withdrawals first, keeping the balance of
+	// the validators above the ejection balance.
+	partials, err := s.PendingPartialWithdrawalsForSweep()
+	if err != nil {
+		return nil, err
+	}
+	partiallyWithdrawn := make(map[math.ValidatorIndex]math.Gwei)
+	for _, partial := range partials {
+		var withdrawal WithdrawalT
+		validator, err = s.ValidatorByIndex(partial.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err = s.GetBalance(partial.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		floor := math.Gwei(s.cs.EjectionBalance())
+		if validator.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) ||
+			validator.GetEffectiveBalance() < floor || balance <= floor {
+			continue
+		}
+
+		withdrawalAddress, err = validator.
+			GetWithdrawalCredentials().ToExecutionAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		amount := min(balance-floor, partial.Amount)
+		withdrawal = withdrawal.New(
+			math.U64(withdrawalIndex),
+			partial.ValidatorIndex,
+			withdrawalAddress,
+			amount,
+		)
+		withdrawals = append(withdrawals, withdrawal)
+		partiallyWithdrawn[partial.ValidatorIndex] += amount
+		withdrawalIndex++
+	}
+
 	totalValidators, err := s.GetTotalValidators()
 	if err != nil {
 		return nil, err
@@ -238,6 +283,7 @@ func (s *StateDB[
 		if err != nil {
 			return nil, err
 		}
+		balance -= min(balance, partiallyWithdrawn[validatorIndex])
 
 		withdrawalAddress, err = validator.
 			GetWithdrawalCredentials().ToExecutionAddress()
@@ -281,6 +327,38 @@ func (s *StateDB[
 	return withdrawals, nil
 }
 
+// PendingPartialWithdrawalsForSweep returns the pending partial withdrawals
+// that are considered by the next withdrawals sweep, which are the
+// withdrawable ones up to half of the withdrawals of a payload. These are
+// dequeued once the payload is processed, even if they are not paid out.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) PendingPartialWithdrawalsForSweep() (
+	[]transition.PendingPartialWithdrawal, error,
+) {
+	slot, err := s.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := math.Epoch(uint64(slot) / s.cs.SlotsPerEpoch())
+
+	pending, err := s.GetPendingPartialWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := s.cs.MaxWithdrawalsPerPayload() / 2
+	partials := make([]transition.PendingPartialWithdrawal, 0, limit)
+	for _, partial := range pending {
+		if partial.WithdrawableEpoch > epoch ||
+			uint64(len(partials)) == limit {
+			break
+		}
+		partials = append(partials, partial)
+	}
+	return partials, nil
+}
+
 // GetMarshallable is the interface for the beacon store.
 //
 //nolint:funlen,gocognit // todo fix somehow
@@ -50,7 +50,7 @@ type StateProcessor[
 		ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
@@ -75,7 +75,10 @@ type StateProcessor[
 		Len() int
 		EncodeIndex(int, *bytes.Buffer)
 	},
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT interface {
+		~[32]byte
+		ToExecutionAddress() (common.ExecutionAddress, error)
+	},
 ] struct {
 	// cs is the chain specification for the beacon chain.
 	cs common.ChainSpec
@@ -105,7 +108,7 @@ func NewStateProcessor[
 		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
@@ -130,7 +133,10 @@ func NewStateProcessor[
 		Len() int
 		EncodeIndex(int, *bytes.Buffer)
 	},
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT interface {
+		~[32]byte
+		ToExecutionAddress() (common.ExecutionAddress, error)
+	},
 ](
 	cs common.ChainSpec,
 	executionEngine ExecutionEngine[
@@ -348,6 +354,10 @@ func (sp *StateProcessor[
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
+	} else if err = sp.processPendingDeposits(st); err != nil {
+		return nil, err
+	} else if err = sp.processPendingConsolidations(st); err != nil {
+		return nil, err
 	}
 	return sp.processSyncCommitteeUpdates(st)
 }
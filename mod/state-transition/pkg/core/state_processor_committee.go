@@ -21,29 +21,57 @@
 package core
 
 import (
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/sourcegraph/conc/iter"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates processes the sync committee updates. Exiting
+// validators are removed from the committee at their exit epoch, after which
+// they are no longer reported.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
 ]) processSyncCommitteeUpdates(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
 	vals, err := st.GetValidatorsByEffectiveBalance()
 	if err != nil {
 		return nil, err
 	}
 
-	return iter.MapErr(
+	updates, err := iter.MapErr(
 		vals,
 		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
 			v := (*val)
+			switch exitEpoch := v.GetExitEpoch(); {
+			case exitEpoch <= epoch:
+				return nil, nil //nolint:nilnil // filtered out below.
+			case exitEpoch == epoch+1:
+				return &transition.ValidatorUpdate{
+					Pubkey:           v.GetPubkey(),
+					EffectiveBalance: 0,
+				}, nil
+			}
 			return &transition.ValidatorUpdate{
 				Pubkey:           v.GetPubkey(),
 				EffectiveBalance: v.GetEffectiveBalance(),
 			}, nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validators that have exited are mapped to nil updates.
+	return slices.DeleteFunc(
+		updates,
+		func(update *transition.ValidatorUpdate) bool { return update == nil },
+	), nil
 }
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
+// processDepositRequests parses the EIP-6110 deposit requests from the
+// execution requests of a block and appends them to the pending deposits
+// queue.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+]) processDepositRequests(
+	st BeaconStateT,
+	requests []bytes.Bytes,
+) error {
+	data, err := engineprimitives.GetExecutionRequestData(
+		requests, engineprimitives.DepositRequestType,
+	)
+	if err != nil {
+		return err
+	}
+
+	var dep DepositT
+	size := int(dep.Empty().SizeSSZ())
+	if len(data)%size != 0 {
+		return errors.Wrapf(
+			ErrInvalidDepositRequests,
+			"length %d is not a multiple of %d", len(data), size,
+		)
+	}
+
+	for i := 0; i < len(data); i += size {
+		dep = dep.Empty()
+		if err = dep.UnmarshalSSZ(data[i : i+size]); err != nil {
+			return errors.Wrap(ErrInvalidDepositRequests, err.Error())
+		}
+		if err = sp.processDepositRequest(st, dep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processDepositRequest records the deposit requests start index on the
+// first deposit request and appends the deposit to the pending deposits
+// queue.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+]) processDepositRequest(
+	st BeaconStateT,
+	dep DepositT,
+) error {
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex {
+		if err = st.SetDepositRequestsStartIndex(
+			dep.GetIndex().Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+
+	bz, err := dep.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return st.EnqueuePendingDeposit(dep.GetIndex().Unwrap(), bz)
+}
+
+// processPendingDeposits applies the pending deposits at the epoch boundary,
+// up to the maximum number of deposits that blocks of an epoch may carry.
+// Deposits of new validators with an invalid signature are dropped, as
+// deposit requests are not validated before they are included.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+]) processPendingDeposits(
+	st BeaconStateT,
+) error {
+	pending, err := st.DequeuePendingDeposits(
+		sp.cs.MaxDepositsPerBlock() * sp.cs.SlotsPerEpoch(),
+	)
+	if err != nil {
+		return err
+	}
+
+	var dep DepositT
+	for _, bz := range pending {
+		dep = dep.Empty()
+		if err = dep.UnmarshalSSZ(bz); err != nil {
+			return err
+		}
+
+		switch _, err = st.ValidatorIndexByPubkey(dep.GetPubkey()); {
+		case err == nil:
+			err = sp.applyDeposit(st, dep)
+		case sp.verifyDepositSignature(st, dep) != nil:
+			continue
+		default:
+			err = sp.addValidatorToRegistry(st, dep)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit returns a deposit of the given amount to the given pubkey.
+func testDeposit(
+	index uint64,
+	pubkey crypto.BLSPubkey,
+	amount math.Gwei,
+) *types.Deposit {
+	return &types.Deposit{
+		Pubkey: pubkey,
+		Credentials: types.NewCredentialsFromExecutionAddress(
+			testSourceAddress,
+		),
+		Amount: amount,
+		Index:  index,
+	}
+}
+
+func TestProcessDepositRequests(t *testing.T) {
+	sp, st := newTestState(t, 32, 16e9)
+
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, constants.UnsetDepositRequestsStartIndex, startIndex)
+
+	require.NoError(t, sp.processDepositRequests(st, executionRequests(
+		t, engineprimitives.DepositRequestType,
+		testDeposit(5, crypto.BLSPubkey{1}, 1e9),
+		testDeposit(6, crypto.BLSPubkey{1}, 2e9),
+	)))
+
+	// The first deposit request marks the end of the log based deposits.
+	startIndex, err = st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), startIndex)
+
+	require.NoError(t, sp.processDepositRequests(st, executionRequests(
+		t, engineprimitives.DepositRequestType,
+		testDeposit(7, crypto.BLSPubkey{1}, 3e9),
+	)))
+	startIndex, err = st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), startIndex)
+
+	// The deposits are only applied when the pending deposits are
+	// processed, in the order they were requested.
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(16e9), val.GetEffectiveBalance())
+
+	pending, err := st.DequeuePendingDeposits(2)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	for i, bz := range pending {
+		dep := new(types.Deposit)
+		require.NoError(t, dep.UnmarshalSSZ(bz))
+		require.Equal(t, math.U64(5+i), dep.GetIndex())
+	}
+	pending, err = st.DequeuePendingDeposits(2)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+}
+
+func TestProcessDepositRequestsInvalidLength(t *testing.T) {
+	sp, st := newTestState(t, 32)
+
+	requests := executionRequests(
+		t, engineprimitives.DepositRequestType,
+		testDeposit(0, crypto.BLSPubkey{1}, 1e9),
+	)
+	requests[0] = requests[0][:len(requests[0])-1]
+	require.ErrorIs(t,
+		sp.processDepositRequests(st, requests), ErrInvalidDepositRequests,
+	)
+}
+
+func TestProcessPendingDeposits(t *testing.T) {
+	sp, st := newTestState(t, 32, 16e9)
+	require.NoError(t, st.SetGenesisValidatorsRoot([32]byte{0x01}))
+
+	signer := &mocks.BLSSigner{}
+	signer.On(
+		"VerifySignature", crypto.BLSPubkey{2}, mock.Anything, mock.Anything,
+	).Return(nil)
+	signer.On(
+		"VerifySignature", crypto.BLSPubkey{3}, mock.Anything, mock.Anything,
+	).Return(errors.New("invalid signature"))
+	sp.signer = signer
+
+	deposits := []*types.Deposit{
+		// Tops up the existing validator.
+		testDeposit(0, crypto.BLSPubkey{1}, 4e9),
+		// Adds a new validator.
+		testDeposit(1, crypto.BLSPubkey{2}, 32e9),
+		// Dropped, as the signature is invalid.
+		testDeposit(2, crypto.BLSPubkey{3}, 32e9),
+	}
+	// Only MaxDepositsPerBlock * SlotsPerEpoch deposits are applied per
+	// epoch, the remaining ones stay pending.
+	for i := range uint64(30) {
+		deposits = append(deposits, testDeposit(3+i, crypto.BLSPubkey{1}, 1e9))
+	}
+	for _, dep := range deposits {
+		bz, err := dep.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, st.EnqueuePendingDeposit(dep.GetIndex().Unwrap(), bz))
+	}
+
+	require.NoError(t, sp.processPendingDeposits(st))
+
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(testMaxEffectiveBalance), val.GetEffectiveBalance())
+
+	idx, err := st.ValidatorIndexByPubkey(crypto.BLSPubkey{2})
+	require.NoError(t, err)
+	require.Equal(t, math.ValidatorIndex(1), idx)
+	_, err = st.ValidatorIndexByPubkey(crypto.BLSPubkey{3})
+	require.Error(t, err)
+
+	pending, err := st.DequeuePendingDeposits(uint64(len(deposits)))
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	dep := new(types.Deposit)
+	require.NoError(t, dep.UnmarshalSSZ(pending[0]))
+	require.Equal(t, math.U64(32), dep.GetIndex())
+}
+
+func TestProcessOperationsDepositAfterRequestsStart(t *testing.T) {
+	tests := []struct {
+		name       string
+		startIndex uint64
+		index      uint64
+		err        error
+	}{
+		{
+			name:       "deposit requests not started",
+			startIndex: constants.UnsetDepositRequestsStartIndex,
+			index:      5,
+		},
+		{
+			name:       "deposit before the start index",
+			startIndex: 5,
+			index:      4,
+		},
+		{
+			name:       "deposit at the start index",
+			startIndex: 5,
+			index:      5,
+			err:        ErrDepositAfterRequestsStart,
+		},
+		{
+			name:       "deposit after the start index",
+			startIndex: 5,
+			index:      6,
+			err:        ErrDepositAfterRequestsStart,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp, st := newTestState(t, 32, 16e9)
+			require.NoError(t, st.SetEth1Data(&types.Eth1Data{}))
+			require.NoError(t, st.SetEth1DepositIndex(tt.index))
+			require.NoError(t, st.SetDepositRequestsStartIndex(tt.startIndex))
+
+			err := sp.processOperations(st, &types.BeaconBlock{
+				Slot: 32,
+				Body: &types.BeaconBlockBody{
+					Deposits: []*types.Deposit{
+						testDeposit(tt.index, crypto.BLSPubkey{1}, 1e9),
+					},
+				},
+			})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			val, err := st.ValidatorByIndex(0)
+			require.NoError(t, err)
+			require.Equal(t, math.Gwei(17e9), val.GetEffectiveBalance())
+		})
+	}
+}
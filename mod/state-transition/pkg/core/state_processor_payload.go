@@ -116,6 +116,7 @@ func (sp *StateProcessor[
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			sp.cs.ActiveForkVersionForSlot(blk.GetSlot()),
 			optimisticEngine,
 		),
 	); err != nil {
@@ -56,7 +56,39 @@ func (sp *StateProcessor[
 	// if uint64(len(deposits)) != depositCount {
 	// 	return errors.New("deposit count mismatch")
 	// }
-	return sp.processDeposits(st, deposits)
+
+	// Log based deposits are only accepted up to the index of the first
+	// deposit received through an execution request.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	for _, dep := range deposits {
+		if dep.GetIndex().Unwrap() >= startIndex {
+			return errors.Wrapf(
+				ErrDepositAfterRequestsStart,
+				"deposit index: %d, deposit requests start index: %d",
+				dep.GetIndex(), startIndex,
+			)
+		}
+	}
+
+	if err = sp.processDeposits(st, deposits); err != nil {
+		return err
+	}
+
+	// Deposits, withdrawals and consolidations are requested by the
+	// execution layer starting at Electra.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return nil
+	}
+	requests := blk.GetBody().GetExecutionRequests()
+	if err = sp.processDepositRequests(st, requests); err != nil {
+		return err
+	} else if err = sp.processWithdrawalRequests(st, requests); err != nil {
+		return err
+	}
+	return sp.processConsolidationRequests(st, requests)
 }
 
 // processDeposits processes the deposits and ensures  they match the
@@ -137,10 +169,27 @@ func (sp *StateProcessor[
 
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
+) error {
+	// Verify that the message was signed correctly.
+	if err := sp.verifyDepositSignature(st, dep); err != nil {
+		return err
+	}
+
+	// Add the validator to the registry.
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// verifyDepositSignature verifies the signature of a deposit against the
+// fork data of the current epoch.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+]) verifyDepositSignature(
+	st BeaconStateT,
+	dep DepositT,
 ) error {
 	var (
 		genesisValidatorsRoot common.Root
@@ -170,7 +219,7 @@ func (sp *StateProcessor[
 
 	// Verify that the message was signed correctly.
 	var d ForkDataT
-	if err = dep.VerifySignature(
+	return dep.VerifySignature(
 		d.New(
 			version.FromUint32[common.Version](
 				sp.cs.ActiveForkVersionForEpoch(epoch),
@@ -178,12 +227,7 @@ func (sp *StateProcessor[
 		),
 		sp.cs.DomainTypeDeposit(),
 		sp.signer.VerifySignature,
-	); err != nil {
-		return err
-	}
-
-	// Add the validator to the registry.
-	return sp.addValidatorToRegistry(st, dep)
+	)
 }
 
 // addValidatorToRegistry adds a validator to the registry.
@@ -237,6 +281,13 @@ func (sp *StateProcessor[
 		payloadWithdrawals = payload.GetWithdrawals()
 	)
 
+	// Get the pending partial withdrawals paid out by the expected
+	// withdrawals, before the expected withdrawals modify the state.
+	partials, err := st.PendingPartialWithdrawalsForSweep()
+	if err != nil {
+		return err
+	}
+
 	// Get the expected withdrawals.
 	expectedWithdrawals, err := st.ExpectedWithdrawals()
 	if err != nil {
@@ -263,13 +314,19 @@ func (sp *StateProcessor[
 		}
 
 		// Then we process the withdrawal.
-		if err = st.DecreaseBalance(
-			wd.GetValidatorIndex(), wd.GetAmount(),
-		); err != nil {
+		if err = sp.processWithdrawal(st, wd); err != nil {
 			return err
 		}
 	}
 
+	// The pending partial withdrawals considered by the sweep are dequeued,
+	// including the ones that could not be paid out.
+	if err = st.DequeuePendingPartialWithdrawals(
+		uint64(len(partials)),
+	); err != nil {
+		return err
+	}
+
 	// Update the next withdrawal index if this block contained withdrawals
 	if numWithdrawals != 0 {
 		// Next sweep starts after the latest withdrawal's validator index
@@ -306,3 +363,34 @@ func (sp *StateProcessor[
 
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// processWithdrawal decreases the balance of the validator by the amount of
+// the withdrawal, lowering its effective balance if it is no longer backed by
+// the balance.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, WithdrawalT, _, _,
+]) processWithdrawal(
+	st BeaconStateT,
+	wd WithdrawalT,
+) error {
+	idx := wd.GetValidatorIndex()
+	if err := st.DecreaseBalance(idx, wd.GetAmount()); err != nil {
+		return err
+	}
+
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	balance, err := st.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+
+	increment := math.Gwei(sp.cs.EffectiveBalanceIncrement())
+	if balance-balance%increment >= val.GetEffectiveBalance() {
+		return nil
+	}
+	val.SetEffectiveBalance(balance - balance%increment)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// processWithdrawalRequests parses the EIP-7002 withdrawal requests from the
+// execution requests of a block and processes them in order.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processWithdrawalRequests(
+	st BeaconStateT,
+	requests []bytes.Bytes,
+) error {
+	data, err := engineprimitives.GetExecutionRequestData(
+		requests, engineprimitives.WithdrawalRequestType,
+	)
+	if err != nil {
+		return err
+	}
+
+	withdrawalRequests, err := engineprimitives.DecodeWithdrawalRequests(data)
+	if err != nil {
+		return err
+	}
+
+	for _, req := range withdrawalRequests {
+		if err = sp.processWithdrawalRequest(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processWithdrawalRequest initiates the exit of the validator for a full
+// exit request, or enqueues a pending partial withdrawal otherwise. Requests
+// that are not sent from the withdrawal address of an active validator are
+// ignored, as the execution layer does not validate them.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	WithdrawalCredentialsT,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	req *engineprimitives.WithdrawalRequest,
+) error {
+	idx, err := st.ValidatorIndexByPubkey(req.ValidatorPubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	credentials := val.GetWithdrawalCredentials()
+	address, err := credentials.ToExecutionAddress()
+	if err != nil || address != req.SourceAddress ||
+		val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		//nolint:nilerr // unauthorized or exiting validators are ignored.
+		return nil
+	}
+
+	pendingAmount, err := sp.pendingPartialWithdrawalsAmount(st, idx)
+	if err != nil {
+		return err
+	}
+
+	if req.IsFullExit() {
+		// Exits are only initiated once the pending partial withdrawals of
+		// the validator are paid out.
+		if pendingAmount != 0 {
+			return nil
+		}
+		return sp.initiateValidatorExit(st, idx, val)
+	}
+
+	balance, err := st.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+
+	// Partial withdrawals may not take the validator below the ejection
+	// balance.
+	floor := math.Gwei(sp.cs.EjectionBalance())
+	if val.GetEffectiveBalance() < floor ||
+		balance <= floor+pendingAmount {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	return st.EnqueuePendingPartialWithdrawal(
+		transition.PendingPartialWithdrawal{
+			ValidatorIndex:    idx,
+			Amount:            min(balance-floor-pendingAmount, req.Amount),
+			WithdrawableEpoch: sp.cs.SlotToEpoch(slot) + 1,
+		},
+	)
+}
+
+// pendingPartialWithdrawalsAmount returns the total amount of the pending
+// partial withdrawals of the validator at the given index.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) pendingPartialWithdrawalsAmount(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) (math.Gwei, error) {
+	pending, err := st.GetPendingPartialWithdrawals()
+	if err != nil {
+		return 0, err
+	}
+
+	var amount math.Gwei
+	for _, partial := range pending {
+		if partial.ValidatorIndex == idx {
+			amount += partial.Amount
+		}
+	}
+	return amount, nil
+}
+
+// initiateValidatorExit schedules the exit of the validator at the next
+// epoch, after which its balance is withdrawn by the withdrawals sweep.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	val ValidatorT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	exitEpoch := sp.cs.SlotToEpoch(slot) + 1
+	val.SetExitEpoch(exitEpoch)
+	val.SetWithdrawableEpoch(exitEpoch + 1)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// processConsolidationRequests parses the EIP-7251 consolidation requests
+// from the execution requests of a block and processes them in order.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processConsolidationRequests(
+	st BeaconStateT,
+	requests []bytes.Bytes,
+) error {
+	data, err := engineprimitives.GetExecutionRequestData(
+		requests, engineprimitives.ConsolidationRequestType,
+	)
+	if err != nil {
+		return err
+	}
+
+	consolidationRequests, err := engineprimitives.
+		DecodeConsolidationRequests(data)
+	if err != nil {
+		return err
+	}
+
+	for _, req := range consolidationRequests {
+		if err = sp.processConsolidationRequest(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processConsolidationRequest initiates the exit of the source validator and
+// enqueues a pending consolidation, which moves the balance of the source
+// validator to the target validator once the source is withdrawable. As with
+// withdrawal requests, invalid requests are ignored.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	WithdrawalCredentialsT,
+]) processConsolidationRequest(
+	st BeaconStateT,
+	req *engineprimitives.ConsolidationRequest,
+) error {
+	if req.SourcePubkey == req.TargetPubkey {
+		return nil
+	}
+
+	sourceIdx, err := st.ValidatorIndexByPubkey(req.SourcePubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+	targetIdx, err := st.ValidatorIndexByPubkey(req.TargetPubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+
+	source, err := st.ValidatorByIndex(sourceIdx)
+	if err != nil {
+		return err
+	}
+	target, err := st.ValidatorByIndex(targetIdx)
+	if err != nil {
+		return err
+	}
+
+	// The request must be sent from the withdrawal address of the source
+	// validator, and the target must have execution withdrawal credentials.
+	credentials := source.GetWithdrawalCredentials()
+	address, err := credentials.ToExecutionAddress()
+	if err != nil || address != req.SourceAddress {
+		//nolint:nilerr // unauthorized requests are ignored.
+		return nil
+	}
+	credentials = target.GetWithdrawalCredentials()
+	if _, err = credentials.ToExecutionAddress(); err != nil {
+		//nolint:nilerr // targets without execution credentials are ignored.
+		return nil
+	}
+
+	if source.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) ||
+		target.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	pendingAmount, err := sp.pendingPartialWithdrawalsAmount(st, sourceIdx)
+	if err != nil {
+		return err
+	} else if pendingAmount != 0 {
+		return nil
+	}
+
+	if err = sp.initiateValidatorExit(st, sourceIdx, source); err != nil {
+		return err
+	}
+	return st.EnqueuePendingConsolidation(transition.PendingConsolidation{
+		SourceIndex: sourceIdx,
+		TargetIndex: targetIdx,
+	})
+}
+
+// processPendingConsolidations moves the balance of the withdrawable source
+// validators of the pending consolidations to their target validators.
+// Consolidations of slashed source validators are dropped.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processPendingConsolidations(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+
+	pending, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+
+	for _, consolidation := range pending {
+		source, err := st.ValidatorByIndex(consolidation.SourceIndex)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case source.IsSlashed():
+			// The consolidation is dropped.
+		case source.GetWithdrawableEpoch() > nextEpoch:
+			continue
+		default:
+			if err = sp.applyConsolidation(st, consolidation); err != nil {
+				return err
+			}
+		}
+
+		if err = st.RemovePendingConsolidation(
+			consolidation.SourceIndex,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyConsolidation moves the active balance of the source validator of a
+// consolidation to its target validator.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) applyConsolidation(
+	st BeaconStateT,
+	consolidation transition.PendingConsolidation,
+) error {
+	source, err := st.ValidatorByIndex(consolidation.SourceIndex)
+	if err != nil {
+		return err
+	}
+	balance, err := st.GetBalance(consolidation.SourceIndex)
+	if err != nil {
+		return err
+	}
+
+	amount := min(balance, source.GetEffectiveBalance())
+	if err = st.DecreaseBalance(
+		consolidation.SourceIndex, amount,
+	); err != nil {
+		return err
+	}
+	if err = st.IncreaseBalance(
+		consolidation.TargetIndex, amount,
+	); err != nil {
+		return err
+	}
+
+	target, err := st.ValidatorByIndex(consolidation.TargetIndex)
+	if err != nil {
+		return err
+	}
+	balance, err = st.GetBalance(consolidation.TargetIndex)
+	if err != nil {
+		return err
+	}
+
+	increment := math.Gwei(sp.cs.EffectiveBalanceIncrement())
+	target.SetEffectiveBalance(min(
+		balance-balance%increment,
+		math.Gwei(sp.cs.MaxEffectiveBalance()),
+	))
+	return st.UpdateValidatorAtIndex(consolidation.TargetIndex, target)
+}
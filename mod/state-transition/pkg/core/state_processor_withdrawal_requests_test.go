@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// testOtherAddress is an address no validator of the test state withdraws
+// to.
+var testOtherAddress = common.ExecutionAddress{0x02}
+
+func TestProcessWithdrawalRequests(t *testing.T) {
+	tests := []struct {
+		name     string
+		pending  []transition.PendingPartialWithdrawal
+		request  *engineprimitives.WithdrawalRequest
+		expected []transition.PendingPartialWithdrawal
+		exiting  bool
+	}{
+		{
+			name: "partial withdrawal",
+			request: &engineprimitives.WithdrawalRequest{
+				SourceAddress:   testSourceAddress,
+				ValidatorPubkey: crypto.BLSPubkey{1},
+				Amount:          1e9,
+			},
+			expected: []transition.PendingPartialWithdrawal{
+				{ValidatorIndex: 0, Amount: 1e9, WithdrawableEpoch: 2},
+			},
+		},
+		{
+			name: "partial withdrawal capped at ejection balance",
+			pending: []transition.PendingPartialWithdrawal{
+				{ValidatorIndex: 0, Amount: 6e9, WithdrawableEpoch: 2},
+			},
+			request: &engineprimitives.WithdrawalRequest{
+				SourceAddress:   testSourceAddress,
+				ValidatorPubkey: crypto.BLSPubkey{1},
+				Amount:          32e9,
+			},
+			expected: []transition.PendingPartialWithdrawal{
+				{ValidatorIndex: 0, Amount: 16e9, WithdrawableEpoch: 2},
+			},
+		},
+		{
+			name: "unauthorized source address",
+			request: &engineprimitives.WithdrawalRequest{
+				SourceAddress:   testOtherAddress,
+				ValidatorPubkey: crypto.BLSPubkey{1},
+				Amount:          1e9,
+			},
+		},
+		{
+			name: "unknown validator",
+			request: &engineprimitives.WithdrawalRequest{
+				SourceAddress:   testSourceAddress,
+				ValidatorPubkey: crypto.BLSPubkey{2},
+				Amount:          1e9,
+			},
+		},
+		{
+			name: "full exit",
+			request: &engineprimitives.WithdrawalRequest{
+				SourceAddress:   testSourceAddress,
+				ValidatorPubkey: crypto.BLSPubkey{1},
+				Amount:          engineprimitives.FullExitRequestAmount,
+			},
+			exiting: true,
+		},
+		{
+			name: "full exit with pending partial withdrawals",
+			pending: []transition.PendingPartialWithdrawal{
+				{ValidatorIndex: 0, Amount: 1e9, WithdrawableEpoch: 2},
+			},
+			request: &engineprimitives.WithdrawalRequest{
+				SourceAddress:   testSourceAddress,
+				ValidatorPubkey: crypto.BLSPubkey{1},
+				Amount:          engineprimitives.FullExitRequestAmount,
+			},
+			expected: []transition.PendingPartialWithdrawal{
+				{ValidatorIndex: 0, Amount: 1e9, WithdrawableEpoch: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp, st := newTestState(t, 32, 32e9)
+			for _, partial := range tt.pending {
+				require.NoError(t, st.EnqueuePendingPartialWithdrawal(partial))
+			}
+
+			require.NoError(t, sp.processWithdrawalRequests(
+				st, executionRequests(
+					t, engineprimitives.WithdrawalRequestType, tt.request,
+				),
+			))
+
+			pending, err := st.GetPendingPartialWithdrawals()
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expected, pending)
+
+			val, err := st.ValidatorByIndex(0)
+			require.NoError(t, err)
+			if tt.exiting {
+				require.Equal(t, math.Epoch(2), val.GetExitEpoch())
+				require.Equal(t, math.Epoch(3), val.GetWithdrawableEpoch())
+			} else {
+				require.Equal(t,
+					math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch(),
+				)
+			}
+		})
+	}
+}
+
+func TestExpectedWithdrawalsPendingPartialWithdrawals(t *testing.T) {
+	sp, st := newTestState(t, 64, 32e9, 20e9)
+	require.NoError(t, st.EnqueuePendingPartialWithdrawal(
+		transition.PendingPartialWithdrawal{
+			ValidatorIndex: 0, Amount: 2e9, WithdrawableEpoch: 2,
+		},
+	))
+	require.NoError(t, st.EnqueuePendingPartialWithdrawal(
+		transition.PendingPartialWithdrawal{
+			ValidatorIndex: 1, Amount: 10e9, WithdrawableEpoch: 2,
+		},
+	))
+	require.NoError(t, st.EnqueuePendingPartialWithdrawal(
+		transition.PendingPartialWithdrawal{
+			ValidatorIndex: 0, Amount: 1e9, WithdrawableEpoch: 3,
+		},
+	))
+
+	withdrawals, err := st.ExpectedWithdrawals()
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 4)
+	require.Equal(t, math.Gwei(2e9), withdrawals[0].GetAmount())
+	require.Equal(t, math.ValidatorIndex(1), withdrawals[1].GetValidatorIndex())
+	require.Equal(t, math.Gwei(4e9), withdrawals[1].GetAmount())
+	require.Equal(t, testSourceAddress, withdrawals[1].GetAddress())
+	require.Equal(t, math.U64(2), withdrawals[2].GetIndex())
+
+	// The pending partial withdrawals are paid out and dequeued with the
+	// withdrawals of the payload.
+	payload := &types.ExecutionPayload{Withdrawals: withdrawals}
+	body := &types.BeaconBlockBody{ExecutionPayload: payload}
+	require.NoError(t, sp.processWithdrawals(st, body))
+
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(16e9), balance)
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(16e9), val.GetEffectiveBalance())
+
+	pending, err := st.GetPendingPartialWithdrawals()
+	require.NoError(t, err)
+	require.Equal(t, []transition.PendingPartialWithdrawal{
+		{ValidatorIndex: 0, Amount: 1e9, WithdrawableEpoch: 3},
+	}, pending)
+}
+
+func TestProcessConsolidationRequests(t *testing.T) {
+	sp, st := newTestState(t, 32, 32e9, 20e9, 20e9)
+
+	requests := executionRequests(
+		t, engineprimitives.ConsolidationRequestType,
+		// Consolidates the second validator into the third one.
+		&engineprimitives.ConsolidationRequest{
+			SourceAddress: testSourceAddress,
+			SourcePubkey:  crypto.BLSPubkey{2},
+			TargetPubkey:  crypto.BLSPubkey{3},
+		},
+		// Ignored, as it is not sent from the withdrawal address.
+		&engineprimitives.ConsolidationRequest{
+			SourceAddress: testOtherAddress,
+			SourcePubkey:  crypto.BLSPubkey{1},
+			TargetPubkey:  crypto.BLSPubkey{3},
+		},
+		// Ignored, as the target is exiting.
+		&engineprimitives.ConsolidationRequest{
+			SourceAddress: testSourceAddress,
+			SourcePubkey:  crypto.BLSPubkey{1},
+			TargetPubkey:  crypto.BLSPubkey{2},
+		},
+	)
+	require.NoError(t, sp.processConsolidationRequests(st, requests))
+
+	consolidations, err := st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, []transition.PendingConsolidation{
+		{SourceIndex: 1, TargetIndex: 2},
+	}, consolidations)
+
+	source, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(2), source.GetExitEpoch())
+
+	// The consolidation is not applied before the source is withdrawable.
+	require.NoError(t, sp.processPendingConsolidations(st))
+	consolidations, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Len(t, consolidations, 1)
+
+	require.NoError(t, st.SetSlot(95))
+	require.NoError(t, sp.processPendingConsolidations(st))
+	consolidations, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Empty(t, consolidations)
+
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(0), balance)
+	balance, err = st.GetBalance(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(40e9), balance)
+	target, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(32e9), target.GetEffectiveBalance())
+}
@@ -77,6 +77,8 @@ type BeaconBlockBody[
 	GetExecutionPayload() ExecutionPayloadT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetExecutionRequests returns the EIP-7685 encoded execution requests.
+	GetExecutionRequests() []bytes.Bytes
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -122,9 +124,16 @@ type Context interface {
 
 // Deposit is the interface for a deposit.
 type Deposit[
+	DepositT any,
 	ForkDataT any,
 	WithdrawlCredentialsT ~[32]byte,
 ] interface {
+	constraints.Empty[DepositT]
+	constraints.SSZMarshallable
+	// SizeSSZ returns the size of the SSZ encoding of the deposit.
+	SizeSSZ() uint32
+	// GetIndex returns the index of the deposit.
+	GetIndex() math.U64
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetPubkey returns the public key of the validator.
@@ -229,6 +238,15 @@ type Validator[
 	SetEffectiveBalance(math.Gwei)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch when the validator exits.
+	SetExitEpoch(math.Epoch)
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
 }
 
 type Validators interface {
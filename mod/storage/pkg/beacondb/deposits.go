@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
+// GetDepositRequestsStartIndex retrieves the index of the first deposit
+// received through an execution request. It returns
+// UnsetDepositRequestsStartIndex if no deposit request has been processed.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetDepositRequestsStartIndex() (uint64, error) {
+	index, err := kv.depositRequestsStartIndex.Get(kv.ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return constants.UnsetDepositRequestsStartIndex, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return index, nil
+}
+
+// SetDepositRequestsStartIndex sets the index of the first deposit received
+// through an execution request.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetDepositRequestsStartIndex(
+	index uint64,
+) error {
+	return kv.depositRequestsStartIndex.Set(kv.ctx, index)
+}
+
+// EnqueuePendingDeposit appends the SSZ encoded deposit with the given
+// deposit index to the pending deposits queue.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) EnqueuePendingDeposit(
+	index uint64,
+	deposit []byte,
+) error {
+	return kv.pendingDeposits.Set(kv.ctx, index, deposit)
+}
+
+// DequeuePendingDeposits removes and returns up to n SSZ encoded deposits
+// from the pending deposits queue, in order of their deposit index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) DequeuePendingDeposits(
+	n uint64,
+) ([][]byte, error) {
+	indices, deposits, err := kv.peekPendingDeposits(n)
+	if err != nil {
+		return nil, err
+	}
+
+	// The iterator is closed by now, so the queue can be written to.
+	for _, index := range indices {
+		if err = kv.pendingDeposits.Remove(kv.ctx, index); err != nil {
+			return nil, err
+		}
+	}
+	return deposits, nil
+}
+
+// peekPendingDeposits returns up to n SSZ encoded deposits from the head of
+// the pending deposits queue, along with their deposit indices.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) peekPendingDeposits(
+	n uint64,
+) ([]uint64, [][]byte, error) {
+	var (
+		indices  []uint64
+		deposits [][]byte
+	)
+
+	iter, err := kv.pendingDeposits.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid() && uint64(len(deposits)) < n; iter.Next() {
+		var entry collections.KeyValue[uint64, []byte]
+		if entry, err = iter.KeyValue(); err != nil {
+			return nil, nil, err
+		}
+		indices = append(indices, entry.Key)
+		deposits = append(deposits, entry.Value)
+	}
+	return indices, deposits, nil
+}
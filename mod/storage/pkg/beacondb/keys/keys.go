@@ -44,6 +44,10 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	DepositRequestsStartIndexPrefix
+	PendingDepositsPrefix
+	PendingPartialWithdrawalsPrefix
+	PendingConsolidationsPrefix
 )
 
 //nolint:lll
@@ -71,4 +75,15 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	DepositRequestsStartIndexPrefixHumanReadable        = "DepositRequestsStartIndexPrefix"
+	PendingDepositsPrefixHumanReadable                  = "PendingDepositsPrefix"
+	PendingPartialWithdrawalsPrefixHumanReadable        = "PendingPartialWithdrawalsPrefix"
+	PendingConsolidationsPrefixHumanReadable            = "PendingConsolidationsPrefix"
+)
+
+// Keys of the node-local metadata of the beacon state. The metadata is kept
+// out of the committed state, so it never changes the app hash.
+const (
+	// SchemaVersionKey is the key of the schema version of the beacon state.
+	SchemaVersionKey = "schema_version"
 )
@@ -63,6 +63,10 @@ type KVStore[
 	slot sdkcollections.Item[uint64]
 	// fork is the current fork
 	fork sdkcollections.Item[ForkT]
+	// schemaVersion is the version of the layout of the beacon state,
+	// recorded in the node-local metadata. It is nil if the store has no
+	// metadata store.
+	schemaVersion *sdkcollections.Item[uint64]
 	// History
 	// latestBlockHeader stores the latest beacon block header.
 	latestBlockHeader sdkcollections.Item[BeaconBlockHeaderT]
@@ -75,6 +79,12 @@ type KVStore[
 	eth1Data sdkcollections.Item[Eth1DataT]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
+	// depositRequestsStartIndex is the index of the first deposit received
+	// through an execution request.
+	depositRequestsStartIndex sdkcollections.Item[uint64]
+	// pendingDeposits stores the SSZ encoded deposits received through
+	// execution requests that are yet to be applied, keyed by deposit index.
+	pendingDeposits sdkcollections.Map[uint64, []byte]
 	// latestExecutionPayloadVersion stores the latest execution payload
 	// version.
 	latestExecutionPayloadVersion sdkcollections.Item[uint32]
@@ -98,6 +108,14 @@ type KVStore[
 	// nextWithdrawalValidatorIndex stores the next withdrawal validator index
 	// for each validator.
 	nextWithdrawalValidatorIndex sdkcollections.Item[uint64]
+	// pendingPartialWithdrawals stores the amounts of the pending partial
+	// withdrawals, keyed by withdrawable epoch and validator index.
+	pendingPartialWithdrawals sdkcollections.Map[
+		sdkcollections.Pair[uint64, uint64], uint64,
+	]
+	// pendingConsolidations stores the target validator index of the pending
+	// consolidations, keyed by source validator index.
+	pendingConsolidations sdkcollections.Map[uint64, uint64]
 	// Randomness
 	// randaoMix stores the randao mix for the current epoch.
 	randaoMix sdkcollections.Map[uint64, []byte]
@@ -188,6 +206,21 @@ func New[
 			keys.Eth1DepositIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		depositRequestsStartIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.DepositRequestsStartIndexPrefix},
+			),
+			keys.DepositRequestsStartIndexPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		pendingDeposits: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.PendingDepositsPrefix}),
+			keys.PendingDepositsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
 		latestExecutionPayloadVersion: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -253,6 +286,24 @@ func New[
 			keys.NextWithdrawalValidatorIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		pendingPartialWithdrawals: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PendingPartialWithdrawalsPrefix},
+			),
+			keys.PendingPartialWithdrawalsPrefixHumanReadable,
+			sdkcollections.PairKeyCodec(
+				sdkcollections.Uint64Key, sdkcollections.Uint64Key,
+			),
+			sdkcollections.Uint64Value,
+		),
+		pendingConsolidations: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.PendingConsolidationsPrefix}),
+			keys.PendingConsolidationsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 		totalSlashing: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.TotalSlashingPrefix}),
@@ -306,3 +357,25 @@ func (kv *KVStore[
 	cpy.ctx = ctx
 	return &cpy
 }
+
+// WithMetadata returns a copy of the Store that records the schema version of
+// the beacon state in the given node-local metadata store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) WithMetadata(
+	mss store.KVStoreService,
+) *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+] {
+	schemaVersion := sdkcollections.NewItem(
+		sdkcollections.NewSchemaBuilder(mss),
+		sdkcollections.NewPrefix(keys.SchemaVersionKey),
+		keys.SchemaVersionKey,
+		sdkcollections.Uint64Value,
+	)
+	cpy := *kv
+	cpy.schemaVersion = &schemaVersion
+	return &cpy
+}
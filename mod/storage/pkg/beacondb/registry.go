@@ -45,12 +45,15 @@ func (kv *KVStore[
 	return kv.balances.Set(kv.ctx, idx, 0)
 }
 
-// AddValidator registers a new validator in the beacon state.
+// AddValidatorBartio registers a new validator in the beacon state with its
+// effective balance as its initial balance, as done on bArtio. The balances
+// it writes are part of the committed state of bArtio, so this layout can't
+// be migrated locally and is kept for the state transition of that chain.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
 ]) AddValidatorBartio(val ValidatorT) error {
-	// Get the ne
+	// Get the next validator index from the sequence.
 	idx, err := kv.validatorIndex.Next(kv.ctx)
 	if err != nil {
 		return err
@@ -21,6 +21,10 @@
 package beacondb
 
 import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -67,3 +71,31 @@ func (kv *KVStore[
 ) error {
 	return kv.slot.Set(kv.ctx, uint64(slot))
 }
+
+// GetSchemaVersion returns the schema version of the beacon state recorded in
+// the node-local metadata, or 0 if none has been recorded.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetSchemaVersion() (uint64, error) {
+	if kv.schemaVersion == nil {
+		return 0, nil
+	}
+	version, err := kv.schemaVersion.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return version, err
+}
+
+// SetSchemaVersion records the schema version of the beacon state in the
+// node-local metadata.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetSchemaVersion(version uint64) error {
+	if kv.schemaVersion == nil {
+		return ErrNoMetadataStore
+	}
+	return kv.schemaVersion.Set(context.TODO(), version)
+}
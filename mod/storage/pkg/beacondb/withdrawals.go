@@ -20,7 +20,12 @@
 
 package beacondb
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
 
 // GetNextWithdrawalIndex returns the next withdrawal index.
 func (kv *KVStore[
@@ -60,3 +65,133 @@ func (kv *KVStore[
 ) error {
 	return kv.nextWithdrawalValidatorIndex.Set(kv.ctx, uint64(index))
 }
+
+// EnqueuePendingPartialWithdrawal adds a pending partial withdrawal. Amounts
+// of withdrawals of the same validator and withdrawable epoch are summed.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) EnqueuePendingPartialWithdrawal(
+	withdrawal transition.PendingPartialWithdrawal,
+) error {
+	key := collections.Join(
+		uint64(withdrawal.WithdrawableEpoch),
+		uint64(withdrawal.ValidatorIndex),
+	)
+	amount, err := kv.pendingPartialWithdrawals.Get(kv.ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return kv.pendingPartialWithdrawals.Set(
+		kv.ctx, key, amount+uint64(withdrawal.Amount),
+	)
+}
+
+// GetPendingPartialWithdrawals returns the pending partial withdrawals,
+// ordered by withdrawable epoch and validator index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetPendingPartialWithdrawals() (
+	[]transition.PendingPartialWithdrawal, error,
+) {
+	var withdrawals []transition.PendingPartialWithdrawal
+	iter, err := kv.pendingPartialWithdrawals.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry collections.KeyValue[
+			collections.Pair[uint64, uint64], uint64,
+		]
+		if entry, err = iter.KeyValue(); err != nil {
+			return nil, err
+		}
+		withdrawals = append(withdrawals, transition.PendingPartialWithdrawal{
+			ValidatorIndex:    math.ValidatorIndex(entry.Key.K2()),
+			Amount:            math.Gwei(entry.Value),
+			WithdrawableEpoch: math.Epoch(entry.Key.K1()),
+		})
+	}
+	return withdrawals, nil
+}
+
+// DequeuePendingPartialWithdrawals removes the first n pending partial
+// withdrawals.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) DequeuePendingPartialWithdrawals(n uint64) error {
+	var keys []collections.Pair[uint64, uint64]
+	iter, err := kv.pendingPartialWithdrawals.Iterate(kv.ctx, nil)
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid() && uint64(len(keys)) < n; iter.Next() {
+		var key collections.Pair[uint64, uint64]
+		if key, err = iter.Key(); err != nil {
+			iter.Close()
+			return err
+		}
+		keys = append(keys, key)
+	}
+	if err = iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err = kv.pendingPartialWithdrawals.Remove(kv.ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnqueuePendingConsolidation adds a pending consolidation.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) EnqueuePendingConsolidation(
+	consolidation transition.PendingConsolidation,
+) error {
+	return kv.pendingConsolidations.Set(
+		kv.ctx,
+		uint64(consolidation.SourceIndex),
+		uint64(consolidation.TargetIndex),
+	)
+}
+
+// GetPendingConsolidations returns the pending consolidations, ordered by
+// source validator index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetPendingConsolidations() ([]transition.PendingConsolidation, error) {
+	var consolidations []transition.PendingConsolidation
+	iter, err := kv.pendingConsolidations.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry collections.KeyValue[uint64, uint64]
+		if entry, err = iter.KeyValue(); err != nil {
+			return nil, err
+		}
+		consolidations = append(consolidations, transition.PendingConsolidation{
+			SourceIndex: math.ValidatorIndex(entry.Key),
+			TargetIndex: math.ValidatorIndex(entry.Value),
+		})
+	}
+	return consolidations, nil
+}
+
+// RemovePendingConsolidation removes the pending consolidation of the given
+// source validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) RemovePendingConsolidation(sourceIndex math.ValidatorIndex) error {
+	return kv.pendingConsolidations.Remove(kv.ctx, uint64(sourceIndex))
+}
@@ -24,10 +24,14 @@ const (
 	BlockKeyPrefix byte = iota
 	RootsKeyPrefix
 	ExecutionNumbersKeyPrefix
+	SchemaVersionKeyPrefix
+	BlockValuesKeyPrefix
 )
 
 const (
 	BlocksMapName           = "blocks"
 	RootsMapName            = "roots"
 	ExecutionNumbersMapName = "execution_numbers"
+	SchemaVersionItemName   = "schema_version"
+	BlockValuesMapName      = "block_values"
 )
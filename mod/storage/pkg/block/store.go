@@ -21,6 +21,7 @@
 package block
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"sync"
@@ -37,6 +38,8 @@ type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
 	blocks           sdkcollections.Map[math.Slot, BeaconBlockT]
 	roots            sdkcollections.Map[[]byte, math.Slot]
 	executionNumbers sdkcollections.Map[math.U64, math.Slot]
+	schemaVersion    sdkcollections.Item[uint64]
+	blockValues      sdkcollections.Map[math.Slot, []byte]
 
 	mu           sync.RWMutex
 	cdc          *encoding.SSZInterfaceCodec[BeaconBlockT]
@@ -71,6 +74,19 @@ func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
 			encoding.U64Key,
 			encoding.U64Value,
 		),
+		schemaVersion: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{SchemaVersionKeyPrefix}),
+			SchemaVersionItemName,
+			sdkcollections.Uint64Value,
+		),
+		blockValues: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{BlockValuesKeyPrefix}),
+			BlockValuesMapName,
+			encoding.U64Key,
+			sdkcollections.BytesValue,
+		),
 		cdc: cdc,
 	}
 }
@@ -83,6 +99,14 @@ func (kv *KVStore[BeaconBlockT]) Get(slot math.Slot) (BeaconBlockT, error) {
 	return kv.blocks.Get(context.TODO(), slot)
 }
 
+// Has returns whether a block is stored at a given index.
+func (kv *KVStore[BeaconBlockT]) Has(slot math.Slot) (bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	return kv.blocks.Has(context.TODO(), slot)
+}
+
 // Set sets the block by a given index in the store and also stores the
 // block root.
 func (kv *KVStore[BeaconBlockT]) Set(slot math.Slot, blk BeaconBlockT) error {
@@ -112,6 +136,15 @@ func (kv *KVStore[BeaconBlockT]) Set(slot math.Slot, blk BeaconBlockT) error {
 	return kv.blocks.Set(ctx, slot, blk)
 }
 
+// SetActiveForkVersion sets the fork version used to decode blocks read
+// from the store.
+func (kv *KVStore[BeaconBlockT]) SetActiveForkVersion(version uint32) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.cdc.SetActiveForkVersion(version)
+}
+
 // GetSlotByRoot retrieves the slot by a given root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByRoot(
 	root common.Root,
@@ -133,6 +166,76 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
 	return kv.executionNumbers.Get(context.TODO(), executionNumber)
 }
 
+// SetBlockValue records the value in Wei of the block with the given root
+// proposed at the given slot.
+func (kv *KVStore[BeaconBlockT]) SetBlockValue(
+	slot math.Slot, root common.Root, value *math.U256,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	bz := value.Bytes32()
+	return kv.blockValues.Set(
+		context.TODO(), slot, append(root[:], bz[:]...),
+	)
+}
+
+// GetBlockValue returns the value in Wei recorded for the block stored at
+// the given slot, or nil if no value was recorded for that block. A value
+// recorded for a block that did not make it into the chain is ignored.
+func (kv *KVStore[BeaconBlockT]) GetBlockValue(
+	slot math.Slot,
+) (*math.U256, error) {
+	ctx := context.TODO()
+
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	bz, err := kv.blockValues.Get(ctx, slot)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	block, err := kv.blocks.Get(ctx, slot)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	root := block.HashTreeRoot()
+	if len(bz) != 2*len(root) || !bytes.Equal(bz[:len(root)], root[:]) {
+		return nil, nil
+	}
+	return new(math.U256).SetBytes32(bz[len(root):]), nil
+}
+
+// GetLowestRetainedIndex returns the lowest slot that has not been pruned
+// from the store.
+func (kv *KVStore[BeaconBlockT]) GetLowestRetainedIndex() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	iter, err := kv.blocks.IterateRaw(
+		context.TODO(), nil, nil, sdkcollections.OrderAscending,
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return kv.earliestSlot.Unwrap(), nil
+	}
+	slot, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return slot.Unwrap(), nil
+}
+
 // Prune removes the [start, end) blocks from the store.
 func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 	var (
@@ -167,6 +270,11 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 			}
 		}
 
+		// Remove the value recorded for the block, if any.
+		if err = kv.blockValues.Remove(ctx, i); err != nil {
+			return err
+		}
+
 		// Finally remove the block from the blocks map.
 		if err = kv.blocks.Remove(ctx, i); err != nil {
 			return err
@@ -176,3 +284,24 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 	kv.earliestSlot = e
 	return nil
 }
+
+// GetSchemaVersion returns the schema version of the store, or 0 if none has
+// been recorded.
+func (kv *KVStore[BeaconBlockT]) GetSchemaVersion() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	version, err := kv.schemaVersion.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return version, err
+}
+
+// SetSchemaVersion records the schema version of the store.
+func (kv *KVStore[BeaconBlockT]) SetSchemaVersion(version uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	return kv.schemaVersion.Set(context.TODO(), version)
+}
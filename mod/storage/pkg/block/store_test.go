@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a minimal block that only carries its execution number.
+type testBlock struct {
+	number uint64
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, b.number), nil
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != 8 {
+		return errors.New("invalid block length")
+	}
+	b.number = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (*testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	b := &testBlock{}
+	return b, b.UnmarshalSSZ(bz)
+}
+
+func (*testBlock) Version() uint32 {
+	return 0
+}
+
+func (b *testBlock) HashTreeRoot() common.Root {
+	bz, _ := b.MarshalSSZ()
+	return sha256.Sum256(bz)
+}
+
+func (b *testBlock) GetExecutionNumber() math.U64 {
+	return math.U64(b.number)
+}
+
+// kvStoreService is an in-memory store.KVStoreService for tests.
+type kvStoreService struct {
+	*storev2.MemDB
+}
+
+func (s kvStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.MemDB
+}
+
+func TestBlockValue(t *testing.T) {
+	kv := block.NewStore[*testBlock](
+		kvStoreService{MemDB: storev2.NewMemDB()},
+	)
+	proposed := &testBlock{number: 1}
+	require.NoError(t, kv.SetBlockValue(
+		1, proposed.HashTreeRoot(), math.NewU256(1e18),
+	))
+
+	// The value is not served until the block is stored.
+	value, err := kv.GetBlockValue(1)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	require.NoError(t, kv.Set(1, proposed))
+	value, err = kv.GetBlockValue(1)
+	require.NoError(t, err)
+	require.Equal(t, math.NewU256(1e18), value)
+
+	// The value of a proposal that did not make it into the chain is not
+	// served for the block stored at its slot.
+	require.NoError(t, kv.SetBlockValue(
+		2, (&testBlock{number: 2}).HashTreeRoot(), math.NewU256(2),
+	))
+	require.NoError(t, kv.Set(2, &testBlock{number: 3}))
+	value, err = kv.GetBlockValue(2)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// Pruning the block also removes its value.
+	require.NoError(t, kv.Prune(0, 2))
+	value, err = kv.GetBlockValue(1)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/migration"
+)
+
+// Migrations returns the schema migrations of the deposit store, ordered by
+// version. The follow head function returns the execution block the deposit
+// sync has caught up to; it may be nil if it is not known, in which case the
+// deposit sync starts over from the first execution block.
+func (kv *KVStore[DepositT]) Migrations(
+	followHead func(context.Context) (uint64, error),
+) []migration.Migration {
+	return []migration.Migration{
+		{
+			Version:     1,
+			Description: "record the schema version of the store",
+		},
+		{
+			Version: 2,
+			Description: "seed the next deposit index and the last " +
+				"processed execution block",
+			Apply: func(ctx context.Context) error {
+				return kv.seedSyncCursor(ctx, followHead)
+			},
+		},
+	}
+}
+
+// seedSyncCursor records the next deposit index and the last processed
+// execution block of a store that was written before they were tracked.
+func (kv *KVStore[DepositT]) seedSyncCursor(
+	ctx context.Context,
+	followHead func(context.Context) (uint64, error),
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	if err := kv.seedNextIndex(ctx); err != nil {
+		return err
+	}
+
+	if followHead == nil {
+		return nil
+	}
+	if has, err := kv.lastBlock.Has(ctx); err != nil || has {
+		return err
+	}
+	blockNum, err := followHead(ctx)
+	if err != nil || blockNum == 0 {
+		return err
+	}
+	return kv.lastBlock.Set(ctx, blockNum)
+}
+
+// seedNextIndex sets the next deposit index to follow the highest deposit
+// in the store, or the lowest retained index if every deposit was pruned.
+func (kv *KVStore[DepositT]) seedNextIndex(ctx context.Context) error {
+	if has, err := kv.nextIndex.Has(ctx); err != nil || has {
+		return err
+	}
+
+	next, err := kv.getLowestRetained()
+	if err != nil {
+		return err
+	}
+
+	iter, err := kv.store.Iterate(
+		ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if iter.Valid() {
+		var highest uint64
+		if highest, err = iter.Key(); err != nil {
+			return err
+		}
+		next = max(next, highest+1)
+	}
+
+	if next == 0 {
+		return nil
+	}
+	return kv.nextIndex.Set(ctx, next)
+}
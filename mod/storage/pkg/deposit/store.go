@@ -22,21 +22,36 @@ package deposit
 
 import (
 	"context"
-	"errors"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix        = "deposit"
+	KeyLowestRetainedPrefix = "lowest_retained"
+	KeySchemaVersionPrefix  = "schema_version"
+	KeyNextIndexPrefix      = "next_index"
+	KeyLastBlockPrefix      = "last_processed_block"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// lowestRetained is the lowest deposit index that has not been pruned.
+	// Every index below it is guaranteed to have been removed from the store.
+	lowestRetained sdkcollections.Item[uint64]
+	// schemaVersion is the version of the layout of the store.
+	schemaVersion sdkcollections.Item[uint64]
+	// nextIndex is the index following the highest deposit ever enqueued.
+	nextIndex sdkcollections.Item[uint64]
+	// lastBlock is the last execution block whose deposits are enqueued.
+	lastBlock sdkcollections.Item[uint64]
+	mu        sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -52,18 +67,54 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		lowestRetained: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyLowestRetainedPrefix)),
+			KeyLowestRetainedPrefix,
+			sdkcollections.Uint64Value,
+		),
+		schemaVersion: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySchemaVersionPrefix)),
+			KeySchemaVersionPrefix,
+			sdkcollections.Uint64Value,
+		),
+		nextIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyNextIndexPrefix)),
+			KeyNextIndexPrefix,
+			sdkcollections.Uint64Value,
+		),
+		lastBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyLastBlockPrefix)),
+			KeyLastBlockPrefix,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
 // GetDepositsByIndex returns the first N deposits starting from the given
 // index. If N is greater than the number of deposits, it returns up to the
-// last deposit.
+// last deposit. It errors if the start index has already been pruned.
 func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	startIndex uint64,
 	numView uint64,
 ) ([]DepositT, error) {
 	kv.mu.RLock()
 	defer kv.mu.RUnlock()
+	lowest, err := kv.getLowestRetained()
+	if err != nil {
+		return nil, err
+	}
+	if startIndex < lowest {
+		return nil, errors.Wrapf(
+			ErrDepositPruned,
+			"requested index %d, lowest retained index %d",
+			startIndex, lowest,
+		)
+	}
+
 	deposits := []DepositT{}
 	for i := range numView {
 		deposit, err := kv.store.Get(context.TODO(), startIndex+i)
@@ -78,39 +129,150 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// GetLowestRetainedIndex returns the lowest deposit index that has not been
+// pruned from the store.
+func (kv *KVStore[DepositT]) GetLowestRetainedIndex() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.getLowestRetained()
+}
+
+// GetSchemaVersion returns the schema version of the store, or 0 if none has
+// been recorded.
+func (kv *KVStore[DepositT]) GetSchemaVersion() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	version, err := kv.schemaVersion.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return version, err
+}
+
+// SetSchemaVersion records the schema version of the store.
+func (kv *KVStore[DepositT]) SetSchemaVersion(version uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.schemaVersion.Set(context.TODO(), version)
+}
+
+// GetNextDepositIndex returns the index following the highest deposit ever
+// enqueued, or 0 if no deposit has been enqueued yet.
+func (kv *KVStore[DepositT]) GetNextDepositIndex() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.getNextIndex()
+}
+
+// GetLastProcessedBlock returns the last execution block whose deposits have
+// been enqueued, or 0 if none has been processed yet.
+func (kv *KVStore[DepositT]) GetLastProcessedBlock() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blockNum, err := kv.lastBlock.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return blockNum, err
+}
+
+// SetLastProcessedBlock records the last execution block whose deposits
+// have been enqueued.
+func (kv *KVStore[DepositT]) SetLastProcessedBlock(blockNum uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.lastBlock.Set(context.TODO(), blockNum)
+}
+
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	return kv.setDeposit(deposit)
+	lowest, err := kv.getLowestRetained()
+	if err != nil {
+		return err
+	}
+	return kv.setDeposit(deposit, lowest)
 }
 
 // EnqueueDeposits pushes multiple deposits to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
+	lowest, err := kv.getLowestRetained()
+	if err != nil {
+		return err
+	}
 	for _, deposit := range deposits {
-		if err := kv.setDeposit(deposit); err != nil {
+		if err = kv.setDeposit(deposit, lowest); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// setDeposit sets the deposit in the store.
-func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
-	return kv.store.Set(context.TODO(), uint64(deposit.GetIndex()), deposit)
+// setDeposit sets the deposit in the store. Deposits below the lowest
+// retained index have already been pruned and are not written back.
+func (kv *KVStore[DepositT]) setDeposit(deposit DepositT, lowest uint64) error {
+	index := deposit.GetIndex().Unwrap()
+	next, err := kv.getNextIndex()
+	if err != nil {
+		return err
+	} else if index >= next {
+		if err = kv.nextIndex.Set(context.TODO(), index+1); err != nil {
+			return err
+		}
+	}
+
+	if index < lowest {
+		return nil
+	}
+	return kv.store.Set(context.TODO(), index, deposit)
 }
 
-// Prune removes the [start, end) deposits from the store.
+// Prune removes the [start, end) deposits from the store. Indexes below the
+// lowest retained index are skipped, and the lowest retained index is only
+// advanced when the pruned range is contiguous with it.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
+	ctx := context.TODO()
+
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	for i := range end {
+
+	lowest, err := kv.getLowestRetained()
+	if err != nil {
+		return err
+	}
+
+	for i := max(start, lowest); i < end; i++ {
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(context.TODO(), start+i); err != nil {
+		if err = kv.store.Remove(ctx, i); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	if start > lowest || end <= lowest {
+		return nil
+	}
+	return kv.lowestRetained.Set(ctx, end)
+}
+
+// getLowestRetained returns the persisted lowest retained index, defaulting
+// to zero if nothing has been pruned yet.
+func (kv *KVStore[DepositT]) getLowestRetained() (uint64, error) {
+	lowest, err := kv.lowestRetained.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return lowest, err
+}
+
+// getNextIndex returns the persisted next deposit index, defaulting to zero
+// if no deposit has been enqueued yet.
+func (kv *KVStore[DepositT]) getNextIndex() (uint64, error) {
+	next, err := kv.nextIndex.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return next, err
 }
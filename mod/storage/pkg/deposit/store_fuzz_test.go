@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzPrune checks that for any sequence of [start, end) ranges the store
+// removes exactly the requested indexes and that every index below the
+// lowest retained index has been removed.
+func FuzzPrune(f *testing.F) {
+	f.Add(uint8(32), []byte{0, 4, 4, 8, 2, 10})
+	f.Add(uint8(16), []byte{3, 5, 0, 3})
+	f.Add(uint8(8), []byte{0, 0, 7, 2})
+	f.Fuzz(func(t *testing.T, n uint8, rawRanges []byte) {
+		numDeposits := uint64(n)
+		kv := newStore(t, numDeposits)
+		expected := make([]bool, numDeposits)
+		for i := 0; i+1 < len(rawRanges); i += 2 {
+			start, end := uint64(rawRanges[i]), uint64(rawRanges[i+1])
+			require.NoError(t, kv.Prune(start, end))
+			for j := start; j < min(end, numDeposits); j++ {
+				expected[j] = true
+			}
+		}
+
+		lowest, err := kv.GetLowestRetainedIndex()
+		require.NoError(t, err)
+		for i := range min(lowest, numDeposits) {
+			require.True(t, expected[i], "index %d below lowest retained", i)
+		}
+
+		for i := lowest; i < numDeposits; i++ {
+			deposits, err := kv.GetDepositsByIndex(i, 1)
+			require.NoError(t, err)
+			require.Equal(t, expected[i], len(deposits) == 0, "index %d", i)
+		}
+		if lowest > 0 {
+			_, err = kv.GetDepositsByIndex(lowest-1, 1)
+			require.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,290 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/migration"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a minimal deposit that only carries its index.
+type testDeposit struct {
+	index uint64
+}
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, d.index), nil
+}
+
+func (d *testDeposit) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != 8 {
+		return errors.New("invalid deposit length")
+	}
+	d.index = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (*testDeposit) Empty() *testDeposit {
+	return &testDeposit{}
+}
+
+func (d *testDeposit) GetIndex() math.U64 {
+	return math.U64(d.index)
+}
+
+// kvStoreService is an in-memory store.KVStoreService for tests.
+type kvStoreService struct {
+	*storev2.MemDB
+}
+
+func (s kvStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.MemDB
+}
+
+func newStore(t *testing.T, numDeposits uint64) *deposit.KVStore[*testDeposit] {
+	t.Helper()
+	return newStoreWith(
+		t, kvStoreService{MemDB: storev2.NewMemDB()}, numDeposits,
+	)
+}
+
+func newStoreWith(
+	t *testing.T,
+	kvs kvStoreService,
+	numDeposits uint64,
+) *deposit.KVStore[*testDeposit] {
+	t.Helper()
+	kv := deposit.NewStore[*testDeposit](kvs)
+	deposits := make([]*testDeposit, 0, numDeposits)
+	for i := range numDeposits {
+		deposits = append(deposits, &testDeposit{index: i})
+	}
+	require.NoError(t, kv.EnqueueDeposits(deposits))
+	return kv
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		name           string
+		ranges         [][2]uint64
+		expectedLowest uint64
+		expectedPruned []uint64
+	}{
+		{
+			name:           "PruneFromZero",
+			ranges:         [][2]uint64{{0, 4}},
+			expectedLowest: 4,
+			expectedPruned: []uint64{0, 1, 2, 3},
+		},
+		{
+			name:           "PruneEmptyRange",
+			ranges:         [][2]uint64{{3, 3}},
+			expectedLowest: 0,
+			expectedPruned: []uint64{},
+		},
+		{
+			name:           "PruneContiguousRanges",
+			ranges:         [][2]uint64{{0, 2}, {2, 5}},
+			expectedLowest: 5,
+			expectedPruned: []uint64{0, 1, 2, 3, 4},
+		},
+		{
+			name:           "PruneOverlappingRanges",
+			ranges:         [][2]uint64{{0, 5}, {1, 3}, {0, 6}},
+			expectedLowest: 6,
+			expectedPruned: []uint64{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name:           "PruneDetachedRange",
+			ranges:         [][2]uint64{{4, 6}},
+			expectedLowest: 0,
+			expectedPruned: []uint64{4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newStore(t, 10)
+			for _, r := range tt.ranges {
+				require.NoError(t, kv.Prune(r[0], r[1]))
+			}
+
+			lowest, err := kv.GetLowestRetainedIndex()
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedLowest, lowest)
+
+			pruned := make(map[uint64]struct{})
+			for _, index := range tt.expectedPruned {
+				pruned[index] = struct{}{}
+			}
+			for i := lowest; i < 10; i++ {
+				deposits, err := kv.GetDepositsByIndex(i, 1)
+				require.NoError(t, err)
+				if _, ok := pruned[i]; ok {
+					require.Empty(t, deposits, "index %d", i)
+				} else {
+					require.Len(t, deposits, 1, "index %d", i)
+					require.Equal(t, i, deposits[0].index)
+				}
+			}
+		})
+	}
+}
+
+func TestGetDepositsByIndexPruned(t *testing.T) {
+	kv := newStore(t, 10)
+	require.NoError(t, kv.Prune(0, 5))
+
+	_, err := kv.GetDepositsByIndex(4, 2)
+	require.ErrorIs(t, err, deposit.ErrDepositPruned)
+
+	deposits, err := kv.GetDepositsByIndex(5, 16)
+	require.NoError(t, err)
+	require.Len(t, deposits, 5)
+	require.Equal(t, uint64(5), deposits[0].index)
+}
+
+func TestEnqueueBelowLowestRetained(t *testing.T) {
+	kvs := kvStoreService{MemDB: storev2.NewMemDB()}
+	kv := newStoreWith(t, kvs, 10)
+	require.NoError(t, kv.Prune(0, 5))
+
+	// Re-enqueueing pruned deposits must not resurrect them.
+	require.NoError(t, kv.EnqueueDeposits([]*testDeposit{{index: 3}}))
+	_, err := kv.GetDepositsByIndex(3, 1)
+	require.ErrorIs(t, err, deposit.ErrDepositPruned)
+
+	key := binary.BigEndian.AppendUint64([]byte(deposit.KeyDepositPrefix), 3)
+	found, err := kvs.Has(key)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	lowest, err := kv.GetLowestRetainedIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), lowest)
+}
+
+func TestLowestRetainedPersisted(t *testing.T) {
+	kvs := kvStoreService{MemDB: storev2.NewMemDB()}
+	kv := deposit.NewStore[*testDeposit](kvs)
+	require.NoError(t, kv.Prune(0, 7))
+
+	// A store reopened over the same database sees the pruned index.
+	reopened := deposit.NewStore[*testDeposit](kvs)
+	lowest, err := reopened.GetLowestRetainedIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), lowest)
+}
+
+func TestSchemaVersion(t *testing.T) {
+	kvs := kvStoreService{MemDB: storev2.NewMemDB()}
+	kv := deposit.NewStore[*testDeposit](kvs)
+	version, err := kv.GetSchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), version)
+
+	require.NoError(t, kv.SetSchemaVersion(1))
+	reopened := deposit.NewStore[*testDeposit](kvs)
+	version, err = reopened.GetSchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+}
+
+func TestSyncProgressPersisted(t *testing.T) {
+	kvs := kvStoreService{MemDB: storev2.NewMemDB()}
+	kv := deposit.NewStore[*testDeposit](kvs)
+	next, err := kv.GetNextDepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), next)
+
+	require.NoError(t, kv.EnqueueDeposits(
+		[]*testDeposit{{index: 0}, {index: 2}, {index: 1}},
+	))
+	require.NoError(t, kv.SetLastProcessedBlock(42))
+	require.NoError(t, kv.Prune(0, 3))
+
+	// Pruning does not rewind the next index, and both survive a reopen.
+	reopened := deposit.NewStore[*testDeposit](kvs)
+	next, err = reopened.GetNextDepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+	blockNum, err := reopened.GetLastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), blockNum)
+}
+
+func TestMigrateSeedsSyncProgress(t *testing.T) {
+	kvs := kvStoreService{MemDB: storev2.NewMemDB()}
+	kv := newStoreWith(t, kvs, 5)
+	require.NoError(t, kv.Prune(0, 2))
+
+	// Stores written before the sync progress was tracked lack it.
+	require.NoError(t, kvs.Delete([]byte(deposit.KeyNextIndexPrefix)))
+
+	registry := migration.NewRegistry()
+	require.NoError(t, registry.Register(
+		"deposits", kv, kv.Migrations(
+			func(context.Context) (uint64, error) { return 42, nil },
+		)...,
+	))
+	_, err := registry.Run(context.Background())
+	require.NoError(t, err)
+
+	version, err := kv.GetSchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+	next, err := kv.GetNextDepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), next)
+	blockNum, err := kv.GetLastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), blockNum)
+}
+
+func TestMigrateKeepsSyncProgress(t *testing.T) {
+	kv := newStore(t, 5)
+	require.NoError(t, kv.SetLastProcessedBlock(7))
+
+	registry := migration.NewRegistry()
+	require.NoError(t, registry.Register(
+		"deposits", kv, kv.Migrations(
+			func(context.Context) (uint64, error) { return 42, nil },
+		)...,
+	))
+	_, err := registry.Run(context.Background())
+	require.NoError(t, err)
+
+	next, err := kv.GetNextDepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), next)
+	blockNum, err := kv.GetLastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), blockNum)
+}
@@ -22,20 +22,34 @@ package filedb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
-// two is a constant for the number 2.
-const two = 2
+const (
+	// two is a constant for the number 2.
+	two = 2
+	// schemaVersionSize is the size of an encoded schema version.
+	schemaVersionSize = 8
+	// SchemaVersionKey is the key under which the schema version is stored.
+	// It is not prefixed by an index, so it is never pruned.
+	SchemaVersionKey = "schema_version"
+)
 
 // Compile-time assertion of prunable interface.
-var _ pruner.Prunable = (*RangeDB)(nil)
+var (
+	_ pruner.Prunable       = (*RangeDB)(nil)
+	_ pruner.LowestRetained = (*RangeDB)(nil)
+)
 
 // RangeDB is a database that stores versioned data.
 // It prefixes keys with an index.
@@ -78,6 +92,36 @@ func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
 	return db.DB.Set(db.prefix(index, key), value)
 }
 
+// GetByIndex returns every value stored under the given index.
+func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: get by index not supported for this db")
+	}
+
+	dir := strconv.FormatUint(index, 10)
+	entries, err := afero.ReadDir(f.fs, dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	values := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		bz, err := afero.ReadFile(f.fs, filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, bz)
+	}
+	return values, nil
+}
+
 // Delete removes the value associated with the given index and key from the
 // database. It prefixes the key with the index and a slash before deleting it
 // from the underlying database.
@@ -117,6 +161,67 @@ func (db *RangeDB) Prune(start, end uint64) error {
 	return nil
 }
 
+// GetLowestRetainedIndex returns the lowest index that may still hold data.
+// If nothing has been pruned since the db was opened, the lowest index is
+// recovered from the filesystem.
+func (db *RangeDB) GetLowestRetainedIndex() (uint64, error) {
+	f, ok := db.DB.(*DB)
+	if !ok || db.firstNonNilIndex != 0 {
+		return db.firstNonNilIndex, nil
+	}
+
+	entries, err := afero.ReadDir(f.fs, ".")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var lowest *uint64
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		index, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		if lowest == nil || index < *lowest {
+			lowest = &index
+		}
+	}
+	if lowest == nil {
+		return 0, nil
+	}
+	return *lowest, nil
+}
+
+// GetSchemaVersion returns the schema version of the db, or 0 if none has
+// been recorded.
+func (db *RangeDB) GetSchemaVersion() (uint64, error) {
+	ok, err := db.DB.Has([]byte(SchemaVersionKey))
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	bz, err := db.DB.Get([]byte(SchemaVersionKey))
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) != schemaVersionSize {
+		return 0, errors.Newf("invalid schema version length: %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetSchemaVersion records the schema version of the db.
+func (db *RangeDB) SetSchemaVersion(version uint64) error {
+	bz := make([]byte, schemaVersionSize)
+	binary.BigEndian.PutUint64(bz, version)
+	return db.DB.Set([]byte(SchemaVersionKey), bz)
+}
+
 // prefix prefixes the given key with the index and a slash.
 func (db *RangeDB) prefix(index uint64, key []byte) []byte {
 	return []byte(fmt.Sprintf("%d/%s", index, hex.FromBytes(key).Unwrap()))
@@ -277,6 +277,68 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_GetLowestRetainedIndex(t *testing.T) {
+	path := t.TempDir()
+	rdb := file.NewRangeDB(newTestFDB(path))
+
+	lowest, err := rdb.GetLowestRetainedIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), lowest)
+
+	require.NoError(t, populateTestDB(rdb, 3, 8))
+
+	// A reopened db recovers the lowest index from the filesystem.
+	reopened := file.NewRangeDB(newTestFDB(path))
+	lowest, err = reopened.GetLowestRetainedIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), lowest)
+
+	require.NoError(t, reopened.Prune(0, 5))
+	lowest, err = reopened.GetLowestRetainedIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), lowest)
+}
+
+func TestRangeDB_GetByIndex(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+	require.NoError(t, rdb.Set(1, []byte("a"), []byte("value-a")))
+	require.NoError(t, rdb.Set(1, []byte("b"), []byte("value-b")))
+	require.NoError(t, rdb.Set(2, []byte("c"), []byte("value-c")))
+
+	values, err := rdb.GetByIndex(1)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, [][]byte{[]byte("value-a"), []byte("value-b")}, values,
+	)
+
+	values, err = rdb.GetByIndex(3)
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+func TestRangeDB_SchemaVersion(t *testing.T) {
+	path := t.TempDir()
+	rdb := file.NewRangeDB(newTestFDB(path))
+
+	version, err := rdb.GetSchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), version)
+
+	require.NoError(t, populateTestDB(rdb, 0, 4))
+	require.NoError(t, rdb.SetSchemaVersion(2))
+
+	// The version survives reopening and pruning the db.
+	reopened := file.NewRangeDB(newTestFDB(path))
+	require.NoError(t, reopened.Prune(0, 4))
+	version, err = reopened.GetSchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+
+	lowest, err := reopened.GetLowestRetainedIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), lowest)
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -29,22 +29,26 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/migration"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
-// DBManager is a manager for all pruners.
+// DBManager is a manager for all pruners and schema migrations.
 type DBManager struct {
-	pruners []pruner.Pruner[pruner.Prunable]
-	logger  log.Logger[any]
+	migrations *migration.Registry
+	pruners    []pruner.Pruner[pruner.Prunable]
+	logger     log.Logger[any]
 }
 
 func NewDBManager(
 	logger log.Logger[any],
+	migrations *migration.Registry,
 	pruners ...pruner.Pruner[pruner.Prunable],
 ) (*DBManager, error) {
 	return &DBManager{
-		logger:  logger,
-		pruners: pruners,
+		logger:     logger,
+		migrations: migrations,
+		pruners:    pruners,
 	}, nil
 }
 
@@ -53,8 +57,23 @@ func (m *DBManager) Name() string {
 	return "db-manager"
 }
 
-// Start starts all pruners.
+// Start migrates the schema of all stores and starts all pruners. It
+// refuses to start if a store has a newer schema than supported.
 func (m *DBManager) Start(ctx context.Context) error {
+	if m.migrations != nil {
+		steps, err := m.migrations.Run(ctx)
+		if err != nil {
+			return err
+		}
+		for _, step := range steps {
+			m.logger.Info(
+				"migrated store schema",
+				"store", step.Store, "from", step.From, "to", step.To,
+				"description", step.Description,
+			)
+		}
+	}
+
 	for _, pruner := range m.pruners {
 		pruner.Start(ctx)
 	}
@@ -58,7 +58,7 @@ func TestDBManager_Start(t *testing.T) {
 		*mocks.Prunable,
 	](logger, mockPrunable, "pruner2", ch, pruneParamsFn)
 
-	m, err := manager.NewDBManager(logger, p1, p2)
+	m, err := manager.NewDBManager(logger, nil, p1, p2)
 	require.NoError(t, err)
 
 	ctx, cancel := context.WithCancel(context.Background())
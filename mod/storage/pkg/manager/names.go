@@ -34,3 +34,15 @@ const (
 	// BlockPrunerName is the name of the block store pruner.
 	BlockPrunerName = "block-store-pruner"
 )
+
+const (
+	// DepositStoreName is the name of the deposit store.
+	DepositStoreName = "deposit-store"
+	// AvailabilityStoreName is the name of the availability store.
+	AvailabilityStoreName = "availability-store"
+	// BlockStoreName is the name of the block store.
+	BlockStoreName = "block-store"
+	// BeaconStateName is the name of the beacon state, whose schema version
+	// is recorded in the node-local metadata.
+	BeaconStateName = "beacon-state"
+)
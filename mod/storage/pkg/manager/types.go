@@ -33,6 +33,8 @@ import (
 // BeaconBlock is an interface for beacon blocks.
 type BeaconBlock interface {
 	GetSlot() math.U64
+	// GetTimestamp returns the timestamp of the block's execution payload.
+	GetTimestamp() math.U64
 }
 
 // BlockEvent is an interface for block events.
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package migration
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNewerSchema is returned when a store has a schema version newer
+	// than the latest version known to this binary.
+	ErrNewerSchema = errors.New("store schema is newer than supported")
+	// ErrInvalidMigrations is returned when the migrations registered for a
+	// store are not numbered consecutively from 1.
+	ErrInvalidMigrations = errors.New("invalid migrations")
+	// ErrStoreAlreadyRegistered is returned when a store is registered more
+	// than once.
+	ErrStoreAlreadyRegistered = errors.New("store already registered")
+)
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// entry holds the migrations registered for a store.
+type entry struct {
+	name       string
+	store      VersionedStore
+	migrations []Migration
+}
+
+// latest returns the latest schema version of the store.
+func (e *entry) latest() uint64 {
+	return uint64(len(e.migrations))
+}
+
+// Registry runs the migrations registered for a set of stores.
+type Registry struct {
+	entries []*entry
+}
+
+// NewRegistry creates a new, empty migration registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register registers a store along with its migrations. Migrations must be
+// numbered consecutively starting from version 1, so that the latest schema
+// version of the store is the number of migrations.
+func (r *Registry) Register(
+	name string,
+	store VersionedStore,
+	migrations ...Migration,
+) error {
+	for _, e := range r.entries {
+		if e.name == name {
+			return errors.Wrap(ErrStoreAlreadyRegistered, name)
+		}
+	}
+	for i, m := range migrations {
+		if m.Version != uint64(i)+1 {
+			return errors.Wrapf(
+				ErrInvalidMigrations,
+				"store %s: expected version %d, got %d",
+				name, i+1, m.Version,
+			)
+		}
+	}
+	r.entries = append(r.entries, &entry{
+		name:       name,
+		store:      store,
+		migrations: migrations,
+	})
+	return nil
+}
+
+// Plan returns the migrations pending for every registered store, in the
+// order they would be applied. It errors if any store has a schema newer
+// than the latest version known to this binary.
+func (r *Registry) Plan() ([]Step, error) {
+	var steps []Step
+	for _, e := range r.entries {
+		pending, err := e.pending()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range pending {
+			steps = append(steps, Step{
+				Store:       e.name,
+				From:        m.Version - 1,
+				To:          m.Version,
+				Description: m.Description,
+			})
+		}
+	}
+	return steps, nil
+}
+
+// Run applies the migrations pending for every registered store and returns
+// the steps that were applied. The schema version of a store is recorded
+// after each migration, so an interrupted run resumes where it left off.
+// No store is migrated if any store has a newer schema than supported.
+func (r *Registry) Run(ctx context.Context) ([]Step, error) {
+	steps, err := r.Plan()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range r.entries {
+		if err = e.migrate(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return steps, nil
+}
+
+// migrate applies the migrations pending for the store, recording the
+// schema version after each one.
+func (e *entry) migrate(ctx context.Context) error {
+	pending, err := e.pending()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range pending {
+		if m.Apply != nil {
+			if err = m.Apply(ctx); err != nil {
+				return errors.Wrapf(
+					err, "store %s: migration to version %d",
+					e.name, m.Version,
+				)
+			}
+		}
+		if err = e.store.SetSchemaVersion(m.Version); err != nil {
+			return errors.Wrapf(err, "store %s", e.name)
+		}
+	}
+	return nil
+}
+
+// pending returns the migrations that have not been applied to the store.
+func (e *entry) pending() ([]Migration, error) {
+	version, err := e.store.GetSchemaVersion()
+	if err != nil {
+		return nil, errors.Wrapf(err, "store %s", e.name)
+	}
+	if version > e.latest() {
+		return nil, errors.Wrapf(
+			ErrNewerSchema, "store %s: version %d, latest supported %d",
+			e.name, version, e.latest(),
+		)
+	}
+	return e.migrations[version:], nil
+}
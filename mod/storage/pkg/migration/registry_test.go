@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package migration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/migration"
+	"github.com/stretchr/testify/require"
+)
+
+// testStore is an in-memory versioned store.
+type testStore struct {
+	version uint64
+}
+
+func (s *testStore) GetSchemaVersion() (uint64, error) {
+	return s.version, nil
+}
+
+func (s *testStore) SetSchemaVersion(version uint64) error {
+	s.version = version
+	return nil
+}
+
+func TestRegistryRun(t *testing.T) {
+	var applied []uint64
+	apply := func(version uint64) func(context.Context) error {
+		return func(context.Context) error {
+			applied = append(applied, version)
+			return nil
+		}
+	}
+
+	store := &testStore{version: 1}
+	r := migration.NewRegistry()
+	require.NoError(t, r.Register("test", store,
+		migration.Migration{Version: 1, Apply: apply(1)},
+		migration.Migration{Version: 2, Apply: apply(2)},
+		migration.Migration{Version: 3},
+	))
+
+	plan, err := r.Plan()
+	require.NoError(t, err)
+	require.Equal(t, []migration.Step{
+		{Store: "test", From: 1, To: 2},
+		{Store: "test", From: 2, To: 3},
+	}, plan)
+	require.Equal(t, uint64(1), store.version, "plan must not migrate")
+
+	steps, err := r.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, plan, steps)
+	require.Equal(t, []uint64{2}, applied)
+	require.Equal(t, uint64(3), store.version)
+
+	// A second run has nothing left to do.
+	steps, err = r.Run(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, steps)
+}
+
+func TestRegistryNewerSchema(t *testing.T) {
+	older := &testStore{}
+	newer := &testStore{version: 2}
+	r := migration.NewRegistry()
+	require.NoError(t, r.Register(
+		"older", older, migration.Migration{Version: 1},
+	))
+	require.NoError(t, r.Register(
+		"newer", newer, migration.Migration{Version: 1},
+	))
+
+	_, err := r.Run(context.Background())
+	require.ErrorIs(t, err, migration.ErrNewerSchema)
+	require.Equal(t, uint64(0), older.version, "no store may be migrated")
+}
+
+func TestRegistryFailedMigration(t *testing.T) {
+	errFailed := errors.New("failed")
+	store := &testStore{}
+	r := migration.NewRegistry()
+	require.NoError(t, r.Register("test", store,
+		migration.Migration{Version: 1},
+		migration.Migration{
+			Version: 2,
+			Apply:   func(context.Context) error { return errFailed },
+		},
+	))
+
+	_, err := r.Run(context.Background())
+	require.ErrorIs(t, err, errFailed)
+	require.Equal(t, uint64(1), store.version)
+}
+
+func TestRegistryRegister(t *testing.T) {
+	r := migration.NewRegistry()
+	require.ErrorIs(t, r.Register(
+		"test", &testStore{}, migration.Migration{Version: 2},
+	), migration.ErrInvalidMigrations)
+
+	require.NoError(t, r.Register("test", &testStore{}))
+	require.ErrorIs(
+		t, r.Register("test", &testStore{}),
+		migration.ErrStoreAlreadyRegistered,
+	)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package migration
+
+import "context"
+
+// VersionedStore is a store that records the version of its schema.
+type VersionedStore interface {
+	// GetSchemaVersion returns the schema version of the store. Stores that
+	// have never recorded a version are at version 0.
+	GetSchemaVersion() (uint64, error)
+	// SetSchemaVersion records the schema version of the store.
+	SetSchemaVersion(version uint64) error
+}
+
+// Migration upgrades the schema of a store to a given version.
+type Migration struct {
+	// Version is the schema version of the store after the migration.
+	Version uint64
+	// Description describes the layout change made by the migration.
+	Description string
+	// Apply performs the migration. A nil Apply only records the new
+	// version.
+	Apply func(ctx context.Context) error
+}
+
+// Step is a migration that is pending for a store.
+type Step struct {
+	// Store is the name of the store.
+	Store string
+	// From is the schema version of the store before the migration.
+	From uint64
+	// To is the schema version of the store after the migration.
+	To uint64
+	// Description describes the layout change made by the migration.
+	Description string
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package pruner
+
+import "time"
+
+const (
+	// DefaultInterval is the default interval between pruning batches.
+	DefaultInterval = 10 * time.Second
+	// DefaultBatchSize is the default maximum number of indexes pruned per
+	// batch.
+	DefaultBatchSize = 1024
+)
+
+// Config is the configuration for the storage pruners.
+type Config struct {
+	// Interval is the interval at which pending prune targets are pruned in
+	// the background. If zero, pruning happens inline on every finalized
+	// block.
+	Interval time.Duration `mapstructure:"interval"`
+	// BatchSize is the maximum number of indexes pruned per interval. If
+	// zero, every pending index is pruned at once.
+	BatchSize uint64 `mapstructure:"batch-size"`
+	// Blocks is the retention configuration for the block store.
+	Blocks RetentionConfig `mapstructure:"blocks"`
+	// Blobs is the retention configuration for the availability store.
+	Blobs RetentionConfig `mapstructure:"blobs"`
+}
+
+// RetentionConfig configures the retention policies applied to a store on
+// top of its default prune range.
+type RetentionConfig struct {
+	// MaxSizeBytes is the disk usage target for the store in bytes. If zero,
+	// no size-based retention is applied.
+	MaxSizeBytes uint64 `mapstructure:"max-size-bytes"`
+	// MaxAge is the retention for the store, measured by block timestamps.
+	// If zero, no time-based retention is applied.
+	MaxAge time.Duration `mapstructure:"max-age"`
+}
+
+// DefaultConfig returns the default configuration for the storage pruners.
+func DefaultConfig() Config {
+	return Config{
+		Interval:  DefaultInterval,
+		BatchSize: DefaultBatchSize,
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package pruner
+
+// metrics is a struct that contains metrics for the pruner.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+	// name is the name of the pruner, used to label metrics.
+	name string
+	// reclaimed is the total number of bytes reclaimed by the pruner.
+	reclaimed uint64
+}
+
+// newMetrics creates a new instance of the metrics struct.
+func newMetrics(sink TelemetrySink, name string) *metrics {
+	return &metrics{
+		sink: sink,
+		name: name,
+	}
+}
+
+// markPruned reports a pruned range ending at end, along with the size of
+// the store before and after pruning it.
+func (m *metrics) markPruned(end, before, after uint64) {
+	if m.sink == nil {
+		return
+	}
+
+	m.sink.IncrementCounter(
+		"beacon_kit.storage.pruner.prune_count", "store", m.name,
+	)
+	m.sink.SetGauge(
+		"beacon_kit.storage.pruner.lowest_retained",
+		//#nosec:G701 // indexes never exceed max int64.
+		int64(end),
+		"store", m.name,
+	)
+
+	if before == 0 && after == 0 {
+		return
+	}
+	if before > after {
+		m.reclaimed += before - after
+	}
+	//#nosec:G701 // store sizes never exceed max int64.
+	m.sink.SetGauge(
+		"beacon_kit.storage.pruner.bytes_reclaimed",
+		int64(m.reclaimed),
+		"store", m.name,
+	)
+	//#nosec:G701 // store sizes never exceed max int64.
+	m.sink.SetGauge(
+		"beacon_kit.storage.pruner.store_size",
+		int64(after),
+		"store", m.name,
+	)
+}
@@ -65,6 +65,51 @@ func (_c *BeaconBlock_GetSlot_Call) RunAndReturn(run func() math.U64) *BeaconBlo
 	return _c
 }
 
+// GetTimestamp provides a mock function with given fields:
+func (_m *BeaconBlock) GetTimestamp() math.U64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimestamp")
+	}
+
+	var r0 math.U64
+	if rf, ok := ret.Get(0).(func() math.U64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(math.U64)
+	}
+
+	return r0
+}
+
+// BeaconBlock_GetTimestamp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimestamp'
+type BeaconBlock_GetTimestamp_Call struct {
+	*mock.Call
+}
+
+// GetTimestamp is a helper method to define mock.On call
+func (_e *BeaconBlock_Expecter) GetTimestamp() *BeaconBlock_GetTimestamp_Call {
+	return &BeaconBlock_GetTimestamp_Call{Call: _e.mock.On("GetTimestamp")}
+}
+
+func (_c *BeaconBlock_GetTimestamp_Call) Run(run func()) *BeaconBlock_GetTimestamp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconBlock_GetTimestamp_Call) Return(_a0 math.U64) *BeaconBlock_GetTimestamp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BeaconBlock_GetTimestamp_Call) RunAndReturn(run func() math.U64) *BeaconBlock_GetTimestamp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBeaconBlock creates a new instance of BeaconBlock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBeaconBlock(t interface {
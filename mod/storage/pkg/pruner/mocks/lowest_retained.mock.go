@@ -0,0 +1,87 @@
+// Code generated by mockery v2.44.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// LowestRetained is an autogenerated mock type for the LowestRetained type
+type LowestRetained struct {
+	mock.Mock
+}
+
+type LowestRetained_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LowestRetained) EXPECT() *LowestRetained_Expecter {
+	return &LowestRetained_Expecter{mock: &_m.Mock}
+}
+
+// GetLowestRetainedIndex provides a mock function with given fields:
+func (_m *LowestRetained) GetLowestRetainedIndex() (uint64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLowestRetainedIndex")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (uint64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LowestRetained_GetLowestRetainedIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLowestRetainedIndex'
+type LowestRetained_GetLowestRetainedIndex_Call struct {
+	*mock.Call
+}
+
+// GetLowestRetainedIndex is a helper method to define mock.On call
+func (_e *LowestRetained_Expecter) GetLowestRetainedIndex() *LowestRetained_GetLowestRetainedIndex_Call {
+	return &LowestRetained_GetLowestRetainedIndex_Call{Call: _e.mock.On("GetLowestRetainedIndex")}
+}
+
+func (_c *LowestRetained_GetLowestRetainedIndex_Call) Run(run func()) *LowestRetained_GetLowestRetainedIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LowestRetained_GetLowestRetainedIndex_Call) Return(_a0 uint64, _a1 error) *LowestRetained_GetLowestRetainedIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LowestRetained_GetLowestRetainedIndex_Call) RunAndReturn(run func() (uint64, error)) *LowestRetained_GetLowestRetainedIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLowestRetained creates a new instance of LowestRetained. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLowestRetained(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LowestRetained {
+	mock := &LowestRetained{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
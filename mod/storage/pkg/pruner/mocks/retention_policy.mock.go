@@ -0,0 +1,94 @@
+// Code generated by mockery v2.44.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RetentionPolicy is an autogenerated mock type for the RetentionPolicy type
+type RetentionPolicy struct {
+	mock.Mock
+}
+
+type RetentionPolicy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RetentionPolicy) EXPECT() *RetentionPolicy_Expecter {
+	return &RetentionPolicy_Expecter{mock: &_m.Mock}
+}
+
+// PruneEnd provides a mock function with given fields: lowest, latest, now
+func (_m *RetentionPolicy) PruneEnd(lowest uint64, latest uint64, now time.Time) (uint64, error) {
+	ret := _m.Called(lowest, latest, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneEnd")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, time.Time) (uint64, error)); ok {
+		return rf(lowest, latest, now)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64, time.Time) uint64); ok {
+		r0 = rf(lowest, latest, now)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64, time.Time) error); ok {
+		r1 = rf(lowest, latest, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetentionPolicy_PruneEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneEnd'
+type RetentionPolicy_PruneEnd_Call struct {
+	*mock.Call
+}
+
+// PruneEnd is a helper method to define mock.On call
+//   - lowest uint64
+//   - latest uint64
+//   - now time.Time
+func (_e *RetentionPolicy_Expecter) PruneEnd(lowest interface{}, latest interface{}, now interface{}) *RetentionPolicy_PruneEnd_Call {
+	return &RetentionPolicy_PruneEnd_Call{Call: _e.mock.On("PruneEnd", lowest, latest, now)}
+}
+
+func (_c *RetentionPolicy_PruneEnd_Call) Run(run func(lowest uint64, latest uint64, now time.Time)) *RetentionPolicy_PruneEnd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RetentionPolicy_PruneEnd_Call) Return(_a0 uint64, _a1 error) *RetentionPolicy_PruneEnd_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RetentionPolicy_PruneEnd_Call) RunAndReturn(run func(uint64, uint64, time.Time) (uint64, error)) *RetentionPolicy_PruneEnd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRetentionPolicy creates a new instance of RetentionPolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRetentionPolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RetentionPolicy {
+	mock := &RetentionPolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,129 @@
+// Code generated by mockery v2.44.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// TelemetrySink is an autogenerated mock type for the TelemetrySink type
+type TelemetrySink struct {
+	mock.Mock
+}
+
+type TelemetrySink_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TelemetrySink) EXPECT() *TelemetrySink_Expecter {
+	return &TelemetrySink_Expecter{mock: &_m.Mock}
+}
+
+// IncrementCounter provides a mock function with given fields: key, args
+func (_m *TelemetrySink) IncrementCounter(key string, args ...string) {
+	_va := make([]interface{}, len(args))
+	for _i := range args {
+		_va[_i] = args[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// TelemetrySink_IncrementCounter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementCounter'
+type TelemetrySink_IncrementCounter_Call struct {
+	*mock.Call
+}
+
+// IncrementCounter is a helper method to define mock.On call
+//   - key string
+//   - args ...string
+func (_e *TelemetrySink_Expecter) IncrementCounter(key interface{}, args ...interface{}) *TelemetrySink_IncrementCounter_Call {
+	return &TelemetrySink_IncrementCounter_Call{Call: _e.mock.On("IncrementCounter",
+		append([]interface{}{key}, args...)...)}
+}
+
+func (_c *TelemetrySink_IncrementCounter_Call) Run(run func(key string, args ...string)) *TelemetrySink_IncrementCounter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TelemetrySink_IncrementCounter_Call) Return() *TelemetrySink_IncrementCounter_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelemetrySink_IncrementCounter_Call) RunAndReturn(run func(string, ...string)) *TelemetrySink_IncrementCounter_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetGauge provides a mock function with given fields: key, value, args
+func (_m *TelemetrySink) SetGauge(key string, value int64, args ...string) {
+	_va := make([]interface{}, len(args))
+	for _i := range args {
+		_va[_i] = args[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key, value)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// TelemetrySink_SetGauge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGauge'
+type TelemetrySink_SetGauge_Call struct {
+	*mock.Call
+}
+
+// SetGauge is a helper method to define mock.On call
+//   - key string
+//   - value int64
+//   - args ...string
+func (_e *TelemetrySink_Expecter) SetGauge(key interface{}, value interface{}, args ...interface{}) *TelemetrySink_SetGauge_Call {
+	return &TelemetrySink_SetGauge_Call{Call: _e.mock.On("SetGauge",
+		append([]interface{}{key, value}, args...)...)}
+}
+
+func (_c *TelemetrySink_SetGauge_Call) Run(run func(key string, value int64, args ...string)) *TelemetrySink_SetGauge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(string), args[1].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TelemetrySink_SetGauge_Call) Return() *TelemetrySink_SetGauge_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TelemetrySink_SetGauge_Call) RunAndReturn(run func(string, int64, ...string)) *TelemetrySink_SetGauge_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewTelemetrySink creates a new instance of TelemetrySink. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTelemetrySink(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TelemetrySink {
+	mock := &TelemetrySink{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
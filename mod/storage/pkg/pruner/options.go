@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package pruner
+
+import "time"
+
+// Option is a functional option for the pruner.
+type Option func(*options)
+
+// options holds the optional configuration of a pruner.
+type options struct {
+	interval  time.Duration
+	batchSize uint64
+	policies  []RetentionPolicy
+	sizeFn    func() (uint64, error)
+	sink      TelemetrySink
+}
+
+// WithCompaction makes the pruner accumulate prune targets and prune them in
+// the background every interval, at most batchSize indexes at a time.
+func WithCompaction(interval time.Duration, batchSize uint64) Option {
+	return func(o *options) {
+		o.interval = interval
+		o.batchSize = batchSize
+	}
+}
+
+// WithRetentionPolicies adds retention policies that can extend the range
+// computed by the prune range function.
+func WithRetentionPolicies(policies ...RetentionPolicy) Option {
+	return func(o *options) {
+		o.policies = append(o.policies, policies...)
+	}
+}
+
+// WithRetention adds the retention policies enabled by the given
+// configuration. The size of the store is only measured, through sizeFn, if
+// size-based retention is enabled, in which case it is also used to report
+// the bytes reclaimed by pruning.
+func WithRetention(cfg RetentionConfig, sizeFn func() (uint64, error)) Option {
+	return func(o *options) {
+		o.policies = append(o.policies, PoliciesFromConfig(cfg, sizeFn)...)
+		if cfg.MaxSizeBytes > 0 {
+			o.sizeFn = sizeFn
+		}
+	}
+}
+
+// WithSizeFn sets the function used to measure the size of the store, which
+// is used to report the bytes reclaimed by pruning. The size is measured
+// before and after every prune.
+func WithSizeFn(sizeFn func() (uint64, error)) Option {
+	return func(o *options) {
+		o.sizeFn = sizeFn
+	}
+}
+
+// WithTelemetrySink sets the telemetry sink used to report pruner metrics.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.sink = sink
+	}
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package pruner
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// RetentionPolicy decides which indexes of a slot-indexed store fall outside
+// of the retention the operator asked for.
+type RetentionPolicy interface {
+	// PruneEnd returns the exclusive end of the range of indexes that may be
+	// pruned, given the lowest retained index, the latest finalized index and
+	// the timestamp of the latest finalized block.
+	PruneEnd(lowest, latest uint64, now time.Time) (uint64, error)
+}
+
+// PoliciesFromConfig builds the retention policies described by the given
+// configuration. The size function is used by the size-based policy.
+func PoliciesFromConfig(
+	cfg RetentionConfig,
+	sizeFn func() (uint64, error),
+) []RetentionPolicy {
+	var policies []RetentionPolicy
+	if cfg.MaxSizeBytes > 0 && sizeFn != nil {
+		policies = append(policies, NewSizePolicy(cfg.MaxSizeBytes, sizeFn))
+	}
+	if cfg.MaxAge > 0 {
+		policies = append(policies, NewAgePolicy(cfg.MaxAge))
+	}
+	return policies
+}
+
+// sizePolicy prunes the oldest indexes of a store once its disk usage grows
+// beyond a target.
+//
+// Deleting entries from a store does not shrink it on disk until the
+// underlying database compacts, so the measured size cannot be compared
+// against the target after the policy has pruned. Instead, the size of the
+// store is estimated as the number of retained indexes times the average
+// size of an index, which is only re-measured once the store has reclaimed
+// space.
+type sizePolicy struct {
+	maxBytes uint64
+	sizeFn   func() (uint64, error)
+
+	mu sync.Mutex
+	// perIndex is the average size of an index in bytes.
+	perIndex uint64
+	// lastSize is the size measured by the previous call.
+	lastSize uint64
+	// pending is true when the policy has pruned indexes whose space has
+	// not been reclaimed yet.
+	pending bool
+}
+
+// NewSizePolicy creates a retention policy that keeps the size reported by
+// sizeFn at or below maxBytes.
+func NewSizePolicy(
+	maxBytes uint64,
+	sizeFn func() (uint64, error),
+) RetentionPolicy {
+	return &sizePolicy{
+		maxBytes: maxBytes,
+		sizeFn:   sizeFn,
+	}
+}
+
+// PruneEnd estimates the size of the retained indexes and returns enough of
+// the oldest indexes to bring the store back under its target.
+func (p *sizePolicy) PruneEnd(
+	lowest, latest uint64,
+	_ time.Time,
+) (uint64, error) {
+	size, err := p.sizeFn()
+	if err != nil {
+		return 0, err
+	}
+	if latest < lowest {
+		return lowest, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	retained := latest - lowest + 1
+	if !p.pending || size < p.lastSize {
+		// The measured size only accounts for retained indexes, either
+		// because nothing was pruned or because the space was reclaimed.
+		p.perIndex = max(size/retained, 1)
+		p.pending = false
+	}
+	p.lastSize = size
+
+	estimate := retained * p.perIndex
+	if estimate <= p.maxBytes {
+		return lowest, nil
+	}
+
+	p.pending = true
+	excess := estimate - p.maxBytes
+	return lowest + (excess+p.perIndex-1)/p.perIndex, nil
+}
+
+// agePolicy prunes indexes whose blocks are older than a maximum age, as
+// measured by block timestamps.
+//
+// Stores do not record the timestamp of every index, so the timestamp of an
+// index is estimated from the average block time observed since an anchor
+// block, which is the first block the policy was queried with. After a
+// restart the anchor is re-established from the next finalized block.
+type agePolicy struct {
+	maxAge time.Duration
+
+	mu          sync.Mutex
+	anchored    bool
+	anchorIndex uint64
+	anchorTime  time.Time
+}
+
+// NewAgePolicy creates a retention policy that keeps indexes for maxAge.
+func NewAgePolicy(maxAge time.Duration) RetentionPolicy {
+	return &agePolicy{
+		maxAge: maxAge,
+	}
+}
+
+// PruneEnd returns the end of the range of indexes whose blocks are
+// estimated to be older than now - maxAge, where now is the timestamp of the
+// latest finalized block.
+func (p *agePolicy) PruneEnd(
+	lowest, latest uint64,
+	now time.Time,
+) (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.anchored || latest < p.anchorIndex {
+		p.anchored, p.anchorIndex, p.anchorTime = true, latest, now
+		return lowest, nil
+	}
+	if latest == p.anchorIndex || !now.After(p.anchorTime) {
+		return lowest, nil
+	}
+
+	//#nosec:G701 // the number of indexes never overflows a duration.
+	perIndex := now.Sub(p.anchorTime) / time.Duration(latest-p.anchorIndex)
+	if perIndex <= 0 {
+		return lowest, nil
+	}
+
+	//#nosec:G701 // the quotient of two positive durations is positive.
+	retained := uint64(p.maxAge / perIndex)
+	if latest < retained {
+		return lowest, nil
+	}
+	return max(lowest, latest-retained), nil
+}
+
+// DirSizeFn returns a function that reports the total size in bytes of the
+// files under the given directory. A missing directory has a size of zero.
+func DirSizeFn(dir string) func() (uint64, error) {
+	return func() (uint64, error) {
+		var size uint64
+		err := filepath.WalkDir(
+			dir, func(_ string, d fs.DirEntry, err error) error {
+				if errors.Is(err, fs.ErrNotExist) {
+					// The store has not written anything yet.
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if d.IsDir() {
+					return nil
+				}
+				info, err := d.Info()
+				if err != nil {
+					return err
+				}
+				//#nosec:G701 // file sizes are never negative.
+				size += uint64(info.Size())
+				return nil
+			},
+		)
+		return size, err
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package pruner_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSizePolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxBytes    uint64
+		size        uint64
+		lowest      uint64
+		latest      uint64
+		expectedEnd uint64
+	}{
+		{
+			name:        "UnderTarget",
+			maxBytes:    1000,
+			size:        800,
+			lowest:      10,
+			latest:      100,
+			expectedEnd: 10,
+		},
+		{
+			name:        "OverTarget",
+			maxBytes:    1000,
+			size:        1500,
+			lowest:      0,
+			latest:      99,
+			expectedEnd: 34,
+		},
+		{
+			name:        "OverTargetFromLowest",
+			maxBytes:    100,
+			size:        200,
+			lowest:      50,
+			latest:      59,
+			expectedEnd: 55,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := pruner.NewSizePolicy(
+				tt.maxBytes,
+				func() (uint64, error) { return tt.size, nil },
+			)
+			end, err := policy.PruneEnd(tt.lowest, tt.latest, time.Now())
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedEnd, end)
+		})
+	}
+}
+
+func TestSizePolicyError(t *testing.T) {
+	errSize := errors.New("size unavailable")
+	policy := pruner.NewSizePolicy(
+		1, func() (uint64, error) { return 0, errSize },
+	)
+	_, err := policy.PruneEnd(0, 10, time.Now())
+	require.ErrorIs(t, err, errSize)
+}
+
+func TestSizePolicyUntilReclaimed(t *testing.T) {
+	size := uint64(1500)
+	policy := pruner.NewSizePolicy(
+		1000, func() (uint64, error) { return size, nil },
+	)
+
+	end, err := policy.PruneEnd(0, 99, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(34), end)
+
+	// The pruned indexes have not been reclaimed on disk yet, so the store
+	// is estimated from the retained indexes rather than pruned again.
+	end, err = policy.PruneEnd(34, 100, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(35), end)
+
+	// Once the space is reclaimed the average size is measured again.
+	size = 1300
+	end, err = policy.PruneEnd(35, 109, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(52), end)
+}
+
+func TestAgePolicy(t *testing.T) {
+	var (
+		policy = pruner.NewAgePolicy(time.Hour)
+		start  = time.Unix(0, 0)
+	)
+
+	// The first block anchors the policy.
+	end, err := policy.PruneEnd(0, 10, start)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), end)
+
+	// Blocks are ten seconds apart, so an hour is 360 blocks.
+	end, err = policy.PruneEnd(0, 20, start.Add(100*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), end)
+
+	end, err = policy.PruneEnd(0, 1000, start.Add(9900*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, uint64(640), end)
+
+	// The lowest retained index is never moved backwards.
+	end, err = policy.PruneEnd(700, 1001, start.Add(9910*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), end)
+}
+
+func TestAgePolicyAfterRestart(t *testing.T) {
+	var (
+		policy = pruner.NewAgePolicy(time.Hour)
+		start  = time.Unix(100000, 0)
+	)
+
+	// Indexes stored before the restart age out as soon as the block time
+	// can be estimated again.
+	end, err := policy.PruneEnd(0, 1000, start)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), end)
+
+	end, err = policy.PruneEnd(0, 1002, start.Add(20*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, uint64(642), end)
+}
+
+func TestPoliciesFromConfig(t *testing.T) {
+	sizeFn := func() (uint64, error) { return 0, nil }
+
+	require.Empty(t, pruner.PoliciesFromConfig(pruner.RetentionConfig{}, sizeFn))
+	require.Len(t, pruner.PoliciesFromConfig(pruner.RetentionConfig{
+		MaxSizeBytes: 1,
+		MaxAge:       time.Hour,
+	}, sizeFn), 2)
+}
+
+func TestDirSizeFn(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "1"), 0o700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "1", "a.ssz"), make([]byte, 100), 0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "b.ssz"), make([]byte, 28), 0o600,
+	))
+
+	size, err := pruner.DirSizeFn(dir)()
+	require.NoError(t, err)
+	require.Equal(t, uint64(128), size)
+
+	size, err = pruner.DirSizeFn(filepath.Join(dir, "missing"))()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), size)
+}
@@ -28,6 +28,7 @@ package pruner
 
 import (
 	"context"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
@@ -45,11 +46,19 @@ type pruner[
 	BlockEventT BlockEvent[BeaconBlockT],
 	PrunableT Prunable,
 ] struct {
+	options
 	prunable     Prunable
 	logger       log.Logger[any]
 	name         string
 	feed         chan BlockEventT
 	pruneRangeFn func(BlockEventT) (uint64, uint64)
+	metrics      *metrics
+
+	// lowest is the lowest index that has not been pruned yet.
+	lowest uint64
+	// target is the exclusive end of the range pending to be pruned in the
+	// background.
+	target uint64
 }
 
 // NewPruner creates a new Pruner.
@@ -63,36 +72,133 @@ func NewPruner[
 	name string,
 	feed chan BlockEventT,
 	pruneRangeFn func(BlockEventT) (uint64, uint64),
+	opts ...Option,
 ) Pruner[PrunableT] {
-	return &pruner[BeaconBlockT, BlockEventT, PrunableT]{
+	p := &pruner[BeaconBlockT, BlockEventT, PrunableT]{
 		logger:       logger,
 		prunable:     prunable,
 		name:         name,
 		feed:         feed,
 		pruneRangeFn: pruneRangeFn,
 	}
+	for _, opt := range opts {
+		opt(&p.options)
+	}
+	p.metrics = newMetrics(p.sink, name)
+	return p
 }
 
 // Start starts the Pruner by listening for new indexes to prune.
 func (p *pruner[_, _, _]) Start(ctx context.Context) {
+	if lr, ok := p.prunable.(LowestRetained); ok {
+		lowest, err := lr.GetLowestRetainedIndex()
+		if err != nil {
+			p.logger.Error("failed to get lowest retained index", "error", err)
+		}
+		p.lowest = lowest
+		p.target = lowest
+	}
 	go p.start(ctx)
 }
 
-// start listens for new indexes to prune.
+// start listens for new indexes to prune and, if compaction is enabled,
+// prunes pending indexes in batches on every interval.
 func (p *pruner[_, _, _]) start(ctx context.Context) {
+	var tick <-chan time.Time
+	if p.interval > 0 {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case event := <-p.feed:
 			if event.Is(events.BeaconBlockFinalized) {
-				start, end := p.pruneRangeFn(event)
-				if err := p.prunable.Prune(start, end); err != nil {
-					p.logger.Error("‼️ error pruning index ‼️", "error", err)
-				}
+				p.onFinalized(event)
 			}
+		case <-tick:
+			p.compact()
+		}
+	}
+}
+
+// onFinalized computes the range to prune for a finalized block. The range
+// is pruned inline unless compaction is enabled, in which case it is left
+// for the next batch.
+func (p *pruner[_, BlockEventT, _]) onFinalized(event BlockEventT) {
+	start, end := p.pruneRangeFn(event)
+	end = p.applyPolicies(event.Data(), end)
+	if p.interval == 0 {
+		p.prune(start, end)
+		return
+	}
+	p.target = max(p.target, end)
+}
+
+// applyPolicies extends the end of the prune range to satisfy every
+// retention policy, never pruning the latest finalized index.
+func (p *pruner[BeaconBlockT, _, _]) applyPolicies(
+	blk BeaconBlockT,
+	end uint64,
+) uint64 {
+	if len(p.policies) == 0 {
+		return end
+	}
+
+	latest := blk.GetSlot().Unwrap()
+	//#nosec:G701 // block timestamps never overflow an int64.
+	now := time.Unix(int64(blk.GetTimestamp().Unwrap()), 0)
+	for _, policy := range p.policies {
+		policyEnd, err := policy.PruneEnd(p.lowest, latest, now)
+		if err != nil {
+			p.logger.Error("failed to apply retention policy", "error", err)
+			continue
 		}
+		end = max(end, min(policyEnd, latest))
+	}
+	return end
+}
+
+// compact prunes the next batch of pending indexes.
+func (p *pruner[_, _, _]) compact() {
+	if p.lowest >= p.target {
+		return
+	}
+
+	end := p.target
+	if p.batchSize > 0 {
+		end = min(end, p.lowest+p.batchSize)
+	}
+	p.prune(p.lowest, end)
+}
+
+// prune prunes [start, end) from the prunable and reports the result.
+func (p *pruner[_, _, _]) prune(start, end uint64) {
+	before := p.size()
+	if err := p.prunable.Prune(start, end); err != nil {
+		p.logger.Error("‼️ error pruning index ‼️", "error", err)
+		return
+	}
+	if start <= p.lowest {
+		p.lowest = max(p.lowest, end)
+	}
+	p.metrics.markPruned(end, before, p.size())
+}
+
+// size returns the size of the store, or zero if it cannot be measured.
+func (p *pruner[_, _, _]) size() uint64 {
+	if p.sizeFn == nil {
+		return 0
+	}
+	size, err := p.sizeFn()
+	if err != nil {
+		p.logger.Error("failed to measure store size", "error", err)
+		return 0
 	}
+	return size
 }
 
 // Name returns the name of the Pruner.
@@ -27,6 +27,7 @@ package pruner_test
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func pruneRangeFn[EventT pruner.BlockEvent[pruner.BeaconBlock]](
@@ -120,3 +122,148 @@ func TestPruner(t *testing.T) {
 		})
 	}
 }
+
+func TestPrunerCompaction(t *testing.T) {
+	logger := log.NewNopLogger()
+	ch := make(chan pruner.BlockEvent[pruner.BeaconBlock])
+	var calls atomic.Int32
+	mockPrunable := new(mocks.Prunable)
+	mockPrunable.On("Prune", mock.Anything, mock.Anything).Return(nil).
+		Run(func(mock.Arguments) { calls.Add(1) })
+
+	rangeFn := func(event pruner.BlockEvent[pruner.BeaconBlock]) (
+		uint64, uint64,
+	) {
+		return 0, event.Data().GetSlot().Unwrap()
+	}
+
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.BlockEvent[pruner.BeaconBlock],
+		pruner.Prunable,
+	](
+		logger, mockPrunable, "TestPruner", ch, rangeFn,
+		pruner.WithCompaction(10*time.Millisecond, 2),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testPruner.Start(ctx)
+
+	block := mocks.BeaconBlock{}
+	block.On("GetSlot").Return(math.U64(5))
+	event := mocks.BlockEvent[pruner.BeaconBlock]{}
+	event.On("Data").Return(&block)
+	event.On("Is", mock.Anything).Return(true)
+	ch <- &event
+
+	// the pending range is pruned in batches of at most two indexes.
+	require.Eventually(t, func() bool {
+		return calls.Load() == 3
+	}, time.Second, 10*time.Millisecond)
+	mockPrunable.AssertCalled(t, "Prune", uint64(0), uint64(2))
+	mockPrunable.AssertCalled(t, "Prune", uint64(2), uint64(4))
+	mockPrunable.AssertCalled(t, "Prune", uint64(4), uint64(5))
+}
+
+func TestPrunerRetentionPolicy(t *testing.T) {
+	logger := log.NewNopLogger()
+	ch := make(chan pruner.BlockEvent[pruner.BeaconBlock])
+	var calls atomic.Int32
+	mockPrunable := new(mocks.Prunable)
+	mockPrunable.On("Prune", mock.Anything, mock.Anything).Return(nil).
+		Run(func(mock.Arguments) { calls.Add(1) })
+
+	// the policy asks for more than the latest index, which is capped.
+	policy := new(mocks.RetentionPolicy)
+	policy.On("PruneEnd", mock.Anything, mock.Anything, mock.Anything).
+		Return(uint64(100), nil)
+
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.BlockEvent[pruner.BeaconBlock],
+		pruner.Prunable,
+	](
+		logger, mockPrunable, "TestPruner", ch, pruneRangeFn,
+		pruner.WithRetentionPolicies(policy),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testPruner.Start(ctx)
+
+	block := mocks.BeaconBlock{}
+	block.On("GetSlot").Return(math.U64(10))
+	block.On("GetTimestamp").Return(math.U64(1000))
+	event := mocks.BlockEvent[pruner.BeaconBlock]{}
+	event.On("Data").Return(&block)
+	event.On("Is", mock.Anything).Return(true)
+	ch <- &event
+
+	require.Eventually(t, func() bool {
+		return calls.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+	mockPrunable.AssertCalled(t, "Prune", uint64(10), uint64(10))
+	policy.AssertCalled(
+		t, "PruneEnd", uint64(0), uint64(10), time.Unix(1000, 0),
+	)
+}
+
+func TestPrunerMeasuresSizeWithSizeRetention(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		cfg      pruner.RetentionConfig
+		measured bool
+	}{
+		{
+			name: "NoRetention",
+		},
+		{
+			name: "AgeRetention",
+			cfg:  pruner.RetentionConfig{MaxAge: time.Hour},
+		},
+		{
+			name:     "SizeRetention",
+			cfg:      pruner.RetentionConfig{MaxSizeBytes: 1 << 30},
+			measured: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan pruner.BlockEvent[pruner.BeaconBlock])
+			var calls, measurements atomic.Int32
+			mockPrunable := new(mocks.Prunable)
+			mockPrunable.On("Prune", mock.Anything, mock.Anything).
+				Return(nil).Run(func(mock.Arguments) { calls.Add(1) })
+			sizeFn := func() (uint64, error) {
+				measurements.Add(1)
+				return 0, nil
+			}
+
+			testPruner := pruner.NewPruner[
+				pruner.BeaconBlock,
+				pruner.BlockEvent[pruner.BeaconBlock],
+				pruner.Prunable,
+			](
+				log.NewNopLogger(), mockPrunable, "TestPruner", ch,
+				pruneRangeFn, pruner.WithRetention(tc.cfg, sizeFn),
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			testPruner.Start(ctx)
+
+			block := mocks.BeaconBlock{}
+			block.On("GetSlot").Return(math.U64(10))
+			block.On("GetTimestamp").Return(math.U64(1000))
+			event := mocks.BlockEvent[pruner.BeaconBlock]{}
+			event.On("Data").Return(&block)
+			event.On("Is", mock.Anything).Return(true)
+			ch <- &event
+
+			require.Eventually(t, func() bool {
+				return calls.Load() == 1
+			}, time.Second, 10*time.Millisecond)
+			require.Equal(t, tc.measured, measurements.Load() > 0)
+		})
+	}
+}
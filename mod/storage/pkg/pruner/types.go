@@ -35,6 +35,8 @@ import (
 // BeaconBlock is an interface for beacon blocks.
 type BeaconBlock interface {
 	GetSlot() math.U64
+	// GetTimestamp returns the timestamp of the block's execution payload.
+	GetTimestamp() math.U64
 }
 
 // BlockEvent is an interface for block events.
@@ -49,6 +51,24 @@ type Prunable interface {
 	Prune(start, end uint64) error
 }
 
+// LowestRetained is implemented by prunables that know the lowest index they
+// still retain, which lets the pruner resume where it left off.
+type LowestRetained interface {
+	// GetLowestRetainedIndex returns the lowest index that has not been
+	// pruned.
+	GetLowestRetainedIndex() (uint64, error)
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
+
 // Pruner is an interface for pruning a prunable type.
 type Pruner[PrunableT Prunable] interface {
 	Name() string